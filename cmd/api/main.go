@@ -5,13 +5,26 @@ import (
 	"log"
 	"os"
 
+	"Skillture_Form/internal/auth"
+	"Skillture_Form/internal/config"
+	"Skillture_Form/internal/embedding"
+	"Skillture_Form/internal/jobs"
+	"Skillture_Form/internal/notification"
 	"Skillture_Form/internal/repository/postgres"
 	"Skillture_Form/internal/server"
 	"Skillture_Form/internal/server/handlers"
 	"Skillture_Form/internal/usecase/admin"
+	"Skillture_Form/internal/usecase/analysis"
+	"Skillture_Form/internal/usecase/authorization"
+	embeddingUsecase "Skillture_Form/internal/usecase/embedding"
 	"Skillture_Form/internal/usecase/form"
 	"Skillture_Form/internal/usecase/form_field"
+	"Skillture_Form/internal/usecase/form_section"
 	"Skillture_Form/internal/usecase/response"
+	"Skillture_Form/internal/usecase/session"
+	webhookUsecase "Skillture_Form/internal/usecase/webhook"
+	val "Skillture_Form/internal/validation"
+	"Skillture_Form/internal/webhook"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
@@ -28,6 +41,49 @@ func main() {
 		log.Fatal("DATABASE_URL must be set")
 	}
 
+	jwtCfg := config.LoadJWTConfig()
+	if err := jwtCfg.Validate(); err != nil {
+		log.Fatalf("Invalid JWT configuration: %v", err)
+	}
+
+	securityCfg := config.LoadSecurityConfig()
+	if err := securityCfg.Validate(); err != nil {
+		log.Fatalf("Invalid security configuration: %v", err)
+	}
+
+	passwordCfg := config.LoadPasswordConfig()
+	if err := passwordCfg.Validate(); err != nil {
+		log.Fatalf("Invalid password configuration: %v", err)
+	}
+
+	draftCfg := config.LoadDraftConfig()
+	if err := draftCfg.Validate(); err != nil {
+		log.Fatalf("Invalid draft configuration: %v", err)
+	}
+
+	webhookCfg := config.LoadWebhookConfig()
+	if err := webhookCfg.Validate(); err != nil {
+		log.Fatalf("Invalid webhook configuration: %v", err)
+	}
+
+	mailCfg := config.LoadMailConfig()
+	if err := mailCfg.Validate(); err != nil {
+		log.Fatalf("Invalid mail configuration: %v", err)
+	}
+	mailer, err := notification.FromConfig(mailCfg)
+	if err != nil {
+		log.Fatalf("Invalid mail configuration: %v", err)
+	}
+
+	embeddingCfg := config.LoadEmbeddingConfig()
+	if err := embeddingCfg.Validate(); err != nil {
+		log.Fatalf("Invalid embedding configuration: %v", err)
+	}
+	embedder, err := embedding.FromConfig(embeddingCfg)
+	if err != nil {
+		log.Fatalf("Invalid embedding configuration: %v", err)
+	}
+
 	// 2. Connect to Database
 	ctx := context.Background()
 	pool, err := pgxpool.New(ctx, dbURL)
@@ -47,24 +103,79 @@ func main() {
 	adminRepo := postgres.NewAdminRepository(baseRepo)
 	formRepo := postgres.NewFormRepository(baseRepo)
 	fieldRepo := postgres.NewFormFieldRepository(baseRepo)
+	sectionRepo := postgres.NewFormSectionRepository(baseRepo)
 	responseRepo := postgres.NewResponseRepository(baseRepo)
 	answerRepo := postgres.NewResponseAnswerRepository(baseRepo)
 	vectorRepo := postgres.NewResponseAnswerVectorRepository(baseRepo)
+	flagRepo := postgres.NewResponseFlagRepository(baseRepo)
+	analyticsRepo := postgres.NewAnalyticsRepository(baseRepo)
+	webhookRepo := postgres.NewWebhookRepository(baseRepo)
+	emailRepo := postgres.NewEmailJobRepository(baseRepo)
+	grantRepo := postgres.NewFormGrantRepository(baseRepo)
+	attemptRepo := postgres.NewLoginAttemptRepository(baseRepo)
+	auditRepo := postgres.NewAuditRepository(baseRepo)
+	resetRepo := postgres.NewPasswordResetRepository(baseRepo)
+	recoveryRepo := postgres.NewMFARecoveryCodeRepository(baseRepo)
+	sessionRepo := postgres.NewAdminSessionRepository(baseRepo)
 
 	// 4. Initialize UseCases
-	adminUC := admin.NewAdminUseCase(adminRepo)
-	formUC := form.NewFormUseCase(formRepo)
-	fieldUC := form_field.NewFormFieldUseCase(formRepo, fieldRepo)
-	responseUC := response.NewResponseUsecase(formRepo, fieldRepo, responseRepo, answerRepo, vectorRepo)
+	tokens := auth.NewTokenManager(jwtCfg)
+	authz := authorization.NewAuthorizer(adminRepo, grantRepo)
+	sessionUC := session.NewSessionUseCase(adminRepo, sessionRepo, auditRepo, authz, tokens)
+	adminUC := admin.NewAdminUseCase(adminRepo, attemptRepo, auditRepo, resetRepo, recoveryRepo, sessionRepo, authz, admin.Settings{
+		MaxLoginAttempts:      securityCfg.MaxLoginAttempts,
+		MaxLoginAttemptsPerIP: securityCfg.MaxLoginAttemptsPerIP,
+		Lockout:               securityCfg.LockoutDuration(),
+		BcryptCost:            jwtCfg.BcryptCost,
+		PasswordPolicy: val.PasswordPolicy{
+			MinLength:     passwordCfg.MinLength,
+			RequireUpper:  passwordCfg.RequireUpper,
+			RequireLower:  passwordCfg.RequireLower,
+			RequireDigit:  passwordCfg.RequireDigit,
+			RequireSymbol: passwordCfg.RequireSymbol,
+			Banned:        passwordCfg.Banned,
+		},
+		ResetTTL:  passwordCfg.ResetTTL(),
+		MFAIssuer: securityCfg.MFAIssuer,
+	})
+	formUC := form.NewFormUseCase(formRepo, adminRepo, grantRepo, authz)
+	fieldUC := form_field.NewFormFieldUseCase(formRepo, fieldRepo, sectionRepo, authz)
+	sectionUC := form_section.NewFormSectionUseCase(formRepo, sectionRepo, fieldRepo, authz)
+	embeddingUC := embeddingUsecase.NewEmbeddingUseCase(formRepo, responseRepo, vectorRepo, flagRepo, embedder, authz)
+	analysisUC := analysis.NewAnalysisUseCase(formRepo, fieldRepo, answerRepo, vectorRepo, analyticsRepo, embedder.Model(), authz)
+	embeddingWorker := jobs.NewEmbeddingWorker(embeddingUC, embeddingCfg.QueueSize, embeddingCfg.MaxRetries, embeddingCfg.RetryBackoff())
+	responseUC := response.NewResponseUsecase(formRepo, fieldRepo, responseRepo, answerRepo, vectorRepo, flagRepo, embeddingWorker, authz)
+	webhookUC := webhookUsecase.NewWebhookUseCase(formRepo, webhookRepo, authz)
+
+	// 5. Start background jobs
+	jobsCtx, stopJobs := context.WithCancel(ctx)
+	defer stopJobs()
+
+	go jobs.NewDraftPurger(responseUC, draftCfg.MaxAge(), draftCfg.PurgeInterval()).Run(jobsCtx)
+	go embeddingWorker.Run(jobsCtx)
+	go jobs.NewWebhookDispatcher(
+		webhookRepo, webhook.NewClient(webhookCfg.Timeout(), webhookCfg.AllowPrivateTargets),
+		webhookCfg.BatchSize, webhookCfg.MaxAttempts,
+		webhookCfg.RetryBackoff(), webhookCfg.PollInterval(), webhookCfg.ClaimLease(),
+	).Run(jobsCtx)
+	go jobs.NewEmailDispatcher(
+		emailRepo, mailer,
+		mailCfg.BatchSize, mailCfg.MaxAttempts,
+		mailCfg.RetryBackoff(), mailCfg.PollInterval(), mailCfg.ClaimLease(),
+	).Run(jobsCtx)
 
-	// 5. Initialize Handlers
-	adminHandler := handlers.NewAdminHandler(adminUC)
+	// 6. Initialize Handlers
+	adminHandler := handlers.NewAdminHandler(adminUC, sessionUC, tokens)
 	formHandler := handlers.NewFormHandler(formUC)
 	fieldHandler := handlers.NewFormFieldHandler(fieldUC)
+	sectionHandler := handlers.NewFormSectionHandler(sectionUC)
 	responseHandler := handlers.NewResponseHandler(responseUC)
+	searchHandler := handlers.NewSearchHandler(embeddingUC)
+	analysisHandler := handlers.NewAnalysisHandler(analysisUC)
+	webhookHandler := handlers.NewWebhookHandler(webhookUC)
 
-	// 6. Initialize and Run Server
-	srv := server.NewServer(adminHandler, formHandler, fieldHandler, responseHandler)
+	// 7. Initialize and Run Server
+	srv := server.NewServer(securityCfg.TrustedProxies, tokens, sessionUC, adminHandler, formHandler, fieldHandler, sectionHandler, responseHandler, searchHandler, analysisHandler, webhookHandler)
 
 	if err := srv.Run(); err != nil {
 		log.Fatalf("Server failed to start: %v", err)
@@ -0,0 +1,77 @@
+// Command embed-backfill embeds existing text answers that have no vector for
+// the configured EMBEDDING_MODEL. It is safe to run repeatedly, e.g. after
+// switching models or when the API worker dropped jobs.
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"Skillture_Form/internal/config"
+	"Skillture_Form/internal/embedding"
+	"Skillture_Form/internal/repository/postgres"
+	"Skillture_Form/internal/usecase/authorization"
+	embeddingUsecase "Skillture_Form/internal/usecase/embedding"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+)
+
+func main() {
+	batchSize := flag.Int("batch", 100, "answers embedded per request")
+	flag.Parse()
+
+	// 1. Load environment variables
+	if err := godotenv.Load(); err != nil {
+		log.Println("No .env file found")
+	}
+
+	dbURL := os.Getenv("DATABASE_URL")
+	if dbURL == "" {
+		log.Fatal("DATABASE_URL must be set")
+	}
+
+	embeddingCfg := config.LoadEmbeddingConfig()
+	if err := embeddingCfg.Validate(); err != nil {
+		log.Fatalf("Invalid embedding configuration: %v", err)
+	}
+	embedder, err := embedding.FromConfig(embeddingCfg)
+	if err != nil {
+		log.Fatalf("Invalid embedding configuration: %v", err)
+	}
+
+	// 2. Connect to Database; Ctrl+C stops after the current batch
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	pool, err := pgxpool.New(ctx, dbURL)
+	if err != nil {
+		log.Fatalf("Unable to connect to database: %v", err)
+	}
+	defer pool.Close()
+
+	if err := pool.Ping(ctx); err != nil {
+		log.Fatalf("Unable to ping database: %v", err)
+	}
+
+	// 3. Backfill
+	baseRepo := postgres.NewBaseRepository(pool, 5*time.Minute)
+	formRepo := postgres.NewFormRepository(baseRepo)
+	responseRepo := postgres.NewResponseRepository(baseRepo)
+	vectorRepo := postgres.NewResponseAnswerVectorRepository(baseRepo)
+	flagRepo := postgres.NewResponseFlagRepository(baseRepo)
+	authz := authorization.NewAuthorizer(postgres.NewAdminRepository(baseRepo), postgres.NewFormGrantRepository(baseRepo))
+	embeddingUC := embeddingUsecase.NewEmbeddingUseCase(formRepo, responseRepo, vectorRepo, flagRepo, embedder, authz)
+
+	log.Printf("Embedding answers with %s", embedder.Model())
+	n, err := embeddingUC.Backfill(ctx, *batchSize)
+	if err != nil {
+		log.Fatalf("Backfill stopped after %d answers: %v", n, err)
+	}
+	log.Printf("Backfill complete: %d answers embedded", n)
+}
@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type contextKey string
+
+const (
+	contextKeyAdminID   contextKey = "admin_id"
+	contextKeySessionID contextKey = "session_id"
+)
+
+// WithAdminID adds the authenticated admin ID to context.
+func WithAdminID(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, contextKeyAdminID, id)
+}
+
+// AdminIDFromContext returns the authenticated admin ID, if any.
+func AdminIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(contextKeyAdminID).(uuid.UUID)
+	return id, ok && id != uuid.Nil
+}
+
+// WithSessionID adds the session of the access token to context.
+func WithSessionID(ctx context.Context, id uuid.UUID) context.Context {
+	return context.WithValue(ctx, contextKeySessionID, id)
+}
+
+// SessionIDFromContext returns the session of the access token, if any.
+func SessionIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	id, ok := ctx.Value(contextKeySessionID).(uuid.UUID)
+	return id, ok && id != uuid.Nil
+}
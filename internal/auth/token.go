@@ -0,0 +1,198 @@
+// Package auth provides JWT issuance and verification for admin sessions,
+// and TOTP codes for two-factor authentication.
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"Skillture_Form/internal/config"
+	"Skillture_Form/internal/domain/entities"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// TokenType distinguishes access tokens from refresh tokens
+type TokenType string
+
+const (
+	TokenTypeAccess  TokenType = "access"
+	TokenTypeRefresh TokenType = "refresh"
+
+	// TokenTypeMFA is the challenge issued after the password of an admin
+	// using MFA was verified; it only grants the second login step
+	TokenTypeMFA TokenType = "mfa"
+)
+
+// MFAChallengeDuration is how long an admin has to enter the second factor
+const MFAChallengeDuration = 5 * time.Minute
+
+// Errors
+var (
+	ErrInvalidToken   = errors.New("invalid token")
+	ErrExpiredToken   = errors.New("token has expired")
+	ErrWrongTokenType = errors.New("wrong token type")
+)
+
+// Claims are the JWT claims carried by admin tokens
+type Claims struct {
+	Username string    `json:"username"`
+	Type     TokenType `json:"typ"`
+	Session  string    `json:"sid,omitempty"` // Login session of access and refresh tokens
+	jwt.RegisteredClaims
+}
+
+// AdminID returns the admin ID stored in the subject claim
+func (c *Claims) AdminID() (uuid.UUID, error) {
+	return uuid.Parse(c.Subject)
+}
+
+// SessionID returns the session ID stored in the sid claim
+func (c *Claims) SessionID() (uuid.UUID, error) {
+	return uuid.Parse(c.Session)
+}
+
+// TokenPair is returned to the client after a successful login or refresh
+type TokenPair struct {
+	AccessToken      string    `json:"access_token"`
+	RefreshToken     string    `json:"refresh_token"`
+	TokenType        string    `json:"token_type"`
+	ExpiresIn        int64     `json:"expires_in"` // Access token lifetime in seconds
+	AccessExpiresAt  time.Time `json:"access_expires_at"`
+	RefreshExpiresAt time.Time `json:"refresh_expires_at"`
+}
+
+// TokenManager signs and verifies admin JWTs using HMAC-SHA256
+type TokenManager struct {
+	cfg config.JWTConfig
+	now func() time.Time
+}
+
+// NewTokenManager creates a new TokenManager from the JWT configuration
+func NewTokenManager(cfg config.JWTConfig) *TokenManager {
+	return &TokenManager{cfg: cfg, now: time.Now}
+}
+
+// IssuePair creates a new access/refresh token pair for a session of the admin
+func (m *TokenManager) IssuePair(admin *entities.Admin, sessionID uuid.UUID) (*TokenPair, error) {
+	now := m.now()
+	accessExp := now.Add(m.cfg.AccessTokenDuration())
+	refreshExp := now.Add(m.cfg.RefreshTokenDuration())
+
+	access, err := m.sign(admin, TokenTypeAccess, sessionID, now, accessExp)
+	if err != nil {
+		return nil, err
+	}
+	refresh, err := m.sign(admin, TokenTypeRefresh, sessionID, now, refreshExp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:      access,
+		RefreshToken:     refresh,
+		TokenType:        "Bearer",
+		ExpiresIn:        int64(m.cfg.AccessTokenDuration().Seconds()),
+		AccessExpiresAt:  accessExp,
+		RefreshExpiresAt: refreshExp,
+	}, nil
+}
+
+// MFAChallenge is returned instead of a token pair when a login needs a
+// second factor
+type MFAChallenge struct {
+	MFARequired bool      `json:"mfa_required"`
+	MFAToken    string    `json:"mfa_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// IssueMFAChallenge creates a short-lived token proving the admin passed the
+// password step of a login
+func (m *TokenManager) IssueMFAChallenge(admin *entities.Admin) (*MFAChallenge, error) {
+	now := m.now()
+	exp := now.Add(MFAChallengeDuration)
+
+	token, err := m.sign(admin, TokenTypeMFA, uuid.Nil, now, exp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MFAChallenge{MFARequired: true, MFAToken: token, ExpiresAt: exp}, nil
+}
+
+// ParseAccess verifies an access token and returns its claims
+func (m *TokenManager) ParseAccess(token string) (*Claims, error) {
+	return m.parse(token, TokenTypeAccess)
+}
+
+// ParseRefresh verifies a refresh token and returns its claims
+func (m *TokenManager) ParseRefresh(token string) (*Claims, error) {
+	return m.parse(token, TokenTypeRefresh)
+}
+
+// ParseMFAChallenge verifies an MFA challenge token and returns its claims
+func (m *TokenManager) ParseMFAChallenge(token string) (*Claims, error) {
+	return m.parse(token, TokenTypeMFA)
+}
+
+// sign builds and signs a token of the given type; sessionID is left out
+// when nil
+func (m *TokenManager) sign(admin *entities.Admin, typ TokenType, sessionID uuid.UUID, issuedAt, expiresAt time.Time) (string, error) {
+	claims := Claims{
+		Username: admin.Username,
+		Type:     typ,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.NewString(),
+			Subject:   admin.ID.String(),
+			Issuer:    m.cfg.Issuer,
+			IssuedAt:  jwt.NewNumericDate(issuedAt),
+			NotBefore: jwt.NewNumericDate(issuedAt),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+	if sessionID != uuid.Nil {
+		claims.Session = sessionID.String()
+	}
+
+	signed, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(m.cfg.Secret))
+	if err != nil {
+		return "", fmt.Errorf("sign %s token: %w", typ, err)
+	}
+	return signed, nil
+}
+
+// parse verifies signature, issuer, expiry and token type
+func (m *TokenManager) parse(token string, want TokenType) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(token, claims,
+		func(t *jwt.Token) (any, error) {
+			return []byte(m.cfg.Secret), nil
+		},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithIssuer(m.cfg.Issuer),
+		jwt.WithTimeFunc(m.now),
+	)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, ErrExpiredToken
+		}
+		return nil, ErrInvalidToken
+	}
+
+	if claims.Type != want {
+		return nil, ErrWrongTokenType
+	}
+	if _, err := claims.AdminID(); err != nil {
+		return nil, ErrInvalidToken
+	}
+	// Access and refresh tokens belong to a session that can be revoked
+	if want != TokenTypeMFA {
+		if _, err := claims.SessionID(); err != nil {
+			return nil, ErrInvalidToken
+		}
+	}
+
+	return claims, nil
+}
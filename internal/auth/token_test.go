@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"Skillture_Form/internal/config"
+	"Skillture_Form/internal/domain/entities"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestManager() *TokenManager {
+	return NewTokenManager(config.JWTConfig{
+		Secret:            strings.Repeat("s", 32),
+		Issuer:            "test-issuer",
+		AccessExpireMin:   15,
+		RefreshExpireDays: 7,
+		BcryptCost:        10,
+	})
+}
+
+func TestTokenManager_IssueAndParse(t *testing.T) {
+	m := newTestManager()
+	admin := &entities.Admin{ID: uuid.New(), Username: "admin"}
+	sessionID := uuid.New()
+
+	pair, err := m.IssuePair(admin, sessionID)
+	require.NoError(t, err)
+	require.Equal(t, "Bearer", pair.TokenType)
+	require.Equal(t, int64(15*60), pair.ExpiresIn)
+
+	// ===== Access token =====
+	claims, err := m.ParseAccess(pair.AccessToken)
+	require.NoError(t, err)
+	require.Equal(t, "admin", claims.Username)
+	id, err := claims.AdminID()
+	require.NoError(t, err)
+	require.Equal(t, admin.ID, id)
+	sid, err := claims.SessionID()
+	require.NoError(t, err)
+	require.Equal(t, sessionID, sid)
+
+	// ===== Refresh token =====
+	claims, err = m.ParseRefresh(pair.RefreshToken)
+	require.NoError(t, err)
+	sid, err = claims.SessionID()
+	require.NoError(t, err)
+	require.Equal(t, sessionID, sid)
+
+	// ===== Session tokens without a session =====
+	noSession, err := m.sign(admin, TokenTypeAccess, uuid.Nil, time.Now(), time.Now().Add(time.Minute))
+	require.NoError(t, err)
+	_, err = m.ParseAccess(noSession)
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	// ===== Type confusion =====
+	_, err = m.ParseAccess(pair.RefreshToken)
+	require.ErrorIs(t, err, ErrWrongTokenType)
+	_, err = m.ParseRefresh(pair.AccessToken)
+	require.ErrorIs(t, err, ErrWrongTokenType)
+}
+
+func TestTokenManager_RejectsExpiredAndForeignTokens(t *testing.T) {
+	m := newTestManager()
+	admin := &entities.Admin{ID: uuid.New(), Username: "admin"}
+
+	pair, err := m.IssuePair(admin, uuid.New())
+	require.NoError(t, err)
+
+	// ===== Expired =====
+	m.now = func() time.Time { return time.Now().Add(time.Hour) }
+	_, err = m.ParseAccess(pair.AccessToken)
+	require.ErrorIs(t, err, ErrExpiredToken)
+
+	// ===== Signed with another secret =====
+	other := newTestManager()
+	other.cfg.Secret = strings.Repeat("x", 32)
+	_, err = other.ParseAccess(pair.AccessToken)
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	// ===== Garbage =====
+	_, err = m.ParseAccess("not-a-token")
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+func TestTokenManager_MFAChallenge(t *testing.T) {
+	m := newTestManager()
+	admin := &entities.Admin{ID: uuid.New(), Username: "admin"}
+
+	challenge, err := m.IssueMFAChallenge(admin)
+	require.NoError(t, err)
+	require.True(t, challenge.MFARequired)
+
+	claims, err := m.ParseMFAChallenge(challenge.MFAToken)
+	require.NoError(t, err)
+	id, err := claims.AdminID()
+	require.NoError(t, err)
+	require.Equal(t, admin.ID, id)
+
+	// ===== Not usable as a session token, nor the reverse =====
+	_, err = m.ParseAccess(challenge.MFAToken)
+	require.ErrorIs(t, err, ErrWrongTokenType)
+	_, err = m.ParseRefresh(challenge.MFAToken)
+	require.ErrorIs(t, err, ErrWrongTokenType)
+
+	pair, err := m.IssuePair(admin, uuid.New())
+	require.NoError(t, err)
+	_, err = m.ParseMFAChallenge(pair.AccessToken)
+	require.ErrorIs(t, err, ErrWrongTokenType)
+
+	// ===== Short-lived =====
+	m.now = func() time.Time { return time.Now().Add(MFAChallengeDuration + time.Minute) }
+	_, err = m.ParseMFAChallenge(challenge.MFAToken)
+	require.ErrorIs(t, err, ErrExpiredToken)
+}
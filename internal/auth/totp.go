@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TOTP defaults used for enrollment; authenticator apps assume them
+const (
+	TOTPDigits     = 6
+	TOTPPeriod     = 30 * time.Second
+	TOTPSecretSize = 20 // 160 bits, as recommended by RFC 4226
+)
+
+// ErrInvalidTOTPSecret is returned for a secret that is not valid base32
+var ErrInvalidTOTPSecret = errors.New("invalid TOTP secret")
+
+// TOTPAlgorithm is the HMAC hash of a TOTP generator
+type TOTPAlgorithm string
+
+const (
+	TOTPSHA1   TOTPAlgorithm = "SHA1"
+	TOTPSHA256 TOTPAlgorithm = "SHA256"
+	TOTPSHA512 TOTPAlgorithm = "SHA512"
+)
+
+// hash returns the hash constructor of the algorithm, SHA-1 by default
+func (a TOTPAlgorithm) hash() func() hash.Hash {
+	switch a {
+	case TOTPSHA256:
+		return sha256.New
+	case TOTPSHA512:
+		return sha512.New
+	default:
+		return sha1.New
+	}
+}
+
+// totpEncoding is the unpadded base32 used for secrets in provisioning URIs
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// TOTP generates and verifies time-based one-time passwords (RFC 6238)
+type TOTP struct {
+	Key       []byte
+	Algorithm TOTPAlgorithm
+	Digits    int
+	Period    time.Duration
+}
+
+// NewTOTPSecret returns a random base32 secret for enrollment
+func NewTOTPSecret() (string, error) {
+	key := make([]byte, TOTPSecretSize)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return totpEncoding.EncodeToString(key), nil
+}
+
+// NewTOTP creates a generator with the default algorithm, digits and period
+// for a base32 secret. Lowercase letters, spaces and padding are accepted.
+func NewTOTP(secret string) (*TOTP, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	key, err := totpEncoding.DecodeString(strings.TrimRight(secret, "="))
+	if err != nil || len(key) == 0 {
+		return nil, ErrInvalidTOTPSecret
+	}
+	return &TOTP{Key: key, Algorithm: TOTPSHA1, Digits: TOTPDigits, Period: TOTPPeriod}, nil
+}
+
+// Counter returns the time step containing at
+func (t *TOTP) Counter(at time.Time) int64 {
+	return at.Unix() / int64(t.Period/time.Second)
+}
+
+// Code returns the one-time password for the time step containing at
+func (t *TOTP) Code(at time.Time) string {
+	return HOTP(t.Key, uint64(t.Counter(at)), t.Digits, t.Algorithm)
+}
+
+// Verify checks code against the time steps within skew steps of at and
+// returns the matching step, so callers can refuse a step used before
+func (t *TOTP) Verify(code string, at time.Time, skew int) (int64, bool) {
+	if len(code) != t.Digits {
+		return 0, false
+	}
+
+	current := t.Counter(at)
+	for i := -skew; i <= skew; i++ {
+		counter := current + int64(i)
+		if counter < 0 {
+			continue
+		}
+		want := HOTP(t.Key, uint64(counter), t.Digits, t.Algorithm)
+		if subtle.ConstantTimeCompare([]byte(want), []byte(code)) == 1 {
+			return counter, true
+		}
+	}
+	return 0, false
+}
+
+// ProvisioningURI returns the otpauth:// URI authenticator apps import,
+// usually from a QR code
+func (t *TOTP) ProvisioningURI(issuer, account string) string {
+	label := url.PathEscape(issuer) + ":" + url.PathEscape(account)
+
+	q := url.Values{}
+	q.Set("secret", totpEncoding.EncodeToString(t.Key))
+	q.Set("issuer", issuer)
+	q.Set("algorithm", string(t.Algorithm))
+	q.Set("digits", strconv.Itoa(t.Digits))
+	q.Set("period", strconv.Itoa(int(t.Period/time.Second)))
+
+	return "otpauth://totp/" + label + "?" + q.Encode()
+}
+
+// HOTP computes an HMAC-based one-time password (RFC 4226) with the given
+// number of digits
+func HOTP(key []byte, counter uint64, digits int, alg TOTPAlgorithm) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+
+	mac := hmac.New(alg.hash(), key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation (RFC 4226, section 5.3)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for range digits {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
@@ -0,0 +1,115 @@
+package auth
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// RFC 4226, Appendix D
+func TestHOTP_RFC4226Vectors(t *testing.T) {
+	key := []byte("12345678901234567890")
+	want := []string{
+		"755224", "287082", "359152", "969429", "338314",
+		"254676", "287922", "162583", "399871", "520489",
+	}
+
+	for counter, code := range want {
+		require.Equal(t, code, HOTP(key, uint64(counter), 6, TOTPSHA1), "counter %d", counter)
+	}
+}
+
+// RFC 6238, Appendix B
+func TestTOTP_RFC6238Vectors(t *testing.T) {
+	keys := map[TOTPAlgorithm][]byte{
+		TOTPSHA1:   []byte("12345678901234567890"),
+		TOTPSHA256: []byte("12345678901234567890123456789012"),
+		TOTPSHA512: []byte("1234567890123456789012345678901234567890123456789012345678901234"),
+	}
+
+	tests := []struct {
+		unix int64
+		want map[TOTPAlgorithm]string
+	}{
+		{59, map[TOTPAlgorithm]string{TOTPSHA1: "94287082", TOTPSHA256: "46119246", TOTPSHA512: "90693936"}},
+		{1111111109, map[TOTPAlgorithm]string{TOTPSHA1: "07081804", TOTPSHA256: "68084774", TOTPSHA512: "25091201"}},
+		{1111111111, map[TOTPAlgorithm]string{TOTPSHA1: "14050471", TOTPSHA256: "67062674", TOTPSHA512: "99943326"}},
+		{1234567890, map[TOTPAlgorithm]string{TOTPSHA1: "89005924", TOTPSHA256: "91819424", TOTPSHA512: "93441116"}},
+		{2000000000, map[TOTPAlgorithm]string{TOTPSHA1: "69279037", TOTPSHA256: "90698825", TOTPSHA512: "38618901"}},
+		{20000000000, map[TOTPAlgorithm]string{TOTPSHA1: "65353130", TOTPSHA256: "77737706", TOTPSHA512: "47863826"}},
+	}
+
+	for _, tt := range tests {
+		at := time.Unix(tt.unix, 0).UTC()
+		for alg, code := range tt.want {
+			totp := &TOTP{Key: keys[alg], Algorithm: alg, Digits: 8, Period: 30 * time.Second}
+			require.Equal(t, code, totp.Code(at), "%s at %d", alg, tt.unix)
+		}
+	}
+}
+
+func TestTOTP_Verify(t *testing.T) {
+	totp := &TOTP{Key: []byte("12345678901234567890"), Algorithm: TOTPSHA1, Digits: 8, Period: 30 * time.Second}
+	at := time.Unix(1111111109, 0)
+
+	// Code of the current step
+	counter, ok := totp.Verify("07081804", at, 1)
+	require.True(t, ok)
+	require.Equal(t, int64(1111111109/30), counter)
+
+	// Code of the next step is accepted within the skew, not beyond it
+	next := totp.Code(at.Add(30 * time.Second))
+	counter, ok = totp.Verify(next, at, 1)
+	require.True(t, ok)
+	require.Equal(t, int64(1111111109/30+1), counter)
+	_, ok = totp.Verify(next, at, 0)
+	require.False(t, ok)
+
+	// Wrong or malformed codes
+	_, ok = totp.Verify("07081805", at, 1)
+	require.False(t, ok)
+	_, ok = totp.Verify("0708180", at, 1)
+	require.False(t, ok)
+}
+
+func TestNewTOTP(t *testing.T) {
+	secret, err := NewTOTPSecret()
+	require.NoError(t, err)
+	require.Len(t, secret, 32) // 20 bytes in unpadded base32
+
+	totp, err := NewTOTP(strings.ToLower(secret))
+	require.NoError(t, err)
+	require.Len(t, totp.Key, TOTPSecretSize)
+	require.Len(t, totp.Code(time.Now()), TOTPDigits)
+
+	// "12345678901234567890" in base32
+	totp, err = NewTOTP("GEZD GNBV GY3T QOJQ GEZD GNBV GY3T QOJQ")
+	require.NoError(t, err)
+	require.Equal(t, "287082", HOTP(totp.Key, 1, 6, TOTPSHA1))
+
+	_, err = NewTOTP("not base32!")
+	require.ErrorIs(t, err, ErrInvalidTOTPSecret)
+	_, err = NewTOTP("")
+	require.ErrorIs(t, err, ErrInvalidTOTPSecret)
+}
+
+func TestTOTP_ProvisioningURI(t *testing.T) {
+	totp, err := NewTOTP("GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ")
+	require.NoError(t, err)
+
+	uri, err := url.Parse(totp.ProvisioningURI("Skillture Form", "site admin"))
+	require.NoError(t, err)
+	require.Equal(t, "otpauth", uri.Scheme)
+	require.Equal(t, "totp", uri.Host)
+	require.Equal(t, "/Skillture Form:site admin", uri.Path)
+
+	q := uri.Query()
+	require.Equal(t, "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ", q.Get("secret"))
+	require.Equal(t, "Skillture Form", q.Get("issuer"))
+	require.Equal(t, "SHA1", q.Get("algorithm"))
+	require.Equal(t, "6", q.Get("digits"))
+	require.Equal(t, "30", q.Get("period"))
+}
@@ -0,0 +1,261 @@
+// Package clustering groups embedding vectors by meaning.
+package clustering
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+)
+
+// Errors
+var (
+	ErrNoVectors         = errors.New("no vectors to cluster")
+	ErrInvalidK          = errors.New("number of clusters must be at least 1")
+	ErrDimensionMismatch = errors.New("vectors have different dimensions")
+)
+
+// Default k-means settings
+const (
+	DefaultMaxIterations = 50
+	DefaultSeed          = 42
+)
+
+// Options tune a k-means run
+type Options struct {
+	MaxIterations int   // Upper bound of assignment/update rounds
+	Seed          int64 // Seed of the k-means++ initialization; same input and seed give the same result
+}
+
+// Result is the outcome of a k-means run
+type Result struct {
+	K            int         // Number of non-empty clusters, at most the number of vectors
+	Centroids    [][]float64 // Unit-length cluster centers
+	Assignments  []int       // Cluster index of each input vector
+	Similarities []float64   // Cosine similarity of each input vector to its centroid
+	Iterations   int         // Rounds run until the assignments were stable
+}
+
+// Sizes returns the number of vectors in each cluster
+func (r *Result) Sizes() []int {
+	sizes := make([]int, r.K)
+	for _, c := range r.Assignments {
+		sizes[c]++
+	}
+	return sizes
+}
+
+// KMeans runs spherical k-means: vectors are compared by cosine similarity and
+// centroids are kept at unit length, which suits text embeddings. Centroids are
+// seeded with k-means++ and an emptied cluster is reseeded with the vector
+// farthest from its centroid. k is lowered to the number of vectors, and
+// clusters left empty because vectors coincide are dropped.
+func KMeans(vectors [][]float32, k int, opts Options) (*Result, error) {
+	if len(vectors) == 0 {
+		return nil, ErrNoVectors
+	}
+	if k < 1 {
+		return nil, ErrInvalidK
+	}
+	if opts.MaxIterations < 1 {
+		opts.MaxIterations = DefaultMaxIterations
+	}
+
+	points, err := normalizeAll(vectors)
+	if err != nil {
+		return nil, err
+	}
+	k = min(k, len(points))
+
+	rng := rand.New(rand.NewSource(opts.Seed))
+	centroids := seedCentroids(points, k, rng)
+
+	res := &Result{
+		K:            k,
+		Assignments:  make([]int, len(points)),
+		Similarities: make([]float64, len(points)),
+	}
+	for i := range res.Assignments {
+		res.Assignments[i] = -1
+	}
+
+	for res.Iterations < opts.MaxIterations {
+		res.Iterations++
+
+		// Assign every vector to its most similar centroid
+		changed := false
+		for i, p := range points {
+			best, bestSim := 0, math.Inf(-1)
+			for c, centroid := range centroids {
+				if sim := dot(p, centroid); sim > bestSim {
+					best, bestSim = c, sim
+				}
+			}
+			if res.Assignments[i] != best {
+				res.Assignments[i] = best
+				changed = true
+			}
+			res.Similarities[i] = bestSim
+		}
+		if !changed {
+			break
+		}
+
+		centroids = updateCentroids(points, res.Assignments, res.Similarities, k)
+	}
+
+	res.Centroids = centroids
+	res.dropEmpty()
+	return res, nil
+}
+
+// dropEmpty removes clusters without vectors, which happens when vectors
+// coincide, and renumbers the rest in their original order
+func (r *Result) dropEmpty() {
+	sizes := r.Sizes()
+	index := make([]int, r.K)
+	centroids := make([][]float64, 0, r.K)
+	for c, size := range sizes {
+		index[c] = len(centroids)
+		if size > 0 {
+			centroids = append(centroids, r.Centroids[c])
+		}
+	}
+	for i, c := range r.Assignments {
+		r.Assignments[i] = index[c]
+	}
+	r.Centroids = centroids
+	r.K = len(centroids)
+}
+
+// seedCentroids picks k initial centroids with k-means++: each next centroid is
+// drawn with probability proportional to its squared cosine distance from the
+// nearest centroid chosen so far
+func seedCentroids(points [][]float64, k int, rng *rand.Rand) [][]float64 {
+	centroids := make([][]float64, 0, k)
+	chosen := make([]bool, len(points))
+
+	first := rng.Intn(len(points))
+	centroids = append(centroids, clone(points[first]))
+	chosen[first] = true
+
+	dist := make([]float64, len(points))
+	for len(centroids) < k {
+		total := 0.0
+		for i, p := range points {
+			d := math.Inf(1)
+			for _, c := range centroids {
+				d = math.Min(d, 1-dot(p, c))
+			}
+			d = math.Max(d, 0)
+			dist[i] = d * d
+			total += dist[i]
+		}
+
+		next := -1
+		if total > 0 {
+			target := rng.Float64() * total
+			for i, d := range dist {
+				target -= d
+				if d > 0 && target <= 0 {
+					next = i
+					break
+				}
+			}
+		}
+		// Remaining vectors all coincide with a centroid: take the first unused one
+		if next < 0 {
+			for i := range points {
+				if !chosen[i] {
+					next = i
+					break
+				}
+			}
+		}
+
+		centroids = append(centroids, clone(points[next]))
+		chosen[next] = true
+	}
+
+	return centroids
+}
+
+// updateCentroids moves each centroid to the normalized mean of its vectors
+func updateCentroids(points [][]float64, assignments []int, similarities []float64, k int) [][]float64 {
+	dims := len(points[0])
+	sums := make([][]float64, k)
+	counts := make([]int, k)
+	for c := range sums {
+		sums[c] = make([]float64, dims)
+	}
+	for i, p := range points {
+		c := assignments[i]
+		counts[c]++
+		for d, v := range p {
+			sums[c][d] += v
+		}
+	}
+
+	taken := make(map[int]bool)
+	for c := range sums {
+		if counts[c] > 0 && normalize(sums[c]) {
+			continue
+		}
+
+		// Reseed an empty cluster with the worst-fitting vector
+		worst, worstSim := -1, math.Inf(1)
+		for i, sim := range similarities {
+			if !taken[i] && sim < worstSim {
+				worst, worstSim = i, sim
+			}
+		}
+		taken[worst] = true
+		sums[c] = clone(points[worst])
+	}
+
+	return sums
+}
+
+// normalizeAll copies the vectors to unit-length float64 vectors.
+// Zero vectors are kept as they are and are equally far from every centroid.
+func normalizeAll(vectors [][]float32) ([][]float64, error) {
+	dims := len(vectors[0])
+	points := make([][]float64, len(vectors))
+	for i, v := range vectors {
+		if len(v) != dims {
+			return nil, ErrDimensionMismatch
+		}
+		p := make([]float64, dims)
+		for d, x := range v {
+			p[d] = float64(x)
+		}
+		normalize(p)
+		points[i] = p
+	}
+	return points, nil
+}
+
+// normalize scales v to unit length and reports whether v was non-zero
+func normalize(v []float64) bool {
+	norm := math.Sqrt(dot(v, v))
+	if norm == 0 {
+		return false
+	}
+	for i := range v {
+		v[i] /= norm
+	}
+	return true
+}
+
+func dot(a, b []float64) float64 {
+	sum := 0.0
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
+
+func clone(v []float64) []float64 {
+	out := make([]float64, len(v))
+	copy(out, v)
+	return out
+}
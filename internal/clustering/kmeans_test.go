@@ -0,0 +1,88 @@
+package clustering
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// blob returns n noisy copies of center
+func blob(rng *rand.Rand, center []float32, n int) [][]float32 {
+	out := make([][]float32, n)
+	for i := range out {
+		v := make([]float32, len(center))
+		for d, x := range center {
+			v[d] = x + float32(rng.NormFloat64()*0.05)
+		}
+		out[i] = v
+	}
+	return out
+}
+
+func TestKMeans_SeparatesClusters(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	var vectors [][]float32
+	vectors = append(vectors, blob(rng, []float32{1, 0, 0, 0}, 12)...)
+	vectors = append(vectors, blob(rng, []float32{0, 1, 0, 0}, 8)...)
+	vectors = append(vectors, blob(rng, []float32{0, 0, 1, 1}, 5)...)
+
+	res, err := KMeans(vectors, 3, Options{Seed: DefaultSeed})
+	require.NoError(t, err)
+	require.Equal(t, 3, res.K)
+	require.Len(t, res.Assignments, len(vectors))
+
+	// Every blob ends up in its own cluster
+	groups := [][2]int{{0, 12}, {12, 20}, {20, 25}}
+	seen := make(map[int]bool)
+	for _, g := range groups {
+		cluster := res.Assignments[g[0]]
+		for i := g[0]; i < g[1]; i++ {
+			require.Equal(t, cluster, res.Assignments[i], "vector %d", i)
+			require.Greater(t, res.Similarities[i], 0.9)
+		}
+		require.False(t, seen[cluster], "blobs share cluster %d", cluster)
+		seen[cluster] = true
+	}
+
+	sizes := res.Sizes()
+	require.ElementsMatch(t, []int{12, 8, 5}, sizes)
+}
+
+func TestKMeans_Deterministic(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+	vectors := blob(rng, []float32{1, 1, 0}, 30)
+
+	a, err := KMeans(vectors, 4, Options{Seed: DefaultSeed})
+	require.NoError(t, err)
+	b, err := KMeans(vectors, 4, Options{Seed: DefaultSeed})
+	require.NoError(t, err)
+	require.Equal(t, a.Assignments, b.Assignments)
+}
+
+func TestKMeans_KLimitedByVectors(t *testing.T) {
+	res, err := KMeans([][]float32{{1, 0}, {0, 1}}, 5, Options{})
+	require.NoError(t, err)
+	require.Equal(t, 2, res.K)
+	require.ElementsMatch(t, []int{0, 1}, res.Assignments)
+}
+
+func TestKMeans_DropsEmptyClusters(t *testing.T) {
+	res, err := KMeans([][]float32{{1, 0}, {2, 0}, {3, 0}}, 3, Options{})
+	require.NoError(t, err)
+	require.Equal(t, 1, res.K)
+	require.Len(t, res.Centroids, 1)
+	require.Equal(t, []int{0, 0, 0}, res.Assignments)
+}
+
+func TestKMeans_Errors(t *testing.T) {
+	_, err := KMeans(nil, 2, Options{})
+	require.True(t, errors.Is(err, ErrNoVectors))
+
+	_, err = KMeans([][]float32{{1, 0}}, 0, Options{})
+	require.True(t, errors.Is(err, ErrInvalidK))
+
+	_, err = KMeans([][]float32{{1, 0}, {1, 0, 0}}, 1, Options{})
+	require.True(t, errors.Is(err, ErrDimensionMismatch))
+}
@@ -14,13 +14,18 @@ import (
 
 // Config holds all application configuration.
 type Config struct {
-	Database DatabaseConfig
-	Server   ServerConfig
-	JWT      JWTConfig
-	Security SecurityConfig
-	Logging  LoggingConfig
-	CORS     CORSConfig
-	Upload   UploadConfig
+	Database  DatabaseConfig
+	Server    ServerConfig
+	JWT       JWTConfig
+	Security  SecurityConfig
+	Passwords PasswordConfig
+	Logging   LoggingConfig
+	CORS      CORSConfig
+	Upload    UploadConfig
+	Drafts    DraftConfig
+	Embedding EmbeddingConfig
+	Webhooks  WebhookConfig
+	Mail      MailConfig
 }
 
 // DatabaseConfig holds database connection and pool settings.
@@ -66,11 +71,24 @@ type JWTConfig struct {
 
 // SecurityConfig holds security-related settings.
 type SecurityConfig struct {
-	RateLimitRequests  int
-	RateLimitWindowMin int
-	MaxLoginAttempts   int
-	LockoutDurationMin int
-	TrustedProxies     []string
+	RateLimitRequests     int
+	RateLimitWindowMin    int
+	MaxLoginAttempts      int      // Failed logins per username before a lockout
+	MaxLoginAttemptsPerIP int      // Failed logins per client IP before a lockout
+	LockoutDurationMin    int      // Length of a lockout, and the window failures are counted in
+	TrustedProxies        []string // Reverse proxies whose X-Forwarded-For is trusted
+	MFAIssuer             string   // Account issuer shown by authenticator apps
+}
+
+// PasswordConfig holds the admin password policy and reset settings.
+type PasswordConfig struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	Banned        []string // Refused in addition to the built-in list of common passwords
+	ResetTTLMin   int      // Lifetime of a one-time reset token
 }
 
 // LoggingConfig holds logging settings.
@@ -95,18 +113,69 @@ type UploadConfig struct {
 	AllowedTypes []string
 }
 
+// DraftConfig holds settings for saved, not yet submitted responses.
+type DraftConfig struct {
+	MaxAgeHours      int
+	PurgeIntervalMin int
+}
+
+// EmbeddingConfig holds settings for embedding text answers.
+type EmbeddingConfig struct {
+	Model           string // Model name of the embedder to use
+	APIURL          string // Base URL of an OpenAI-compatible embeddings API
+	APIKey          string
+	TimeoutSec      int
+	QueueSize       int
+	MaxRetries      int
+	RetryBackoffSec int
+}
+
+// WebhookConfig holds settings for delivering webhook events.
+type WebhookConfig struct {
+	TimeoutSec      int // Timeout of a single delivery request
+	BatchSize       int // Deliveries sent concurrently per poll
+	MaxAttempts     int // Attempts before a delivery is dead-lettered
+	RetryBackoffSec int
+	PollIntervalSec int
+
+	// AllowPrivateTargets permits deliveries to loopback, private and
+	// link-local addresses. Only for local development and tests.
+	AllowPrivateTargets bool
+}
+
+// MailConfig holds settings for sending notification emails.
+type MailConfig struct {
+	Driver          string // "smtp", or "file" to write .eml files to Dir
+	From            string // Sender address, e.g. "Skillture Forms <no-reply@example.com>"
+	SMTPHost        string
+	SMTPPort        string // 465 uses implicit TLS; other ports use STARTTLS when offered
+	SMTPUsername    string
+	SMTPPassword    string
+	Dir             string
+	TimeoutSec      int // Timeout of a single SMTP session
+	BatchSize       int // Emails sent per poll
+	MaxAttempts     int // Attempts before an email is given up
+	RetryBackoffSec int
+	PollIntervalSec int
+}
+
 // Load reads configuration from environment variables.
 func Load() (*Config, error) {
 	_ = godotenv.Load()
 
 	cfg := &Config{
-		Database: LoadDatabaseConfig(),
-		Server:   loadServerConfig(),
-		JWT:      loadJWTConfig(),
-		Security: loadSecurityConfig(),
-		Logging:  loadLoggingConfig(),
-		CORS:     loadCORSConfig(),
-		Upload:   loadUploadConfig(),
+		Database:  LoadDatabaseConfig(),
+		Server:    loadServerConfig(),
+		JWT:       LoadJWTConfig(),
+		Security:  LoadSecurityConfig(),
+		Passwords: LoadPasswordConfig(),
+		Logging:   loadLoggingConfig(),
+		CORS:      loadCORSConfig(),
+		Upload:    loadUploadConfig(),
+		Drafts:    LoadDraftConfig(),
+		Embedding: LoadEmbeddingConfig(),
+		Webhooks:  LoadWebhookConfig(),
+		Mail:      LoadMailConfig(),
 	}
 
 	if err := cfg.Validate(); err != nil {
@@ -150,7 +219,8 @@ func loadServerConfig() ServerConfig {
 	}
 }
 
-func loadJWTConfig() JWTConfig {
+// LoadJWTConfig reads JWT settings from environment variables.
+func LoadJWTConfig() JWTConfig {
 	return JWTConfig{
 		Secret:            getEnv("JWT_SECRET", ""),
 		Issuer:            getEnv("JWT_ISSUER", "nahj-api"),
@@ -160,13 +230,29 @@ func loadJWTConfig() JWTConfig {
 	}
 }
 
-func loadSecurityConfig() SecurityConfig {
+// LoadSecurityConfig reads security settings from environment variables.
+func LoadSecurityConfig() SecurityConfig {
 	return SecurityConfig{
-		RateLimitRequests:  getEnvInt("RATE_LIMIT_REQUESTS", 100),
-		RateLimitWindowMin: getEnvInt("RATE_LIMIT_WINDOW_MIN", 15),
-		MaxLoginAttempts:   getEnvInt("MAX_LOGIN_ATTEMPTS", 5),
-		LockoutDurationMin: getEnvInt("LOCKOUT_DURATION_MIN", 15),
-		TrustedProxies:     getEnvSlice("TRUSTED_PROXIES", "127.0.0.1"),
+		RateLimitRequests:     getEnvInt("RATE_LIMIT_REQUESTS", 100),
+		RateLimitWindowMin:    getEnvInt("RATE_LIMIT_WINDOW_MIN", 15),
+		MaxLoginAttempts:      getEnvInt("MAX_LOGIN_ATTEMPTS", 5),
+		MaxLoginAttemptsPerIP: getEnvInt("MAX_LOGIN_ATTEMPTS_PER_IP", 50),
+		LockoutDurationMin:    getEnvInt("LOCKOUT_DURATION_MIN", 15),
+		TrustedProxies:        getEnvSlice("TRUSTED_PROXIES", "127.0.0.1,::1"),
+		MFAIssuer:             getEnv("MFA_ISSUER", "Skillture Form"),
+	}
+}
+
+// LoadPasswordConfig reads password policy settings from environment variables.
+func LoadPasswordConfig() PasswordConfig {
+	return PasswordConfig{
+		MinLength:     getEnvInt("PASSWORD_MIN_LENGTH", 10),
+		RequireUpper:  getEnvBool("PASSWORD_REQUIRE_UPPER", true),
+		RequireLower:  getEnvBool("PASSWORD_REQUIRE_LOWER", true),
+		RequireDigit:  getEnvBool("PASSWORD_REQUIRE_DIGIT", true),
+		RequireSymbol: getEnvBool("PASSWORD_REQUIRE_SYMBOL", false),
+		Banned:        getEnvSlice("PASSWORD_BANNED", ""),
+		ResetTTLMin:   getEnvInt("PASSWORD_RESET_TTL_MIN", 60),
 	}
 }
 
@@ -195,6 +281,58 @@ func loadUploadConfig() UploadConfig {
 	}
 }
 
+// LoadDraftConfig reads draft response settings from environment variables.
+func LoadDraftConfig() DraftConfig {
+	return DraftConfig{
+		MaxAgeHours:      getEnvInt("DRAFT_MAX_AGE_HOURS", 168),
+		PurgeIntervalMin: getEnvInt("DRAFT_PURGE_INTERVAL_MIN", 60),
+	}
+}
+
+// LoadEmbeddingConfig reads embedding settings from environment variables.
+func LoadEmbeddingConfig() EmbeddingConfig {
+	return EmbeddingConfig{
+		Model:           getEnv("EMBEDDING_MODEL", "local-hashing-v1"),
+		APIURL:          getEnv("EMBEDDING_API_URL", "https://api.openai.com/v1"),
+		APIKey:          getEnv("EMBEDDING_API_KEY", ""),
+		TimeoutSec:      getEnvInt("EMBEDDING_TIMEOUT_SEC", 30),
+		QueueSize:       getEnvInt("EMBEDDING_QUEUE_SIZE", 1000),
+		MaxRetries:      getEnvInt("EMBEDDING_MAX_RETRIES", 5),
+		RetryBackoffSec: getEnvInt("EMBEDDING_RETRY_BACKOFF_SEC", 2),
+	}
+}
+
+// LoadWebhookConfig reads webhook delivery settings from environment variables.
+func LoadWebhookConfig() WebhookConfig {
+	return WebhookConfig{
+		TimeoutSec:      getEnvInt("WEBHOOK_TIMEOUT_SEC", 10),
+		BatchSize:       getEnvInt("WEBHOOK_BATCH_SIZE", 20),
+		MaxAttempts:     getEnvInt("WEBHOOK_MAX_ATTEMPTS", 8),
+		RetryBackoffSec: getEnvInt("WEBHOOK_RETRY_BACKOFF_SEC", 30),
+		PollIntervalSec: getEnvInt("WEBHOOK_POLL_INTERVAL_SEC", 5),
+
+		AllowPrivateTargets: getEnvBool("WEBHOOK_ALLOW_PRIVATE_TARGETS", false),
+	}
+}
+
+// LoadMailConfig reads email settings from environment variables.
+func LoadMailConfig() MailConfig {
+	return MailConfig{
+		Driver:          getEnv("MAIL_DRIVER", "file"),
+		From:            getEnv("MAIL_FROM", "Skillture Forms <no-reply@localhost>"),
+		SMTPHost:        getEnv("SMTP_HOST", ""),
+		SMTPPort:        getEnv("SMTP_PORT", "587"),
+		SMTPUsername:    getEnv("SMTP_USERNAME", ""),
+		SMTPPassword:    getEnv("SMTP_PASSWORD", ""),
+		Dir:             getEnv("MAIL_DIR", "./mail"),
+		TimeoutSec:      getEnvInt("MAIL_TIMEOUT_SEC", 30),
+		BatchSize:       getEnvInt("MAIL_BATCH_SIZE", 20),
+		MaxAttempts:     getEnvInt("MAIL_MAX_ATTEMPTS", 8),
+		RetryBackoffSec: getEnvInt("MAIL_RETRY_BACKOFF_SEC", 60),
+		PollIntervalSec: getEnvInt("MAIL_POLL_INTERVAL_SEC", 10),
+	}
+}
+
 // Validate checks all configuration values.
 func (c *Config) Validate() error {
 	if err := c.Database.Validate(); err != nil {
@@ -209,9 +347,24 @@ func (c *Config) Validate() error {
 	if err := c.Security.Validate(); err != nil {
 		return fmt.Errorf("security: %w", err)
 	}
+	if err := c.Passwords.Validate(); err != nil {
+		return fmt.Errorf("passwords: %w", err)
+	}
 	if err := c.Upload.Validate(); err != nil {
 		return fmt.Errorf("upload: %w", err)
 	}
+	if err := c.Drafts.Validate(); err != nil {
+		return fmt.Errorf("drafts: %w", err)
+	}
+	if err := c.Embedding.Validate(); err != nil {
+		return fmt.Errorf("embedding: %w", err)
+	}
+	if err := c.Webhooks.Validate(); err != nil {
+		return fmt.Errorf("webhooks: %w", err)
+	}
+	if err := c.Mail.Validate(); err != nil {
+		return fmt.Errorf("mail: %w", err)
+	}
 	return nil
 }
 
@@ -272,6 +425,19 @@ func (j *JWTConfig) Validate() error {
 
 // Validate checks security configuration.
 func (s *SecurityConfig) Validate() error {
+	if s.MaxLoginAttempts < 1 {
+		return fmt.Errorf("max_login_attempts must be at least 1")
+	}
+	if s.MaxLoginAttemptsPerIP < s.MaxLoginAttempts {
+		return fmt.Errorf("max_login_attempts_per_ip must be at least max_login_attempts")
+	}
+	if s.LockoutDurationMin < 1 {
+		return fmt.Errorf("lockout_duration_min must be at least 1")
+	}
+	// The issuer prefixes the account label in otpauth URIs, split on ':'
+	if s.MFAIssuer == "" || strings.Contains(s.MFAIssuer, ":") {
+		return fmt.Errorf("mfa_issuer must be set and must not contain ':'")
+	}
 	for _, proxy := range s.TrustedProxies {
 		proxy = strings.TrimSpace(proxy)
 		if proxy == "" {
@@ -286,6 +452,18 @@ func (s *SecurityConfig) Validate() error {
 	return nil
 }
 
+// Validate checks password configuration.
+// bcrypt only uses the first 72 bytes of a password.
+func (p *PasswordConfig) Validate() error {
+	if p.MinLength < 8 || p.MinLength > 72 {
+		return fmt.Errorf("min_length must be between 8 and 72")
+	}
+	if p.ResetTTLMin < 1 {
+		return fmt.Errorf("reset_ttl_min must be at least 1")
+	}
+	return nil
+}
+
 // Validate checks upload configuration.
 func (u *UploadConfig) Validate() error {
 	if u.MaxSizeMB < 1 || u.MaxSizeMB > 100 {
@@ -294,6 +472,95 @@ func (u *UploadConfig) Validate() error {
 	return nil
 }
 
+// Validate checks draft configuration.
+func (d *DraftConfig) Validate() error {
+	if d.MaxAgeHours < 1 {
+		return fmt.Errorf("max_age_hours must be at least 1")
+	}
+	if d.PurgeIntervalMin < 1 {
+		return fmt.Errorf("purge_interval_min must be at least 1")
+	}
+	return nil
+}
+
+// Validate checks embedding configuration.
+func (e *EmbeddingConfig) Validate() error {
+	if e.Model == "" {
+		return fmt.Errorf("model is required")
+	}
+	if e.TimeoutSec < 1 {
+		return fmt.Errorf("timeout_sec must be at least 1")
+	}
+	if e.QueueSize < 1 {
+		return fmt.Errorf("queue_size must be at least 1")
+	}
+	if e.MaxRetries < 0 {
+		return fmt.Errorf("max_retries must not be negative")
+	}
+	if e.RetryBackoffSec < 1 {
+		return fmt.Errorf("retry_backoff_sec must be at least 1")
+	}
+	return nil
+}
+
+// Validate checks webhook configuration.
+func (w *WebhookConfig) Validate() error {
+	if w.TimeoutSec < 1 {
+		return fmt.Errorf("timeout_sec must be at least 1")
+	}
+	if w.BatchSize < 1 {
+		return fmt.Errorf("batch_size must be at least 1")
+	}
+	if w.MaxAttempts < 1 {
+		return fmt.Errorf("max_attempts must be at least 1")
+	}
+	if w.RetryBackoffSec < 1 {
+		return fmt.Errorf("retry_backoff_sec must be at least 1")
+	}
+	if w.PollIntervalSec < 1 {
+		return fmt.Errorf("poll_interval_sec must be at least 1")
+	}
+	return nil
+}
+
+// Validate checks mail configuration.
+func (m *MailConfig) Validate() error {
+	switch m.Driver {
+	case "smtp":
+		if m.SMTPHost == "" {
+			return fmt.Errorf("smtp_host is required for the smtp driver")
+		}
+		if m.SMTPPort == "" {
+			return fmt.Errorf("smtp_port is required for the smtp driver")
+		}
+	case "file":
+		if m.Dir == "" {
+			return fmt.Errorf("dir is required for the file driver")
+		}
+	default:
+		return fmt.Errorf("driver must be smtp or file")
+	}
+	if m.From == "" {
+		return fmt.Errorf("from is required")
+	}
+	if m.TimeoutSec < 1 {
+		return fmt.Errorf("timeout_sec must be at least 1")
+	}
+	if m.BatchSize < 1 {
+		return fmt.Errorf("batch_size must be at least 1")
+	}
+	if m.MaxAttempts < 1 {
+		return fmt.Errorf("max_attempts must be at least 1")
+	}
+	if m.RetryBackoffSec < 1 {
+		return fmt.Errorf("retry_backoff_sec must be at least 1")
+	}
+	if m.PollIntervalSec < 1 {
+		return fmt.Errorf("poll_interval_sec must be at least 1")
+	}
+	return nil
+}
+
 // ConnectionString returns PostgreSQL connection URL.
 func (d *DatabaseConfig) ConnectionString() string {
 	return fmt.Sprintf(
@@ -340,6 +607,11 @@ func (s *SecurityConfig) RateLimitWindow() time.Duration {
 	return time.Duration(s.RateLimitWindowMin) * time.Minute
 }
 
+// ResetTTL returns how long a password reset token is valid.
+func (p *PasswordConfig) ResetTTL() time.Duration {
+	return time.Duration(p.ResetTTLMin) * time.Minute
+}
+
 // LockoutDuration returns account lockout duration.
 func (s *SecurityConfig) LockoutDuration() time.Duration {
 	return time.Duration(s.LockoutDurationMin) * time.Minute
@@ -373,6 +645,69 @@ func (s *SecurityConfig) IsTrustedProxy(ip string) bool {
 	return false
 }
 
+// MaxAge returns how long an untouched draft is kept.
+func (d *DraftConfig) MaxAge() time.Duration {
+	return time.Duration(d.MaxAgeHours) * time.Hour
+}
+
+// PurgeInterval returns how often abandoned drafts are purged.
+func (d *DraftConfig) PurgeInterval() time.Duration {
+	return time.Duration(d.PurgeIntervalMin) * time.Minute
+}
+
+// Timeout returns the timeout of a single embeddings API request.
+func (e *EmbeddingConfig) Timeout() time.Duration {
+	return time.Duration(e.TimeoutSec) * time.Second
+}
+
+// RetryBackoff returns the delay before the first retry of a failed embedding job.
+func (e *EmbeddingConfig) RetryBackoff() time.Duration {
+	return time.Duration(e.RetryBackoffSec) * time.Second
+}
+
+// Timeout returns the timeout of a single webhook delivery request.
+func (w *WebhookConfig) Timeout() time.Duration {
+	return time.Duration(w.TimeoutSec) * time.Second
+}
+
+// RetryBackoff returns the delay before the first retry of a failed delivery.
+func (w *WebhookConfig) RetryBackoff() time.Duration {
+	return time.Duration(w.RetryBackoffSec) * time.Second
+}
+
+// PollInterval returns how often the outbox is checked for due deliveries.
+func (w *WebhookConfig) PollInterval() time.Duration {
+	return time.Duration(w.PollIntervalSec) * time.Second
+}
+
+// ClaimLease returns how long a claimed delivery is hidden from other
+// dispatchers; it outlasts the request so a delivery is only retried
+// early if its dispatcher crashed.
+func (w *WebhookConfig) ClaimLease() time.Duration {
+	return w.Timeout() + time.Minute
+}
+
+// Timeout returns the timeout of a single SMTP session.
+func (m *MailConfig) Timeout() time.Duration {
+	return time.Duration(m.TimeoutSec) * time.Second
+}
+
+// RetryBackoff returns the delay before the first retry of a failed email.
+func (m *MailConfig) RetryBackoff() time.Duration {
+	return time.Duration(m.RetryBackoffSec) * time.Second
+}
+
+// PollInterval returns how often the queue is checked for due emails.
+func (m *MailConfig) PollInterval() time.Duration {
+	return time.Duration(m.PollIntervalSec) * time.Second
+}
+
+// ClaimLease returns how long a claimed email is hidden from other
+// dispatchers; emails are sent one by one, so it outlasts a whole batch.
+func (m *MailConfig) ClaimLease() time.Duration {
+	return time.Duration(m.BatchSize)*m.Timeout() + time.Minute
+}
+
 // MaxSizeBytes returns max file size in bytes.
 func (u *UploadConfig) MaxSizeBytes() int64 {
 	return int64(u.MaxSizeMB) * 1024 * 1024
@@ -11,7 +11,11 @@ CREATE TABLE admins (
     id UUID PRIMARY KEY,                  
     username VARCHAR(255) NOT NULL UNIQUE, -- Admin login username
     hashed_password TEXT NOT NULL,        -- Securely hashed password
-    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP -- Account creation time
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP, -- Account creation time
+    role VARCHAR(20) DEFAULT 'owner',     -- Global role: owner, editor, reviewer, viewer; NULL = granted forms only
+    mfa_secret VARCHAR(64),               -- Base32 TOTP secret, set from MFA enrollment on
+    mfa_enabled_at TIMESTAMP,             -- Set once enrollment is confirmed; logins then need a code
+    mfa_last_counter BIGINT               -- Last accepted TOTP time step, refused on reuse
 );
 
 -- =====================================================
@@ -23,9 +27,35 @@ CREATE TABLE forms (
     title JSONB NOT NULL,                 -- {"en": "Survey", "ar": "استبيان"}
     description JSONB,                    -- Optional description in multiple languages
     status SMALLINT DEFAULT 1,            -- Form status (1=active, 0=inactive)
+    duplicate_policy JSONB,               -- {"unique_email": true, "fingerprint_window_min": 60, "near_duplicate_threshold": 0.95} optional
+    notification_settings JSONB,          -- {"confirm_respondent": true, "admin_emails": [...], "confirmation": {"en": {...}}} optional
     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP
 );
 
+-- =====================================================
+-- Table: form_sections
+-- Groups fields into pages/steps of a multi-step form
+-- =====================================================
+CREATE TABLE form_sections (
+    id UUID PRIMARY KEY,
+    form_id UUID NOT NULL,
+
+    title JSONB NOT NULL,                 -- {"en": "Personal info", "ar": "معلومات شخصية"}
+    description JSONB,                    -- {"en": "...", "ar": "..."} optional
+    section_order INT NOT NULL,           -- Section order
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_form_sections_form
+        FOREIGN KEY (form_id)
+        REFERENCES forms(id)
+        ON DELETE CASCADE
+);
+
+-- Ensure unique section order inside form
+CREATE UNIQUE INDEX uq_form_sections_form_order
+ON form_sections(form_id, section_order);
+
 -- =====================================================
 -- Table: form_fields
 -- Defines fields/questions belonging to a form
@@ -33,6 +63,7 @@ CREATE TABLE forms (
 CREATE TABLE form_fields (
     id UUID PRIMARY KEY,
     form_id UUID NOT NULL,
+    section_id UUID,                      -- Optional section (page) the field belongs to
 
     label JSONB NOT NULL,                 -- {"en": "Name", "ar": "الاسم"}
     type VARCHAR(50) NOT NULL,            -- text, textarea, select, radio, checkbox, number, email ...
@@ -41,13 +72,19 @@ CREATE TABLE form_fields (
     placeholder JSONB,                    -- {"en": "...", "ar": "..."} optional
     help_text JSONB,                      -- {"en": "...", "ar": "..."} optional
     options JSONB,                        -- {"en":["Option1","Option2"], "ar":["خيار1","خيار2"]}
+    validation_rules JSONB,               -- {"min": 16, "max": 35} / {"pattern": "^\+?[0-9]{10,14}$"} optional
+    show_if JSONB,                        -- {"match": "all", "conditions": [{"field_id": "...", "operator": "equals", "value": "yes"}]}
     created_at TIMESTAMP NOT NULL DEFAULT NOW(),
     updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
     
     CONSTRAINT fk_form_fields_form
         FOREIGN KEY (form_id)
         REFERENCES forms(id)
-        ON DELETE CASCADE
+        ON DELETE CASCADE,
+    CONSTRAINT fk_form_fields_section
+        FOREIGN KEY (section_id)
+        REFERENCES form_sections(id)
+        ON DELETE SET NULL
 );
 
 -- Ensure unique position inside form
@@ -62,13 +99,69 @@ CREATE TABLE responses (
     id UUID PRIMARY KEY,                  
     form_id UUID NOT NULL,                
     respondent JSONB,                     -- {"email": "...", "name": "..."} optional
-    status SMALLINT DEFAULT 0,            -- 0=pending, 1=submitted, 2=reviewed
+    status SMALLINT DEFAULT 0,            -- 0=pending, 1=submitted, 2=reviewed, 3=accepted, 4=rejected
     submitted_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    resume_token_hash VARCHAR(64) UNIQUE, -- SHA-256 of the draft resume token, NULL once submitted
+    fingerprint VARCHAR(64),              -- SHA-256 of the client fingerprint, for duplicate detection
+    reviewed_by UUID,                     -- Admin who made the last review decision
+    reviewed_at TIMESTAMP,
+    review_notes TEXT,
 
     CONSTRAINT fk_responses_form
         FOREIGN KEY (form_id)
         REFERENCES forms(id)
-        ON DELETE CASCADE
+        ON DELETE CASCADE,
+    CONSTRAINT fk_responses_reviewer
+        FOREIGN KEY (reviewed_by)
+        REFERENCES admins(id)
+        ON DELETE SET NULL
+);
+
+-- =====================================================
+-- Table: response_status_history
+-- Audit trail of review status changes
+-- =====================================================
+CREATE TABLE response_status_history (
+    id UUID PRIMARY KEY,
+    response_id UUID NOT NULL,
+    from_status SMALLINT NOT NULL,
+    to_status SMALLINT NOT NULL,
+    changed_by UUID,                      -- Admin who made the change
+    notes TEXT,
+    changed_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_status_history_response
+        FOREIGN KEY (response_id)
+        REFERENCES responses(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_status_history_admin
+        FOREIGN KEY (changed_by)
+        REFERENCES admins(id)
+        ON DELETE SET NULL
+);
+
+-- =====================================================
+-- Table: response_flags
+-- Review flags of submitted responses (e.g. near-duplicate answers)
+-- =====================================================
+CREATE TABLE response_flags (
+    id UUID PRIMARY KEY,
+    response_id UUID NOT NULL,
+    reason VARCHAR(50) NOT NULL,          -- near_duplicate, same_fingerprint
+    field_id UUID,                        -- Field whose answer triggered the flag
+    matched_response_id UUID,             -- Earlier response the answer resembles
+    similarity DOUBLE PRECISION,          -- Cosine similarity of the two answers
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT fk_flags_response
+        FOREIGN KEY (response_id)
+        REFERENCES responses(id)
+        ON DELETE CASCADE,
+    CONSTRAINT fk_flags_matched_response
+        FOREIGN KEY (matched_response_id)
+        REFERENCES responses(id)
+        ON DELETE SET NULL
 );
 
 -- =====================================================
@@ -110,12 +203,228 @@ CREATE TABLE response_answer_vectors (
         ON DELETE CASCADE
 );
 
+-- =====================================================
+-- Table: webhook_subscriptions
+-- Per-form webhook endpoints and the events they receive
+-- =====================================================
+CREATE TABLE webhook_subscriptions (
+    id UUID PRIMARY KEY,
+    form_id UUID NOT NULL,
+    url TEXT NOT NULL,                    -- http(s) endpoint receiving POSTed events
+    secret VARCHAR(128) NOT NULL,         -- HMAC-SHA256 signing key
+    events TEXT[] NOT NULL,               -- {"response.submitted", "form.closed"}
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_webhook_subscriptions_form
+        FOREIGN KEY (form_id)
+        REFERENCES forms(id)
+        ON DELETE CASCADE
+);
+
+-- =====================================================
+-- Table: webhook_deliveries
+-- Outbox of events per subscription, written in the same transaction
+-- as the change; doubles as the delivery log
+-- =====================================================
+CREATE TABLE webhook_deliveries (
+    id UUID PRIMARY KEY,
+    subscription_id UUID NOT NULL,
+    event_id UUID NOT NULL,               -- Shared by the deliveries of one event
+    event VARCHAR(50) NOT NULL,           -- response.submitted, response.reviewed, form.published, form.closed
+    payload JSONB NOT NULL,               -- Body POSTed to the subscriber
+    status SMALLINT NOT NULL DEFAULT 0,   -- 0=pending, 1=delivered, 2=dead
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_status_code INT,                 -- HTTP status of the last attempt
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    delivered_at TIMESTAMP,
+
+    CONSTRAINT fk_webhook_deliveries_subscription
+        FOREIGN KEY (subscription_id)
+        REFERENCES webhook_subscriptions(id)
+        ON DELETE CASCADE
+);
+
+-- =====================================================
+-- Table: email_jobs
+-- Durable queue of rendered notification emails
+-- =====================================================
+CREATE TABLE email_jobs (
+    id UUID PRIMARY KEY,
+    response_id UUID,                     -- Submission the email is about
+    kind VARCHAR(50) NOT NULL,            -- confirmation, admin_alert
+    recipients TEXT[] NOT NULL,
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL,
+    status SMALLINT NOT NULL DEFAULT 0,   -- 0=pending, 1=sent, 2=dead
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMP,
+
+    CONSTRAINT fk_email_jobs_response
+        FOREIGN KEY (response_id)
+        REFERENCES responses(id)
+        ON DELETE SET NULL
+);
+
+-- =====================================================
+-- Table: form_grants
+-- Per-form roles of admins, on top of their global role
+-- =====================================================
+CREATE TABLE form_grants (
+    form_id UUID NOT NULL,
+    admin_id UUID NOT NULL,
+    role VARCHAR(20) NOT NULL,            -- owner, editor, reviewer, viewer
+    granted_by UUID,                      -- Admin who granted the role
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (form_id, admin_id),
+
+    CONSTRAINT fk_form_grants_form
+        FOREIGN KEY (form_id)
+        REFERENCES forms(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_form_grants_admin
+        FOREIGN KEY (admin_id)
+        REFERENCES admins(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_form_grants_granted_by
+        FOREIGN KEY (granted_by)
+        REFERENCES admins(id)
+        ON DELETE SET NULL
+);
+
+-- =====================================================
+-- Table: login_attempts
+-- Recent failed logins and lockouts per username and client IP
+-- =====================================================
+CREATE TABLE login_attempts (
+    scope VARCHAR(10) NOT NULL,           -- username, ip
+    key VARCHAR(255) NOT NULL,            -- Attempted username or client IP
+    failures INT NOT NULL DEFAULT 0,      -- Failures since window_start
+    window_start TIMESTAMP NOT NULL,
+    locked_until TIMESTAMP,               -- Set while locked out
+
+    PRIMARY KEY (scope, key)
+);
+
+-- =====================================================
+-- Table: audit_events
+-- Security-relevant events such as lockouts
+-- =====================================================
+CREATE TABLE audit_events (
+    id UUID PRIMARY KEY,
+    event VARCHAR(50) NOT NULL,           -- e.g. login.lockout, login.unlock
+    admin_id UUID,                        -- Admin the event is about
+    actor_id UUID,                        -- Admin who acted, if any
+    subject VARCHAR(255),                 -- e.g. locked username or IP
+    client_ip VARCHAR(45),
+    details JSONB,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_audit_events_admin
+        FOREIGN KEY (admin_id)
+        REFERENCES admins(id)
+        ON DELETE SET NULL,
+
+    CONSTRAINT fk_audit_events_actor
+        FOREIGN KEY (actor_id)
+        REFERENCES admins(id)
+        ON DELETE SET NULL
+);
+
+-- =====================================================
+-- Table: password_resets
+-- One-time password reset tokens issued by owners
+-- =====================================================
+CREATE TABLE password_resets (
+    id UUID PRIMARY KEY,
+    admin_id UUID NOT NULL,               -- Admin whose password is reset
+    token_hash VARCHAR(64) NOT NULL UNIQUE, -- SHA-256 of the token; the token itself is never stored
+    created_by UUID,                      -- Owner who issued the token
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_password_resets_admin
+        FOREIGN KEY (admin_id)
+        REFERENCES admins(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_password_resets_created_by
+        FOREIGN KEY (created_by)
+        REFERENCES admins(id)
+        ON DELETE SET NULL
+);
+
+-- =====================================================
+-- Table: mfa_recovery_codes
+-- One-time codes replacing an authenticator code
+-- =====================================================
+CREATE TABLE mfa_recovery_codes (
+    id UUID PRIMARY KEY,
+    admin_id UUID NOT NULL,
+    code_hash VARCHAR(64) NOT NULL,       -- SHA-256 of the code; the code itself is never stored
+    used_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT fk_mfa_recovery_codes_admin
+        FOREIGN KEY (admin_id)
+        REFERENCES admins(id)
+        ON DELETE CASCADE
+);
+
+-- =====================================================
+-- Table: admin_sessions
+-- Admin logins; each refresh rotates the refresh token
+-- =====================================================
+CREATE TABLE admin_sessions (
+    id UUID PRIMARY KEY,                  -- Carried as the sid claim of access and refresh tokens
+    admin_id UUID NOT NULL,
+    refresh_token_hash VARCHAR(64) NOT NULL UNIQUE, -- SHA-256 of the current refresh token
+    user_agent TEXT,
+    ip_address VARCHAR(64),
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMP NOT NULL DEFAULT NOW(), -- Login or last refresh
+    expires_at TIMESTAMP NOT NULL,        -- Expiry of the current refresh token
+    revoked_at TIMESTAMP,
+    revoke_reason VARCHAR(32),            -- revoked, refresh_token_reuse, admin_deleted, password_changed, password_reset
+
+    CONSTRAINT fk_admin_sessions_admin
+        FOREIGN KEY (admin_id)
+        REFERENCES admins(id)
+        ON DELETE CASCADE
+);
+
 -- =====================================================
 -- Indexes for performance
 -- =====================================================
 CREATE INDEX idx_form_fields_form_id ON form_fields(form_id);
+CREATE INDEX idx_form_fields_section_id ON form_fields(section_id);
 CREATE INDEX idx_responses_form_id ON responses(form_id);
+CREATE INDEX idx_responses_pending_updated_at ON responses(updated_at) WHERE status = 0;
+CREATE INDEX idx_responses_form_email ON responses(form_id, lower(respondent->>'email')); -- Duplicate email checks
+CREATE INDEX idx_responses_form_fingerprint ON responses(form_id, fingerprint, submitted_at) WHERE fingerprint IS NOT NULL; -- Duplicate fingerprint checks
+CREATE UNIQUE INDEX uq_response_flags_response_reason_field ON response_flags(response_id, reason, field_id); -- One flag per reason and field
+CREATE INDEX idx_response_status_history_response_id ON response_status_history(response_id, changed_at);
 CREATE INDEX idx_response_answers_response_id ON response_answers(response_id);
 CREATE INDEX idx_response_answers_field_id ON response_answers(field_id);
 CREATE INDEX idx_response_answers_value ON response_answers USING GIN (value); -- JSONB search
 CREATE INDEX idx_response_answer_vectors_embedding ON response_answer_vectors USING hnsw (embedding vector_cosine_ops); -- Vector similarity
+CREATE UNIQUE INDEX uq_response_answer_vectors_answer_model ON response_answer_vectors(response_answer_id, model_name); -- One vector per answer and model
+CREATE INDEX idx_webhook_subscriptions_form_id ON webhook_subscriptions(form_id);
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries(next_attempt_at) WHERE status = 0; -- Dispatcher polling
+CREATE INDEX idx_webhook_deliveries_subscription ON webhook_deliveries(subscription_id, created_at);
+CREATE INDEX idx_email_jobs_due ON email_jobs(next_attempt_at) WHERE status = 0; -- Email worker polling
+CREATE INDEX idx_form_grants_admin_id ON form_grants(admin_id); -- Forms visible to an admin
+CREATE INDEX idx_audit_events_created_at ON audit_events(created_at); -- Audit log by time
+CREATE INDEX idx_password_resets_admin_id ON password_resets(admin_id);
+CREATE INDEX idx_mfa_recovery_codes_admin_id ON mfa_recovery_codes(admin_id, code_hash); -- Recovery code lookup
+CREATE INDEX idx_admin_sessions_admin_id ON admin_sessions(admin_id) WHERE revoked_at IS NULL; -- Active sessions of an admin
@@ -1,16 +1,51 @@
 package entities
 
 import (
+	"errors"
 	"time"
 
+	"Skillture_Form/internal/domain/enums"
+
 	"github.com/google/uuid"
 )
 
+var (
+	// ErrWeakPassword is returned for a password that fails the password policy
+	ErrWeakPassword = errors.New("password does not meet the password policy")
+
+	// ErrWrongPassword is returned when the current password given to change it is wrong
+	ErrWrongPassword = errors.New("current password is incorrect")
+
+	// ErrInvalidMFACode is returned for a wrong, reused or malformed
+	// authenticator or recovery code
+	ErrInvalidMFACode = errors.New("invalid verification code")
+
+	// ErrMFAEnabled is returned when enrolling an admin that already uses MFA
+	ErrMFAEnabled = errors.New("two-factor authentication is already enabled")
+
+	// ErrMFANotEnabled is returned for MFA operations on an admin without it
+	ErrMFANotEnabled = errors.New("two-factor authentication is not enabled")
+
+	// ErrMFANotEnrolled is returned when confirming MFA before enrolling
+	ErrMFANotEnrolled = errors.New("two-factor enrollment has not been started")
+)
+
 type Admin struct {
 	ID             uuid.UUID `db:"id" json:"id"`
 	Username       string    `db:"username" json:"username"`
 	HashedPassword string    `db:"hashed_password" json:"-"`
 	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+
+	// Role applies to every form; empty limits the admin to forms granted
+	// in form_grants
+	Role enums.AdminRole `db:"role" json:"role,omitempty"`
+
+	// MFASecret is the base32 TOTP secret, set from enrollment on.
+	// MFAEnabledAt is set once the admin confirmed a code from it, and
+	// MFALastCounter is the last accepted time step, refused on reuse.
+	MFASecret      string     `db:"mfa_secret" json:"-"`
+	MFAEnabledAt   *time.Time `db:"mfa_enabled_at" json:"mfa_enabled_at,omitempty"`
+	MFALastCounter *int64     `db:"mfa_last_counter" json:"-"`
 }
 
 // TableName returns the database table name for the entity
@@ -25,6 +60,12 @@ func (a *Admin) HasPassword() bool {
 	return a.HashedPassword != ""
 }
 
+// MFAEnabled checks if logins of the admin need a second factor
+
+func (a *Admin) MFAEnabled() bool {
+	return a.MFAEnabledAt != nil
+}
+
 // CanLogin checks if the admin is allowed to login
 
 func (a *Admin) CanLogin() bool {
@@ -0,0 +1,48 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrSessionRevoked is returned for a token of a revoked, expired or
+	// unknown session
+	ErrSessionRevoked = errors.New("session has been revoked")
+
+	// ErrRefreshTokenReused is returned when a refresh token that was already
+	// rotated is presented again; the session is revoked in response
+	ErrRefreshTokenReused = errors.New("refresh token was already used; session revoked")
+)
+
+// AdminSession is a login of an admin on one device. Every refresh rotates
+// its refresh token; only the hash of the current one is stored.
+type AdminSession struct {
+	ID               uuid.UUID                 `db:"id" json:"id"`
+	AdminID          uuid.UUID                 `db:"admin_id" json:"admin_id"`
+	RefreshTokenHash string                    `db:"refresh_token_hash" json:"-"`
+	UserAgent        string                    `db:"user_agent" json:"user_agent,omitempty"`
+	IPAddress        string                    `db:"ip_address" json:"ip_address,omitempty"`
+	CreatedAt        time.Time                 `db:"created_at" json:"created_at"`
+	LastUsedAt       time.Time                 `db:"last_used_at" json:"last_used_at"` // Login or last refresh
+	ExpiresAt        time.Time                 `db:"expires_at" json:"expires_at"`
+	RevokedAt        *time.Time                `db:"revoked_at" json:"revoked_at,omitempty"`
+	RevokeReason     enums.SessionRevokeReason `db:"revoke_reason" json:"revoke_reason,omitempty"`
+
+	// Current marks the session of the requesting access token
+	Current bool `db:"-" json:"current"`
+}
+
+// TableName returns the DB table name
+func (AdminSession) TableName() string {
+	return "admin_sessions"
+}
+
+// IsActive reports whether the session is neither revoked nor expired at now
+func (s *AdminSession) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && s.ExpiresAt.After(now)
+}
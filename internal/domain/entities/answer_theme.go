@@ -0,0 +1,41 @@
+package entities
+
+import (
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/google/uuid"
+)
+
+// FieldThemes groups the free-text answers of a field into themes by meaning
+type FieldThemes struct {
+	FieldID     uuid.UUID         `json:"field_id"`
+	Model       enums.ModelName   `json:"model"`
+	Total       int               `json:"total"`  // Clustered answers
+	Themes      []*Theme          `json:"themes"` // Largest theme first
+	Assignments []ThemeAssignment `json:"assignments"`
+}
+
+// Theme is one cluster of similar answers
+type Theme struct {
+	ID              int           `json:"id"` // 1-based, in order of size
+	Size            int           `json:"size"`
+	Share           float64       `json:"share"`    // Size / Total
+	Cohesion        float64       `json:"cohesion"` // Mean similarity of the answers to the theme centroid
+	Representatives []ThemeAnswer `json:"representatives"`
+}
+
+// ThemeAnswer is an answer close to the centroid of its theme
+type ThemeAnswer struct {
+	ResponseID uuid.UUID `json:"response_id"`
+	AnswerID   uuid.UUID `json:"answer_id"`
+	Text       string    `json:"text"`
+	Similarity float64   `json:"similarity"` // Cosine similarity to the theme centroid
+}
+
+// ThemeAssignment links a response to the theme of its answer
+type ThemeAssignment struct {
+	ResponseID uuid.UUID `json:"response_id"`
+	AnswerID   uuid.UUID `json:"answer_id"`
+	ThemeID    int       `json:"theme_id"`
+	Similarity float64   `json:"similarity"`
+}
@@ -0,0 +1,26 @@
+package entities
+
+import (
+	"time"
+
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/google/uuid"
+)
+
+// AuditEvent records a security-relevant action for later review
+type AuditEvent struct {
+	ID        uuid.UUID        `db:"id" json:"id"`
+	Event     enums.AuditEvent `db:"event" json:"event"`
+	AdminID   *uuid.UUID       `db:"admin_id" json:"admin_id,omitempty"` // Admin the event is about
+	ActorID   *uuid.UUID       `db:"actor_id" json:"actor_id,omitempty"` // Admin who acted, if any
+	Subject   string           `db:"subject" json:"subject,omitempty"`   // e.g. the locked username or IP
+	ClientIP  string           `db:"client_ip" json:"client_ip,omitempty"`
+	Details   map[string]any   `db:"details" json:"details,omitempty"`
+	CreatedAt time.Time        `db:"created_at" json:"created_at"`
+}
+
+// TableName returns the DB table name
+func (AuditEvent) TableName() string {
+	return "audit_events"
+}
@@ -0,0 +1,47 @@
+package entities
+
+import (
+	"Skillture_Form/internal/stats"
+
+	"github.com/google/uuid"
+)
+
+// SelectionPair counts the responses that selected RowKey in one choice field
+// and ColumnKey in another
+type SelectionPair struct {
+	RowKey    string
+	ColumnKey string
+	Count     int
+}
+
+// Crosstab is a contingency table of two choice fields of a form.
+// Cells[i][j] belongs to Rows[i] and Columns[j].
+type Crosstab struct {
+	FormID        uuid.UUID              `json:"form_id"`
+	RowFieldID    uuid.UUID              `json:"row_field_id"`
+	ColumnFieldID uuid.UUID              `json:"column_field_id"`
+	Language      string                 `json:"language"`
+	Total         int                    `json:"total"`       // Sum of all cells
+	Respondents   int                    `json:"respondents"` // Responses in the table; below Total when a checkbox answer fills several cells
+	Rows          []CrosstabCategory     `json:"rows"`
+	Columns       []CrosstabCategory     `json:"columns"`
+	Cells         [][]CrosstabCell       `json:"cells"`
+	ChiSquare     *stats.ChiSquareResult `json:"chi_square"`                // nil with fewer than two non-empty rows or columns, or a checkbox field
+	ChiSquareNote string                 `json:"chi_square_note,omitempty"` // Why ChiSquare was not computed
+}
+
+// CrosstabCategory is an option of a crosstab field with its marginal total
+type CrosstabCategory struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+	Total int    `json:"total"`
+}
+
+// CrosstabCell is one option pair of a crosstab. Percentages are 0–100.
+type CrosstabCell struct {
+	Count         int     `json:"count"`
+	RowPercent    float64 `json:"row_percent"`    // Share of the row total
+	ColumnPercent float64 `json:"column_percent"` // Share of the column total
+	TotalPercent  float64 `json:"total_percent"`  // Share of the table total
+	Expected      float64 `json:"expected"`       // Expected count under independence
+}
@@ -0,0 +1,57 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidDuplicatePolicy is returned for out-of-range duplicate policy settings
+var ErrInvalidDuplicatePolicy = errors.New("invalid duplicate policy")
+
+// DuplicatePolicy configures how a form treats repeated submissions.
+// The email rule rejects the submission; a repeated client fingerprint and
+// near-duplicate answers are only flagged for review, since a fingerprint
+// can be shared by unrelated respondents.
+type DuplicatePolicy struct {
+	UniqueEmail            bool    `json:"unique_email"`             // One submission per respondent email
+	FingerprintWindowMin   int     `json:"fingerprint_window_min"`   // Flag submissions from a client fingerprint already seen within this many minutes (0 = off)
+	NearDuplicateThreshold float64 `json:"near_duplicate_threshold"` // Flag text answers at least this similar to an earlier answer (0 = off)
+}
+
+// FingerprintWindow returns the fingerprint window as a duration
+func (p *DuplicatePolicy) FingerprintWindow() time.Duration {
+	if p == nil {
+		return 0
+	}
+	return time.Duration(p.FingerprintWindowMin) * time.Minute
+}
+
+// FlagsNearDuplicates reports whether near-duplicate answers are flagged
+func (p *DuplicatePolicy) FlagsNearDuplicates() bool {
+	return p != nil && p.NearDuplicateThreshold > 0
+}
+
+// Response flag reasons
+const (
+	FlagReasonNearDuplicate   = "near_duplicate"
+	FlagReasonSameFingerprint = "same_fingerprint"
+)
+
+// ResponseFlag marks a submitted response for review, e.g. because one of
+// its answers nearly repeats an answer of an earlier response
+type ResponseFlag struct {
+	ID                uuid.UUID  `db:"id" json:"id"`
+	ResponseID        uuid.UUID  `db:"response_id" json:"response_id"`
+	Reason            string     `db:"reason" json:"reason"`
+	FieldID           *uuid.UUID `db:"field_id" json:"field_id,omitempty"`
+	MatchedResponseID *uuid.UUID `db:"matched_response_id" json:"matched_response_id,omitempty"`
+	Similarity        *float64   `db:"similarity" json:"similarity,omitempty"`
+	CreatedAt         time.Time  `db:"created_at" json:"created_at"`
+}
+
+// TableName returns the DB table name
+func (ResponseFlag) TableName() string {
+	return "response_flags"
+}
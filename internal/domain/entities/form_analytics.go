@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/google/uuid"
+)
+
+// FormAnalytics aggregates the submitted responses of a form
+type FormAnalytics struct {
+	FormID      uuid.UUID         `json:"form_id"`
+	Language    string            `json:"language"`    // Language of field and option labels
+	Responses   int               `json:"responses"`   // Submitted responses
+	Submissions []DailyCount      `json:"submissions"` // Submissions per day, oldest first
+	Fields      []*FieldAnalytics `json:"fields"`      // In FieldOrder
+}
+
+// FieldAnalytics summarizes the answers to one field.
+// Options, Number and Dates are only set for the matching field types.
+type FieldAnalytics struct {
+	FieldID  uuid.UUID       `json:"field_id"`
+	Type     enums.FieldType `json:"type"`
+	Label    string          `json:"label"`
+	Answered int             `json:"answered"`  // Non-empty answers
+	Skipped  int             `json:"skipped"`   // Responses without an answer, including hidden fields
+	SkipRate float64         `json:"skip_rate"` // Skipped / Responses
+
+	Options []OptionCount `json:"options,omitempty"` // select, radio, checkbox
+	Number  *NumberStats  `json:"number,omitempty"`  // number
+	Dates   []DailyCount  `json:"dates,omitempty"`   // date: answers per day
+}
+
+// OptionCount is how often an option was selected
+type OptionCount struct {
+	Key   string  `json:"key"`
+	Label string  `json:"label"`
+	Count int     `json:"count"`
+	Share float64 `json:"share"` // Count / answered; checkbox shares can add up to more than 1
+}
+
+// NumberStats describes the distribution of a number field's answers
+type NumberStats struct {
+	Count     int               `json:"count"`
+	Min       float64           `json:"min"`
+	Max       float64           `json:"max"`
+	Mean      float64           `json:"mean"`
+	Median    float64           `json:"median"`
+	Histogram []HistogramBucket `json:"histogram"`
+}
+
+// HistogramBucket counts answers in [From, To); the last bucket includes To
+type HistogramBucket struct {
+	From  float64 `json:"from"`
+	To    float64 `json:"to"`
+	Count int     `json:"count"`
+}
+
+// DailyCount is a number of events on one day (YYYY-MM-DD)
+type DailyCount struct {
+	Date  string `json:"date"`
+	Count int    `json:"count"`
+}
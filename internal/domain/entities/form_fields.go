@@ -12,17 +12,86 @@ import (
 type FormField struct {
 	ID          uuid.UUID         `db:"id" json:"id"`
 	FormID      uuid.UUID         `db:"form_id" json:"form_id"`
-	Label       map[string]string `db:"label" json:"label"`                       // Multilingual labels {"en":"Name","ar":"الاسم"}
-	Placeholder map[string]string `db:"placeholder" json:"placeholder,omitempty"` // Optional multilingual placeholders
-	HelpText    map[string]string `db:"help_text" json:"help_text,omitempty"`     // Optional multilingual help text
-	Required    bool              `db:"required" json:"required"`                 // Indicates if field is mandatory
-	Options     map[string]any    `db:"options" json:"options,omitempty"`         // Only used for select, radio, checkbox
-	FieldOrder  int               `db:"field_order" json:"field_order"`           // Order in the form
-	Type        enums.FieldType   `db:"type" json:"type"`                         // Enum: restricts to allowed field types (text, select, radio, etc.)
+	SectionID   *uuid.UUID        `db:"section_id" json:"section_id,omitempty"`             // Optional section (page) the field belongs to
+	Label       map[string]string `db:"label" json:"label"`                                 // Multilingual labels {"en":"Name","ar":"الاسم"}
+	Placeholder map[string]string `db:"placeholder" json:"placeholder,omitempty"`           // Optional multilingual placeholders
+	HelpText    map[string]string `db:"help_text" json:"help_text,omitempty"`               // Optional multilingual help text
+	Required    bool              `db:"required" json:"required"`                           // Indicates if field is mandatory
+	Options     map[string]any    `db:"options" json:"options,omitempty"`                   // Only used for select, radio, checkbox
+	FieldOrder  int               `db:"field_order" json:"field_order"`                     // Order in the form
+	Type        enums.FieldType   `db:"type" json:"type"`                                   // Enum: restricts to allowed field types (text, select, radio, etc.)
+	Rules       *FieldRules       `db:"validation_rules" json:"validation_rules,omitempty"` // Optional per-type answer constraints
+	ShowIf      *ShowIf           `db:"show_if" json:"show_if,omitempty"`                   // Optional conditional visibility
 	CreatedAt   time.Time         `db:"created_at" json:"created_at"`
 	UpdatedAt   time.Time         `db:"updated_at" json:"updated_at"`
 }
 
+// FieldRules holds optional answer constraints for a field.
+// Each rule only applies to specific field types:
+//   - Min/Max: number
+//   - MinLength/MaxLength/Pattern: text, textarea
+//   - Earliest/Latest (YYYY-MM-DD): date
+//   - MinSelections/MaxSelections: checkbox
+type FieldRules struct {
+	Min           *float64 `json:"min,omitempty"`
+	Max           *float64 `json:"max,omitempty"`
+	MinLength     *int     `json:"min_length,omitempty"`
+	MaxLength     *int     `json:"max_length,omitempty"`
+	Pattern       string   `json:"pattern,omitempty"` // Must match the whole answer
+	Earliest      string   `json:"earliest,omitempty"`
+	Latest        string   `json:"latest,omitempty"`
+	MinSelections *int     `json:"min_selections,omitempty"`
+	MaxSelections *int     `json:"max_selections,omitempty"`
+}
+
+// IsEmpty returns true if no rule is set
+func (r *FieldRules) IsEmpty() bool {
+	return r == nil || *r == FieldRules{}
+}
+
+// ShowIf makes a field visible (and only then required) when its conditions
+// on earlier fields of the same form are met
+type ShowIf struct {
+	Match      string           `json:"match"` // "all" (default) or "any"
+	Conditions []FieldCondition `json:"conditions"`
+}
+
+// FieldCondition compares the answer of another field with a value
+type FieldCondition struct {
+	FieldID  uuid.UUID               `json:"field_id"`
+	Operator enums.ConditionOperator `json:"operator"`
+	Value    any                     `json:"value"`
+}
+
+// Show-if match modes
+const (
+	ShowIfMatchAll = "all"
+	ShowIfMatchAny = "any"
+)
+
+// MatchAny returns true if any single condition is enough to show the field
+func (s *ShowIf) MatchAny() bool {
+	return s != nil && s.Match == ShowIfMatchAny
+}
+
+// DependsOn returns true if any condition references the given field
+func (ff *FormField) DependsOn(fieldID uuid.UUID) bool {
+	if ff.ShowIf == nil {
+		return false
+	}
+	for _, c := range ff.ShowIf.Conditions {
+		if c.FieldID == fieldID {
+			return true
+		}
+	}
+	return false
+}
+
+// IsConditional returns true if the field has show-if conditions
+func (ff *FormField) IsConditional() bool {
+	return ff.ShowIf != nil && len(ff.ShowIf.Conditions) > 0
+}
+
 // Erorrs
 var ErrInvalidFieldType = errors.New("invalid form status")
 var ErrMissingOptions = errors.New("field requires options but none provided")
@@ -38,6 +107,12 @@ func (ff *FormField) HasOptions() bool {
 	return len(ff.Options) > 0
 }
 
+// HasOption checks if key is one of the field's option keys
+func (ff *FormField) HasOption(key string) bool {
+	_, ok := ff.Options[key]
+	return ok
+}
+
 // RequiresOptions returns true if this field type must have options
 func (ff *FormField) RequiresOptions() bool {
 	// Domain-level rule: these field types must have options
@@ -71,41 +146,48 @@ func (ff *FormField) IsRequired() bool {
 	return ff.Required
 }
 
-// GetLabel returns the label in the requested language, defaults to English
+// GetLabel returns the label in the requested language, falling back to
+// English and then to any available language
 func (ff *FormField) GetLabel(lang string) string {
-	if val, ok := ff.Label[lang]; ok && val != "" {
-		return val
+	return localized(ff.Label, lang)
+}
+
+// GetOptionLabel returns the display label of an option key in the requested language.
+// Options map keys to either a plain label or multilingual labels {"en": "...", "ar": "..."};
+// unknown keys are returned as-is.
+func (ff *FormField) GetOptionLabel(key, lang string) string {
+	switch v := ff.Options[key].(type) {
+	case string:
+		if v != "" {
+			return v
+		}
+	case map[string]any:
+		if val := localized(optionTranslations(v), lang); val != "" {
+			return val
+		}
 	}
-	if val, ok := ff.Label["en"]; ok {
-		return val
+	return key
+}
+
+// optionTranslations returns the string translations of a multilingual option label
+func optionTranslations(v map[string]any) map[string]string {
+	out := make(map[string]string, len(v))
+	for lang, label := range v {
+		if s, ok := label.(string); ok {
+			out[lang] = s
+		}
 	}
-	return ""
+	return out
 }
 
-// GetPlaceholder returns the placeholder in the requested language, defaults to English
+// GetPlaceholder returns the placeholder in the requested language, falling back to
+// English and then to any available language
 func (ff *FormField) GetPlaceholder(lang string) string {
-	if ff.Placeholder == nil {
-		return ""
-	}
-	if val, ok := ff.Placeholder[lang]; ok && val != "" {
-		return val
-	}
-	if val, ok := ff.Placeholder["en"]; ok {
-		return val
-	}
-	return ""
+	return localized(ff.Placeholder, lang)
 }
 
-// GetHelpText returns the help text in the requested language, defaults to English
+// GetHelpText returns the help text in the requested language, falling back to
+// English and then to any available language
 func (ff *FormField) GetHelpText(lang string) string {
-	if ff.HelpText == nil {
-		return ""
-	}
-	if val, ok := ff.HelpText[lang]; ok && val != "" {
-		return val
-	}
-	if val, ok := ff.HelpText["en"]; ok {
-		return val
-	}
-	return ""
+	return localized(ff.HelpText, lang)
 }
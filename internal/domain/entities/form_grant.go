@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidRole is returned for an unknown admin role
+var ErrInvalidRole = errors.New("invalid role")
+
+// FormGrant gives an admin a role on a single form, on top of their global role
+type FormGrant struct {
+	FormID    uuid.UUID       `db:"form_id" json:"form_id"`
+	AdminID   uuid.UUID       `db:"admin_id" json:"admin_id"`
+	Username  string          `db:"username" json:"username,omitempty"` // Populated on listing
+	Role      enums.AdminRole `db:"role" json:"role"`
+	GrantedBy *uuid.UUID      `db:"granted_by" json:"granted_by,omitempty"`
+	CreatedAt time.Time       `db:"created_at" json:"created_at"`
+}
+
+// TableName returns the DB table name
+func (FormGrant) TableName() string {
+	return "form_grants"
+}
@@ -0,0 +1,232 @@
+package entities
+
+import (
+	"sort"
+	"strings"
+
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/google/uuid"
+)
+
+// LocalizedForm is a form definition resolved to a single language.
+// Texts fall back to English (then any language) when a translation is missing.
+type LocalizedForm struct {
+	ID          uuid.UUID           `json:"id"`
+	Language    string              `json:"language"`  // Language the texts were resolved to
+	Languages   []string            `json:"languages"` // Languages every text of the form is translated to
+	Title       string              `json:"title"`
+	Description string              `json:"description,omitempty"`
+	Status      enums.FormStatus    `json:"status"`
+	Sections    []*LocalizedSection `json:"sections"`
+	Fields      []*LocalizedField   `json:"fields"` // All fields in FieldOrder; SectionID links them to sections
+}
+
+// LocalizedSection is a section header resolved to a single language
+type LocalizedSection struct {
+	ID           uuid.UUID `json:"id"`
+	Title        string    `json:"title"`
+	Description  string    `json:"description,omitempty"`
+	SectionOrder int       `json:"section_order"`
+}
+
+// LocalizedField is a form field resolved to a single language
+type LocalizedField struct {
+	ID          uuid.UUID         `json:"id"`
+	SectionID   *uuid.UUID        `json:"section_id,omitempty"`
+	Type        enums.FieldType   `json:"type"`
+	Label       string            `json:"label"`
+	Placeholder string            `json:"placeholder,omitempty"`
+	HelpText    string            `json:"help_text,omitempty"`
+	Required    bool              `json:"required"`
+	FieldOrder  int               `json:"field_order"`
+	Options     []LocalizedOption `json:"options,omitempty"` // Ordered by key
+	Rules       *FieldRules       `json:"validation_rules,omitempty"`
+	ShowIf      *ShowIf           `json:"show_if,omitempty"`
+}
+
+// LocalizedOption is a selectable option with its resolved label
+type LocalizedOption struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+}
+
+// TranslationReport lists the texts of a form that are missing in a language.
+// Only texts set in at least one language need a translation; plain string
+// option labels are language-neutral.
+type TranslationReport struct {
+	Language string               `json:"language"`
+	Complete bool                 `json:"complete"`
+	Form     []string             `json:"form"` // Missing form texts: title, description
+	Sections []MissingTranslation `json:"sections"`
+	Fields   []MissingTranslation `json:"fields"`
+}
+
+// MissingTranslation lists the untranslated texts of one section or field,
+// e.g. "label", "placeholder", "help_text" or "options.<key>"
+type MissingTranslation struct {
+	ID      uuid.UUID `json:"id"`
+	Missing []string  `json:"missing"`
+}
+
+// AllFields returns the fields of every section and the unsectioned fields in FieldOrder
+func (d *FormDefinition) AllFields() []*FormField {
+	fields := make([]*FormField, 0, len(d.Fields))
+	for _, s := range d.Sections {
+		fields = append(fields, s.Fields...)
+	}
+	fields = append(fields, d.Fields...)
+
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].FieldOrder < fields[j].FieldOrder })
+	return fields
+}
+
+// Localize resolves every text of the definition to lang
+func (d *FormDefinition) Localize(lang string) *LocalizedForm {
+	lf := &LocalizedForm{
+		ID:          d.Form.ID,
+		Language:    lang,
+		Languages:   d.SupportedLanguages(),
+		Title:       d.Form.GetTitle(lang),
+		Description: d.Form.GetDescription(lang),
+		Status:      d.Form.Status,
+		Sections:    make([]*LocalizedSection, 0, len(d.Sections)),
+		Fields:      []*LocalizedField{},
+	}
+
+	for _, s := range d.Sections {
+		lf.Sections = append(lf.Sections, &LocalizedSection{
+			ID:           s.ID,
+			Title:        s.GetTitle(lang),
+			Description:  s.GetDescription(lang),
+			SectionOrder: s.SectionOrder,
+		})
+	}
+
+	for _, f := range d.AllFields() {
+		field := &LocalizedField{
+			ID:          f.ID,
+			SectionID:   f.SectionID,
+			Type:        f.Type,
+			Label:       f.GetLabel(lang),
+			Placeholder: f.GetPlaceholder(lang),
+			HelpText:    f.GetHelpText(lang),
+			Required:    f.Required,
+			FieldOrder:  f.FieldOrder,
+			Rules:       f.Rules,
+			ShowIf:      f.ShowIf,
+		}
+		for _, key := range f.OptionKeys() {
+			field.Options = append(field.Options, LocalizedOption{Key: key, Label: f.GetOptionLabel(key, lang)})
+		}
+		lf.Fields = append(lf.Fields, field)
+	}
+
+	return lf
+}
+
+// TranslationReport checks which texts of the definition are missing in lang
+func (d *FormDefinition) TranslationReport(lang string) *TranslationReport {
+	report := &TranslationReport{
+		Language: lang,
+		Form:     []string{},
+		Sections: []MissingTranslation{},
+		Fields:   []MissingTranslation{},
+	}
+
+	var form missingTexts
+	form.check("title", d.Form.Title, lang)
+	form.check("description", d.Form.Description, lang)
+	report.Form = append(report.Form, form...)
+
+	for _, s := range d.Sections {
+		var missing missingTexts
+		missing.check("title", s.Title, lang)
+		missing.check("description", s.Description, lang)
+		if len(missing) > 0 {
+			report.Sections = append(report.Sections, MissingTranslation{ID: s.ID, Missing: missing})
+		}
+	}
+
+	for _, f := range d.AllFields() {
+		var missing missingTexts
+		missing.check("label", f.Label, lang)
+		missing.check("placeholder", f.Placeholder, lang)
+		missing.check("help_text", f.HelpText, lang)
+		for _, key := range f.OptionKeys() {
+			if labels, ok := f.Options[key].(map[string]any); ok {
+				missing.check("options."+key, optionTranslations(labels), lang)
+			}
+		}
+		if len(missing) > 0 {
+			report.Fields = append(report.Fields, MissingTranslation{ID: f.ID, Missing: missing})
+		}
+	}
+
+	report.Complete = len(report.Form) == 0 && len(report.Sections) == 0 && len(report.Fields) == 0
+	return report
+}
+
+// SupportedLanguages returns the languages every text of the form is translated to
+func (d *FormDefinition) SupportedLanguages() []string {
+	used := make(map[string]bool)
+	collect := func(values map[string]string) {
+		for lang, v := range values {
+			if strings.TrimSpace(v) != "" {
+				used[lang] = true
+			}
+		}
+	}
+
+	collect(d.Form.Title)
+	collect(d.Form.Description)
+	for _, s := range d.Sections {
+		collect(s.Title)
+		collect(s.Description)
+	}
+	for _, f := range d.AllFields() {
+		collect(f.Label)
+		collect(f.Placeholder)
+		collect(f.HelpText)
+		for _, v := range f.Options {
+			if labels, ok := v.(map[string]any); ok {
+				collect(optionTranslations(labels))
+			}
+		}
+	}
+
+	langs := []string{}
+	for lang := range used {
+		if d.TranslationReport(lang).Complete {
+			langs = append(langs, lang)
+		}
+	}
+	sort.Strings(langs)
+	return langs
+}
+
+// missingTexts collects the names of untranslated texts
+type missingTexts []string
+
+// check records name when values has text in some language but not in lang
+func (m *missingTexts) check(name string, values map[string]string, lang string) {
+	if strings.TrimSpace(values[lang]) != "" {
+		return
+	}
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			*m = append(*m, name)
+			return
+		}
+	}
+}
+
+// OptionKeys returns the option keys in a stable order
+func (ff *FormField) OptionKeys() []string {
+	keys := make([]string, 0, len(ff.Options))
+	for key := range ff.Options {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,101 @@
+package entities_test
+
+import (
+	"reflect"
+	"testing"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/google/uuid"
+)
+
+func newDefinition() (*entities.FormDefinition, *entities.FormField, *entities.FormField) {
+	section := &entities.FormSection{
+		ID:           uuid.New(),
+		Title:        map[string]string{"en": "About you", "ar": "عنك"},
+		SectionOrder: 1,
+	}
+	name := &entities.FormField{
+		ID:          uuid.New(),
+		SectionID:   &section.ID,
+		Type:        enums.FieldTypeText,
+		Label:       map[string]string{"en": "Name", "ar": "الاسم"},
+		Placeholder: map[string]string{"en": "Your name"},
+		FieldOrder:  1,
+	}
+	color := &entities.FormField{
+		ID:    uuid.New(),
+		Type:  enums.FieldTypeRadio,
+		Label: map[string]string{"en": "Color", "ar": "اللون"},
+		Options: map[string]any{
+			"red":  map[string]any{"en": "Red", "ar": "أحمر"},
+			"blue": map[string]any{"en": "Blue"},
+			"n/a":  "N/A",
+		},
+		FieldOrder: 2,
+	}
+
+	def := &entities.FormDefinition{
+		Form: &entities.Form{
+			ID:    uuid.New(),
+			Title: map[string]string{"en": "Survey", "ar": "استبيان"},
+		},
+		Sections: []*entities.SectionDefinition{{FormSection: section, Fields: []*entities.FormField{name}}},
+		Fields:   []*entities.FormField{color},
+	}
+	return def, name, color
+}
+
+func TestFormDefinition_Localize(t *testing.T) {
+	def, name, color := newDefinition()
+
+	lf := def.Localize("ar")
+	if lf.Title != "استبيان" || lf.Sections[0].Title != "عنك" {
+		t.Errorf("expected Arabic title and section, got %q / %q", lf.Title, lf.Sections[0].Title)
+	}
+	if len(lf.Fields) != 2 || lf.Fields[0].ID != name.ID || lf.Fields[1].ID != color.ID {
+		t.Fatalf("expected fields flattened in field order, got %+v", lf.Fields)
+	}
+	if lf.Fields[0].Placeholder != "Your name" {
+		t.Errorf("expected English placeholder fallback, got %q", lf.Fields[0].Placeholder)
+	}
+
+	want := []entities.LocalizedOption{{Key: "blue", Label: "Blue"}, {Key: "n/a", Label: "N/A"}, {Key: "red", Label: "أحمر"}}
+	if !reflect.DeepEqual(lf.Fields[1].Options, want) {
+		t.Errorf("expected options %v, got %v", want, lf.Fields[1].Options)
+	}
+
+	if !reflect.DeepEqual(lf.Languages, []string{"en"}) {
+		t.Errorf("expected only English to be fully supported, got %v", lf.Languages)
+	}
+}
+
+func TestFormDefinition_TranslationReport(t *testing.T) {
+	def, name, color := newDefinition()
+
+	report := def.TranslationReport("ar")
+	if report.Complete {
+		t.Fatal("expected Arabic translation to be incomplete")
+	}
+	want := []entities.MissingTranslation{
+		{ID: name.ID, Missing: []string{"placeholder"}},
+		{ID: color.ID, Missing: []string{"options.blue"}},
+	}
+	if !reflect.DeepEqual(report.Fields, want) {
+		t.Errorf("expected missing %v, got %v", want, report.Fields)
+	}
+	if len(report.Form) != 0 || len(report.Sections) != 0 {
+		t.Errorf("expected form and sections to be translated, got %v / %v", report.Form, report.Sections)
+	}
+
+	// Completing the translations makes Arabic supported
+	name.Placeholder["ar"] = "اسمك"
+	color.Options["blue"] = map[string]any{"en": "Blue", "ar": "أزرق"}
+	if !def.TranslationReport("ar").Complete {
+		t.Error("expected Arabic translation to be complete")
+	}
+	if got := def.SupportedLanguages(); !reflect.DeepEqual(got, []string{"ar", "en"}) {
+		t.Errorf("expected [ar en], got %v", got)
+	}
+}
@@ -0,0 +1,66 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FormSection groups form fields into a page / step of a multi-step form
+type FormSection struct {
+	ID           uuid.UUID         `db:"id" json:"id"`
+	FormID       uuid.UUID         `db:"form_id" json:"form_id"`
+	Title        map[string]string `db:"title" json:"title"`                       // Multilingual titles {"en":"Personal info","ar":"معلومات شخصية"}
+	Description  map[string]string `db:"description" json:"description,omitempty"` // Optional multilingual description
+	SectionOrder int               `db:"section_order" json:"section_order"`       // Order in the form
+	CreatedAt    time.Time         `db:"created_at" json:"created_at"`
+	UpdatedAt    time.Time         `db:"updated_at" json:"updated_at"`
+}
+
+// Domain errors
+var (
+	ErrMissingSectionTitle = errors.New("section title is missing")
+)
+
+// TableName returns the DB table name
+func (FormSection) TableName() string {
+	return "form_sections"
+}
+
+// IsValid validates domain rules
+func (s *FormSection) IsValid() error {
+	if s.FormID == uuid.Nil {
+		return ErrMissingFormID
+	}
+	if len(s.Title) == 0 {
+		return ErrMissingSectionTitle
+	}
+	return nil
+}
+
+// GetTitle returns the title in the requested language, falling back to
+// English and then to any available language
+func (s *FormSection) GetTitle(lang string) string {
+	return localized(s.Title, lang)
+}
+
+// GetDescription returns the description in the requested language, falling back to
+// English and then to any available language
+func (s *FormSection) GetDescription(lang string) string {
+	return localized(s.Description, lang)
+}
+
+// FormDefinition is the full structure of a form used to render it:
+// ordered sections with their nested fields, plus fields without a section
+type FormDefinition struct {
+	Form     *Form                `json:"form"`
+	Sections []*SectionDefinition `json:"sections"`
+	Fields   []*FormField         `json:"fields"` // Fields not assigned to any section
+}
+
+// SectionDefinition is a section with its ordered fields
+type SectionDefinition struct {
+	*FormSection
+	Fields []*FormField `json:"fields"`
+}
@@ -0,0 +1,51 @@
+package entities_test
+
+import (
+	"testing"
+
+	"Skillture_Form/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+func TestFormSection_TableName(t *testing.T) {
+	section := entities.FormSection{}
+	expected := "form_sections"
+
+	if section.TableName() != expected {
+		t.Errorf("expected table name %s, got %s", expected, section.TableName())
+	}
+}
+
+func TestFormSection_IsValid(t *testing.T) {
+	valid := entities.FormSection{
+		FormID: uuid.New(),
+		Title:  map[string]string{"en": "Personal info"},
+	}
+	if err := valid.IsValid(); err != nil {
+		t.Errorf("expected valid section, got %v", err)
+	}
+
+	noForm := entities.FormSection{Title: map[string]string{"en": "Personal info"}}
+	if err := noForm.IsValid(); err != entities.ErrMissingFormID {
+		t.Errorf("expected ErrMissingFormID, got %v", err)
+	}
+
+	noTitle := entities.FormSection{FormID: uuid.New()}
+	if err := noTitle.IsValid(); err != entities.ErrMissingSectionTitle {
+		t.Errorf("expected ErrMissingSectionTitle, got %v", err)
+	}
+}
+
+func TestFormSection_GetTitle(t *testing.T) {
+	section := entities.FormSection{
+		Title: map[string]string{"en": "Personal info", "ar": "معلومات شخصية"},
+	}
+
+	if got := section.GetTitle("ar"); got != "معلومات شخصية" {
+		t.Errorf("expected Arabic title, got %s", got)
+	}
+	if got := section.GetTitle("fr"); got != "Personal info" {
+		t.Errorf("expected English fallback, got %s", got)
+	}
+}
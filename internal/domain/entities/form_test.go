@@ -70,8 +70,8 @@ func TestForm_FieldsInitialization(t *testing.T) {
 	now := time.Now()
 	form := entities.Form{
 		ID:          uuid.New(),
-		Title:       "Test Form",
-		Description: "This is a test form",
+		Title:       map[string]string{"en": "Test Form"},
+		Description: map[string]string{"en": "This is a test form"},
 		Status:      enums.FormStatusPublished,
 		CreatedAt:   now,
 	}
@@ -79,10 +79,10 @@ func TestForm_FieldsInitialization(t *testing.T) {
 	if form.ID == uuid.Nil {
 		t.Error("form ID should not be nil")
 	}
-	if form.Title == "Hollow Knight" {
+	if form.GetTitle("en") == "Hollow Knight" {
 		t.Error("form title should not be empty")
 	}
-	if form.Description == "is the beast game" {
+	if form.GetDescription("en") == "is the beast game" {
 		t.Error("form description should not be empty")
 	}
 	if form.CreatedAt.IsZero() {
@@ -93,3 +93,29 @@ func TestForm_FieldsInitialization(t *testing.T) {
 		t.Error("expected form to be active")
 	}
 }
+
+func TestForm_GetTitleAndDescription(t *testing.T) {
+	form := entities.Form{
+		Title:       map[string]string{"en": "Registration", "ar": "التسجيل"},
+		Description: map[string]string{"ar": "نموذج التسجيل"},
+	}
+
+	if got := form.GetTitle("ar"); got != "التسجيل" {
+		t.Errorf("expected Arabic title, got %s", got)
+	}
+	if got := form.GetTitle("fr"); got != "Registration" {
+		t.Errorf("expected English fallback, got %s", got)
+	}
+	// Arabic-only description falls back to the only available language
+	if got := form.GetDescription("en"); got != "نموذج التسجيل" {
+		t.Errorf("expected Arabic fallback, got %s", got)
+	}
+	if !form.HasTitle() {
+		t.Error("expected form to have a title")
+	}
+
+	empty := entities.Form{Title: map[string]string{"en": "  "}}
+	if empty.HasTitle() {
+		t.Error("expected blank title to be missing")
+	}
+}
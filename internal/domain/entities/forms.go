@@ -3,20 +3,31 @@ package entities
 import (
 	"Skillture_Form/internal/domain/enums"
 	"errors"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Form struct {
-	ID          uuid.UUID        `db:"id" json:"id"`
-	Title       string           `db:"title" json:"title"`
-	Description string           `db:"description" json:"description"`
-	Status      enums.FormStatus `db:"status" json:"status"`
-	CreatedAt   time.Time        `db:"creat_at" json:"creat_at"`
+	ID          uuid.UUID         `db:"id" json:"id"`
+	Title       map[string]string `db:"title" json:"title"`             // Multilingual titles {"en":"Survey","ar":"استبيان"}
+	Description map[string]string `db:"description" json:"description"` // Multilingual descriptions
+	Status      enums.FormStatus  `db:"status" json:"status"`
+	CreatedAt   time.Time         `db:"creat_at" json:"creat_at"`
+
+	// DuplicatePolicy is nil when repeated submissions are allowed
+	DuplicatePolicy *DuplicatePolicy `db:"duplicate_policy" json:"duplicate_policy,omitempty"`
+
+	// Notifications is nil when no emails are sent on submission
+	Notifications *NotificationSettings `db:"notification_settings" json:"notifications,omitempty"`
 }
 
-var ErrInvalidFormStatus = errors.New("invalid form status")
+var (
+	ErrInvalidFormStatus = errors.New("invalid form status")
+	ErrMissingFormTitle  = errors.New("form title is required")
+)
 
 // TableName returns the DB table name
 
@@ -42,3 +53,43 @@ func (f *Form) IsValid() error {
 	}
 	return nil
 }
+
+// HasTitle reports whether the title is set in at least one language
+func (f *Form) HasTitle() bool {
+	return f.GetTitle("en") != ""
+}
+
+// GetTitle returns the title in the requested language, falling back to
+// English and then to any available language
+func (f *Form) GetTitle(lang string) string {
+	return localized(f.Title, lang)
+}
+
+// GetDescription returns the description in the requested language, falling back to
+// English and then to any available language
+func (f *Form) GetDescription(lang string) string {
+	return localized(f.Description, lang)
+}
+
+// localized picks a translation: lang, then "en", then the first non-empty
+// value by language code so the result is stable
+func localized(values map[string]string, lang string) string {
+	if val := strings.TrimSpace(values[lang]); val != "" {
+		return val
+	}
+	if val := strings.TrimSpace(values["en"]); val != "" {
+		return val
+	}
+
+	langs := make([]string, 0, len(values))
+	for l := range values {
+		langs = append(langs, l)
+	}
+	sort.Strings(langs)
+	for _, l := range langs {
+		if val := strings.TrimSpace(values[l]); val != "" {
+			return val
+		}
+	}
+	return ""
+}
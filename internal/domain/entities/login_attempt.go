@@ -0,0 +1,52 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"Skillture_Form/internal/domain/enums"
+)
+
+var (
+	// ErrInvalidCredentials is returned for an unknown username or a wrong password
+	ErrInvalidCredentials = errors.New("invalid username or password")
+
+	// ErrAccountLocked is returned while a username or client IP is locked out
+	ErrAccountLocked = errors.New("too many failed login attempts")
+)
+
+// LoginAttempt counts recent failed logins of a username or client IP
+type LoginAttempt struct {
+	Scope       enums.LoginScope `db:"scope" json:"scope"`
+	Key         string           `db:"key" json:"key"`
+	Failures    int              `db:"failures" json:"failures"`
+	WindowStart time.Time        `db:"window_start" json:"window_start"` // First failure counted
+	LockedUntil *time.Time       `db:"locked_until" json:"locked_until,omitempty"`
+}
+
+// TableName returns the DB table name
+func (LoginAttempt) TableName() string {
+	return "login_attempts"
+}
+
+// LockoutError reports a lockout and when it ends; it matches ErrAccountLocked
+type LockoutError struct {
+	Until time.Time
+}
+
+func (e *LockoutError) Error() string {
+	return ErrAccountLocked.Error()
+}
+
+func (e *LockoutError) Unwrap() error {
+	return ErrAccountLocked
+}
+
+// RetryAfter returns how long until the lockout ends, rounded up to a second
+func (e *LockoutError) RetryAfter(now time.Time) time.Duration {
+	wait := e.Until.Sub(now)
+	if wait <= 0 {
+		return 0
+	}
+	return (wait + time.Second - 1).Truncate(time.Second)
+}
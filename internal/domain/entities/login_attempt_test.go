@@ -0,0 +1,46 @@
+package entities_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+)
+
+func TestLockoutError_MatchesErrAccountLocked(t *testing.T) {
+	var err error = fmt.Errorf("login: %w", &entities.LockoutError{Until: time.Now()})
+
+	if !errors.Is(err, entities.ErrAccountLocked) {
+		t.Errorf("expected lockout to match ErrAccountLocked")
+	}
+
+	var lockout *entities.LockoutError
+	if !errors.As(err, &lockout) {
+		t.Errorf("expected errors.As to find the LockoutError")
+	}
+}
+
+func TestLockoutError_RetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		until    time.Time
+		expected time.Duration
+	}{
+		{"whole seconds", now.Add(90 * time.Second), 90 * time.Second},
+		{"rounds up", now.Add(1500 * time.Millisecond), 2 * time.Second},
+		{"expired", now.Add(-time.Second), 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &entities.LockoutError{Until: tt.until}
+			if got := e.RetryAfter(now); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package entities
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MFARecoveryCode is a one-time code that replaces an authenticator code,
+// for admins who lost their device. Only its hash is stored.
+type MFARecoveryCode struct {
+	ID        uuid.UUID  `db:"id" json:"id"`
+	AdminID   uuid.UUID  `db:"admin_id" json:"admin_id"`
+	CodeHash  string     `db:"code_hash" json:"-"`
+	UsedAt    *time.Time `db:"used_at" json:"used_at,omitempty"`
+	CreatedAt time.Time  `db:"created_at" json:"created_at"`
+}
+
+// TableName returns the DB table name
+func (MFARecoveryCode) TableName() string {
+	return "mfa_recovery_codes"
+}
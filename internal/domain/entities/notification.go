@@ -0,0 +1,64 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidNotificationSettings is returned for invalid notification addresses or templates
+var ErrInvalidNotificationSettings = errors.New("invalid notification settings")
+
+// Notification email kinds
+const (
+	EmailKindConfirmation = "confirmation" // Sent to the respondent
+	EmailKindAdminAlert   = "admin_alert"  // Sent to the form's admin addresses
+)
+
+// NotificationSettings configures the emails sent when a response to a form is
+// submitted. Templates are keyed by language; missing languages fall back to
+// English and then to the built-in templates.
+type NotificationSettings struct {
+	ConfirmRespondent bool                     `json:"confirm_respondent"`     // Email the respondent's address, if given
+	AdminEmails       []string                 `json:"admin_emails"`           // Addresses alerted on every submission
+	AdminLanguage     string                   `json:"admin_language"`         // Language of admin alerts (default en)
+	Confirmation      map[string]EmailTemplate `json:"confirmation,omitempty"` // Respondent confirmation per language
+	AdminAlert        map[string]EmailTemplate `json:"admin_alert,omitempty"`  // Admin alert per language
+}
+
+// EmailTemplate is a text/template subject and plain-text body
+type EmailTemplate struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+// IsEmpty reports whether the settings send no email at all
+func (s *NotificationSettings) IsEmpty() bool {
+	return s == nil || (!s.ConfirmRespondent && len(s.AdminEmails) == 0)
+}
+
+// EmailJob is a rendered notification email queued for delivery. Jobs are
+// written in the transaction that submits the response, so a mail server
+// outage delays the email instead of failing the submission.
+type EmailJob struct {
+	ID            uuid.UUID         `db:"id" json:"id"`
+	ResponseID    *uuid.UUID        `db:"response_id" json:"response_id,omitempty"`
+	Kind          string            `db:"kind" json:"kind"`
+	Recipients    []string          `db:"recipients" json:"recipients"`
+	Subject       string            `db:"subject" json:"subject"`
+	Body          string            `db:"body" json:"body"`
+	Status        enums.EmailStatus `db:"status" json:"status"`
+	Attempts      int               `db:"attempts" json:"attempts"`
+	NextAttemptAt time.Time         `db:"next_attempt_at" json:"next_attempt_at"`
+	LastError     string            `db:"last_error" json:"last_error,omitempty"`
+	CreatedAt     time.Time         `db:"created_at" json:"created_at"`
+	SentAt        *time.Time        `db:"sent_at" json:"sent_at,omitempty"`
+}
+
+// TableName returns the DB table name
+func (EmailJob) TableName() string {
+	return "email_jobs"
+}
@@ -0,0 +1,28 @@
+package entities
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidResetToken is returned for an unknown, used or expired reset token
+var ErrInvalidResetToken = errors.New("invalid or expired reset token")
+
+// PasswordReset is a one-time token that lets an admin set a new password.
+// Only the token's hash is stored.
+type PasswordReset struct {
+	ID        uuid.UUID  `db:"id" json:"id"`
+	AdminID   uuid.UUID  `db:"admin_id" json:"admin_id"`
+	TokenHash string     `db:"token_hash" json:"-"`
+	CreatedBy *uuid.UUID `db:"created_by" json:"created_by,omitempty"` // Owner who issued the token
+	ExpiresAt time.Time  `db:"expires_at" json:"expires_at"`
+	UsedAt    *time.Time `db:"used_at" json:"used_at,omitempty"`
+	CreatedAt time.Time  `db:"created_at" json:"created_at"`
+}
+
+// TableName returns the DB table name
+func (PasswordReset) TableName() string {
+	return "password_resets"
+}
@@ -13,6 +13,9 @@ var (
 	ErrMissingFormID     = errors.New("form ID is missing")
 	ErrMissingRespondent = errors.New("respondent info is missing")
 	ErrInvalidStatus     = errors.New("invalid response status")
+
+	ErrInvalidStatusTransition = errors.New("response status transition not allowed")
+	ErrMissingReviewer         = errors.New("reviewer is missing")
 )
 
 // Response represents a single form submission by a user
@@ -20,9 +23,24 @@ type Response struct {
 	ID          uuid.UUID            `db:"id" json:"id"`
 	FormID      uuid.UUID            `db:"form_id" json:"form_id"`
 	Respondent  map[string]any       `db:"respondent" json:"respondent"` // JSONB: {"email": "...", "name": "...", "phone": "..."}
-	Status      enums.ResponseStatus `db:"status" json:"status"`         // Enum: Pending, Submitted, Reviewed
+	Status      enums.ResponseStatus `db:"status" json:"status"`         // Enum: Pending, Submitted, Reviewed, Accepted, Rejected
 	SubmittedAt time.Time            `db:"submitted_at" json:"submitted_at"`
+	UpdatedAt   time.Time            `db:"updated_at" json:"updated_at"`
 	Answers     []*ResponseAnswer    `json:"answers,omitempty"` // Populated by usecase, not stored in DB
+
+	// Review: the admin who made the last status decision
+	ReviewedBy  *uuid.UUID `db:"reviewed_by" json:"reviewed_by,omitempty"`
+	ReviewedAt  *time.Time `db:"reviewed_at" json:"reviewed_at,omitempty"`
+	ReviewNotes string     `db:"review_notes" json:"review_notes,omitempty"`
+
+	// Fingerprint is the SHA-256 of the submitting client's fingerprint
+	Fingerprint string `db:"fingerprint" json:"-"`
+
+	// Flags are review flags such as near-duplicate answers; populated by usecase
+	Flags []*ResponseFlag `json:"flags,omitempty"`
+
+	// ResumeTokenHash is the SHA-256 of the draft resume token; only set while pending
+	ResumeTokenHash string `db:"resume_token_hash" json:"-"`
 }
 
 // TableName returns the DB table name
@@ -30,6 +48,41 @@ func (Response) TableName() string {
 	return "responses"
 }
 
+// IsDraft reports whether the response is a saved, not yet submitted draft
+func (r *Response) IsDraft() bool {
+	return r.Status == enums.ResponsePending
+}
+
+// Review moves the response to status following the review workflow
+// (Submitted → Reviewed → Accepted/Rejected) and records the reviewer.
+// It returns the history entry describing the change.
+func (r *Response) Review(status enums.ResponseStatus, adminID uuid.UUID, notes string, at time.Time) (*ResponseStatusChange, error) {
+	if adminID == uuid.Nil {
+		return nil, ErrMissingReviewer
+	}
+	if !r.Status.CanTransitionTo(status) {
+		return nil, ErrInvalidStatusTransition
+	}
+
+	change := &ResponseStatusChange{
+		ID:         uuid.New(),
+		ResponseID: r.ID,
+		FromStatus: r.Status,
+		ToStatus:   status,
+		ChangedBy:  &adminID,
+		Notes:      notes,
+		ChangedAt:  at,
+	}
+
+	r.Status = status
+	r.ReviewedBy = &adminID
+	r.ReviewedAt = &at
+	r.ReviewNotes = notes
+	r.UpdatedAt = at
+
+	return change, nil
+}
+
 // GetEmail returns the email of the respondent if exists
 func (r *Response) GetEmail() string {
 	if email, ok := r.Respondent["email"].(string); ok {
@@ -46,6 +99,14 @@ func (r *Response) GetName() string {
 	return ""
 }
 
+// GetLanguage returns the language the respondent chose, if given
+func (r *Response) GetLanguage() string {
+	if lang, ok := r.Respondent["language"].(string); ok {
+		return lang
+	}
+	return ""
+}
+
 // SetEmail sets or updates the email in the respondent JSON
 func (r *Response) SetEmail(email string) {
 	if r.Respondent == nil {
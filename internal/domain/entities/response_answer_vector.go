@@ -50,3 +50,17 @@ func (v *ResponseAnswerVector) IsValid() error {
 
 	return nil
 }
+
+// AnswerMatch is an answer found by semantic search with its response
+// and the cosine similarity to the query (1 = same direction)
+type AnswerMatch struct {
+	Similarity float64         `json:"similarity"`
+	Answer     *ResponseAnswer `json:"answer"`
+	Response   *Response       `json:"response"`
+}
+
+// EmbeddedAnswer is an answer with its stored embedding
+type EmbeddedAnswer struct {
+	Answer    *ResponseAnswer
+	Embedding []float32
+}
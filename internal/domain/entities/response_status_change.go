@@ -0,0 +1,25 @@
+package entities
+
+import (
+	"time"
+
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/google/uuid"
+)
+
+// ResponseStatusChange is one entry of a response's status history
+type ResponseStatusChange struct {
+	ID         uuid.UUID            `db:"id" json:"id"`
+	ResponseID uuid.UUID            `db:"response_id" json:"response_id"`
+	FromStatus enums.ResponseStatus `db:"from_status" json:"from_status"`
+	ToStatus   enums.ResponseStatus `db:"to_status" json:"to_status"`
+	ChangedBy  *uuid.UUID           `db:"changed_by" json:"changed_by,omitempty"` // Admin who made the change; nil if the admin was deleted
+	Notes      string               `db:"notes" json:"notes,omitempty"`
+	ChangedAt  time.Time            `db:"changed_at" json:"changed_at"`
+}
+
+// TableName returns the DB table name
+func (ResponseStatusChange) TableName() string {
+	return "response_status_history"
+}
@@ -101,3 +101,45 @@ func TestResponse_IsValid(t *testing.T) {
 		})
 	}
 }
+
+func TestResponse_ReviewWorkflow(t *testing.T) {
+	adminID := uuid.New()
+	now := time.Now()
+	r := entities.Response{ID: uuid.New(), Status: enums.ResponseSubmitted}
+
+	// Submitted cannot jump straight to a decision
+	if _, err := r.Review(enums.ResponseAccepted, adminID, "", now); err != entities.ErrInvalidStatusTransition {
+		t.Errorf("expected ErrInvalidStatusTransition, got %v", err)
+	}
+
+	change, err := r.Review(enums.ResponseReviewed, adminID, "looks complete", now)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if change.FromStatus != enums.ResponseSubmitted || change.ToStatus != enums.ResponseReviewed {
+		t.Errorf("unexpected change %v -> %v", change.FromStatus, change.ToStatus)
+	}
+	if r.Status != enums.ResponseReviewed || r.ReviewedBy == nil || *r.ReviewedBy != adminID {
+		t.Errorf("expected response reviewed by %s", adminID)
+	}
+
+	if _, err := r.Review(enums.ResponseRejected, adminID, "not eligible", now); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.ReviewNotes != "not eligible" {
+		t.Errorf("expected notes to be recorded, got %q", r.ReviewNotes)
+	}
+
+	// Decisions are final
+	if _, err := r.Review(enums.ResponseAccepted, adminID, "", now); err != entities.ErrInvalidStatusTransition {
+		t.Errorf("expected ErrInvalidStatusTransition, got %v", err)
+	}
+}
+
+func TestResponse_ReviewRequiresReviewer(t *testing.T) {
+	r := entities.Response{Status: enums.ResponseSubmitted}
+
+	if _, err := r.Review(enums.ResponseReviewed, uuid.Nil, "", time.Now()); err != entities.ErrMissingReviewer {
+		t.Errorf("expected ErrMissingReviewer, got %v", err)
+	}
+}
@@ -0,0 +1,88 @@
+package entities
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrInvalidWebhook is returned for an invalid webhook subscription
+	ErrInvalidWebhook = errors.New("invalid webhook subscription")
+	// ErrDeliveryPending is returned when redelivering a delivery that is still being attempted
+	ErrDeliveryPending = errors.New("webhook delivery is still pending")
+)
+
+// WebhookSubscription delivers selected events of a form to a URL.
+// Payloads are signed with Secret using HMAC-SHA256.
+type WebhookSubscription struct {
+	ID        uuid.UUID            `db:"id" json:"id"`
+	FormID    uuid.UUID            `db:"form_id" json:"form_id"`
+	URL       string               `db:"url" json:"url"`
+	Secret    string               `db:"secret" json:"secret,omitempty"` // Only returned when the subscription is created
+	Events    []enums.WebhookEvent `db:"events" json:"events"`
+	Active    bool                 `db:"active" json:"active"`
+	CreatedAt time.Time            `db:"created_at" json:"created_at"`
+	UpdatedAt time.Time            `db:"updated_at" json:"updated_at"`
+}
+
+// TableName returns the DB table name
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscriptions"
+}
+
+// WebhookEvent is something that happened to a form, queued for its subscribers
+// in the same transaction as the change itself
+type WebhookEvent struct {
+	ID        uuid.UUID          `json:"id"`
+	Type      enums.WebhookEvent `json:"type"`
+	FormID    uuid.UUID          `json:"form_id"`
+	CreatedAt time.Time          `json:"created_at"`
+	Data      any                `json:"data"`
+}
+
+// NewWebhookEvent creates an event of a form carrying data (a response or form)
+func NewWebhookEvent(event enums.WebhookEvent, formID uuid.UUID, data any) *WebhookEvent {
+	return &WebhookEvent{
+		ID:        uuid.New(),
+		Type:      event,
+		FormID:    formID,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	}
+}
+
+// Payload returns the JSON body POSTed to subscribers
+func (e *WebhookEvent) Payload() ([]byte, error) {
+	return json.Marshal(e)
+}
+
+// WebhookDelivery is one event queued for one subscription. It is both the
+// outbox entry read by the dispatcher and the delivery log shown to admins.
+type WebhookDelivery struct {
+	ID             uuid.UUID                   `db:"id" json:"id"`
+	SubscriptionID uuid.UUID                   `db:"subscription_id" json:"subscription_id"`
+	EventID        uuid.UUID                   `db:"event_id" json:"event_id"`
+	Event          enums.WebhookEvent          `db:"event" json:"event"`
+	Payload        json.RawMessage             `db:"payload" json:"payload"`
+	Status         enums.WebhookDeliveryStatus `db:"status" json:"status"`
+	Attempts       int                         `db:"attempts" json:"attempts"`
+	NextAttemptAt  time.Time                   `db:"next_attempt_at" json:"next_attempt_at"`
+	LastStatusCode *int                        `db:"last_status_code" json:"last_status_code,omitempty"` // HTTP status of the last attempt; nil if no response
+	LastError      string                      `db:"last_error" json:"last_error,omitempty"`
+	CreatedAt      time.Time                   `db:"created_at" json:"created_at"`
+	DeliveredAt    *time.Time                  `db:"delivered_at" json:"delivered_at,omitempty"`
+
+	// Target of the delivery, loaded with due deliveries for the dispatcher
+	URL    string `json:"-"`
+	Secret string `json:"-"`
+}
+
+// TableName returns the DB table name
+func (WebhookDelivery) TableName() string {
+	return "webhook_deliveries"
+}
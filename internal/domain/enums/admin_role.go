@@ -0,0 +1,49 @@
+package enums
+
+// AdminRole is the access level of an admin, either on every form (the
+// admin's global role) or on a single form (a form grant)
+type AdminRole string
+
+const (
+	// RoleOwner can do everything an editor can, delete forms and manage
+	// access; a global owner also manages admins
+	RoleOwner AdminRole = "owner"
+
+	// RoleEditor can change forms, fields, sections, webhooks and delete responses
+	RoleEditor AdminRole = "editor"
+
+	// RoleReviewer can read responses and record review decisions
+	RoleReviewer AdminRole = "reviewer"
+
+	// RoleViewer can read forms, responses and analytics
+	RoleViewer AdminRole = "viewer"
+)
+
+// adminRoleRanks orders roles; each role includes the permissions of lower ones
+var adminRoleRanks = map[AdminRole]int{
+	RoleViewer:   1,
+	RoleReviewer: 2,
+	RoleEditor:   3,
+	RoleOwner:    4,
+}
+
+// IsValid checks if the AdminRole is a known role
+func (r AdminRole) IsValid() bool {
+	_, ok := adminRoleRanks[r]
+	return ok
+}
+
+// Includes reports whether r has at least the permissions of required.
+// The empty role includes nothing.
+func (r AdminRole) Includes(required AdminRole) bool {
+	rank, ok := adminRoleRanks[r]
+	return ok && rank >= adminRoleRanks[required]
+}
+
+// Max returns the higher of two roles
+func (r AdminRole) Max(other AdminRole) AdminRole {
+	if adminRoleRanks[other] > adminRoleRanks[r] {
+		return other
+	}
+	return r
+}
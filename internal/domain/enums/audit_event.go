@@ -0,0 +1,40 @@
+package enums
+
+// AuditEvent names a security-relevant event recorded in the audit log
+type AuditEvent string
+
+const (
+	// AuditLoginLockout is recorded when a username or client IP is locked
+	// out after too many failed logins
+	AuditLoginLockout AuditEvent = "login.lockout"
+
+	// AuditLoginUnlock is recorded when an owner lifts an account lockout
+	AuditLoginUnlock AuditEvent = "login.unlock"
+
+	// AuditPasswordChanged is recorded when an admin changes their own password
+	AuditPasswordChanged AuditEvent = "password.changed"
+
+	// AuditPasswordResetIssued is recorded when an owner issues a reset token
+	AuditPasswordResetIssued AuditEvent = "password.reset_issued"
+
+	// AuditPasswordReset is recorded when a reset token sets a new password
+	AuditPasswordReset AuditEvent = "password.reset"
+
+	// AuditMFAEnabled is recorded when an admin confirms TOTP enrollment
+	AuditMFAEnabled AuditEvent = "mfa.enabled"
+
+	// AuditMFADisabled is recorded when MFA is turned off for an admin
+	AuditMFADisabled AuditEvent = "mfa.disabled"
+
+	// AuditMFARecoveryCodeUsed is recorded when a recovery code replaces an
+	// authenticator code
+	AuditMFARecoveryCodeUsed AuditEvent = "mfa.recovery_code_used"
+
+	// AuditSessionRevoked is recorded when an admin or an owner revokes
+	// sessions
+	AuditSessionRevoked AuditEvent = "session.revoked"
+
+	// AuditRefreshTokenReuse is recorded when a rotated refresh token is
+	// presented again and its session is revoked
+	AuditRefreshTokenReuse AuditEvent = "session.refresh_token_reuse"
+)
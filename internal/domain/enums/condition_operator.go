@@ -0,0 +1,45 @@
+package enums
+
+// ConditionOperator represents how a show-if condition compares an answer
+type ConditionOperator string
+
+const (
+	// ConditionEquals matches when the answer equals the value
+	ConditionEquals ConditionOperator = "equals"
+
+	// ConditionNotEquals matches when the answer is given and differs from the value
+	ConditionNotEquals ConditionOperator = "not_equals"
+
+	// ConditionContains matches text containing the value or checkboxes including the option
+	ConditionContains ConditionOperator = "contains"
+
+	// ConditionGreaterThan matches numbers or dates after the value
+	ConditionGreaterThan ConditionOperator = "greater_than"
+
+	// ConditionLessThan matches numbers or dates before the value
+	ConditionLessThan ConditionOperator = "less_than"
+)
+
+// IsValid returns true if the ConditionOperator is one of the allowed enum values
+func (o ConditionOperator) IsValid() bool {
+	switch o {
+	case ConditionEquals, ConditionNotEquals, ConditionContains, ConditionGreaterThan, ConditionLessThan:
+		return true
+	default:
+		return false
+	}
+}
+
+// AppliesTo returns true if the operator can be used on answers of the given field type
+func (o ConditionOperator) AppliesTo(t FieldType) bool {
+	switch o {
+	case ConditionEquals, ConditionNotEquals:
+		return t.IsValid()
+	case ConditionContains:
+		return t == FieldTypeText || t == FieldTypeTextarea || t == FieldTypeEmail || t == FieldTypeCheckbox
+	case ConditionGreaterThan, ConditionLessThan:
+		return t == FieldTypeNumber || t == FieldTypeDate
+	default:
+		return false
+	}
+}
@@ -0,0 +1,25 @@
+package enums
+
+// EmailStatus is the state of a queued notification email
+type EmailStatus int16
+
+const (
+	// EmailPending is waiting for its next send attempt
+	EmailPending EmailStatus = iota
+
+	// EmailSent was accepted by the mail server
+	EmailSent
+
+	// EmailDead failed every attempt and is no longer retried
+	EmailDead
+)
+
+// IsValid checks if the EmailStatus is allowed
+func (s EmailStatus) IsValid() bool {
+	switch s {
+	case EmailPending, EmailSent, EmailDead:
+		return true
+	default:
+		return false
+	}
+}
@@ -16,3 +16,16 @@ func (fs FormStatus) IsValid() bool {
 	}
 	return false
 }
+
+// ParseFormStatus converts "draft", "published", "closed" or their numeric values to a FormStatus
+func ParseFormStatus(s string) (FormStatus, bool) {
+	switch s {
+	case "draft", "0":
+		return FormStatusDraft, true
+	case "published", "1":
+		return FormStatusPublished, true
+	case "closed", "2":
+		return FormStatusClosed, true
+	}
+	return 0, false
+}
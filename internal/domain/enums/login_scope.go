@@ -0,0 +1,12 @@
+package enums
+
+// LoginScope is what failed login attempts are counted against
+type LoginScope string
+
+const (
+	// LoginScopeUsername counts failures per attempted username
+	LoginScopeUsername LoginScope = "username"
+
+	// LoginScopeIP counts failures per client IP
+	LoginScopeIP LoginScope = "ip"
+)
@@ -12,12 +12,15 @@ const (
 
 	// PlaceholderModel: can be defined later according to project needs
 	PlaceholderModel ModelName = "placeholder"
+
+	// ModelLocalHashing: deterministic feature-hashing embedder that runs offline
+	ModelLocalHashing ModelName = "local-hashing-v1"
 )
 
 // IsValid returns true if the ModelName is one of the allowed enum values
 func (m ModelName) IsValid() bool {
 	switch m {
-	case ModelTextEmbedding3Large, ModelTextEmbedding3Small, PlaceholderModel, "":
+	case ModelTextEmbedding3Large, ModelTextEmbedding3Small, PlaceholderModel, ModelLocalHashing, "":
 		return true
 	default:
 		return false
@@ -12,14 +12,64 @@ const (
 
 	// ResponseReviewed means the submission has been reviewed by admin
 	ResponseReviewed
+
+	// ResponseAccepted means a reviewed submission was accepted
+	ResponseAccepted
+
+	// ResponseRejected means a reviewed submission was rejected
+	ResponseRejected
 )
 
+// responseStatusNames maps statuses to their API names
+var responseStatusNames = map[ResponseStatus]string{
+	ResponsePending:   "pending",
+	ResponseSubmitted: "submitted",
+	ResponseReviewed:  "reviewed",
+	ResponseAccepted:  "accepted",
+	ResponseRejected:  "rejected",
+}
+
+// responseTransitions is the review workflow:
+// Submitted → Reviewed → Accepted / Rejected
+var responseTransitions = map[ResponseStatus][]ResponseStatus{
+	ResponseSubmitted: {ResponseReviewed},
+	ResponseReviewed:  {ResponseAccepted, ResponseRejected},
+}
+
 // IsValid checks if the ResponseStatus is allowed
 func (rs ResponseStatus) IsValid() bool {
 	switch rs {
-	case ResponsePending, ResponseSubmitted, ResponseReviewed:
+	case ResponsePending, ResponseSubmitted, ResponseReviewed, ResponseAccepted, ResponseRejected:
 		return true
 	default:
 		return false
 	}
 }
+
+// String returns the API name of the status
+func (rs ResponseStatus) String() string {
+	if name, ok := responseStatusNames[rs]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// CanTransitionTo reports whether the review workflow allows moving to next
+func (rs ResponseStatus) CanTransitionTo(next ResponseStatus) bool {
+	for _, s := range responseTransitions[rs] {
+		if s == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseResponseStatus converts an API name like "accepted" to a ResponseStatus
+func ParseResponseStatus(name string) (ResponseStatus, bool) {
+	for status, n := range responseStatusNames {
+		if n == name {
+			return status, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,23 @@
+package enums
+
+// SessionRevokeReason records why an admin session was ended
+type SessionRevokeReason string
+
+const (
+	// SessionRevokedByAdmin is set when the admin or an owner revokes a session
+	SessionRevokedByAdmin SessionRevokeReason = "revoked"
+
+	// SessionRevokedReuse is set when a rotated refresh token was presented
+	// again, which means it may have been stolen
+	SessionRevokedReuse SessionRevokeReason = "refresh_token_reuse"
+
+	// SessionRevokedAdminDeleted is set when the admin is deleted
+	SessionRevokedAdminDeleted SessionRevokeReason = "admin_deleted"
+
+	// SessionRevokedPasswordChanged is set on the admin's other sessions when
+	// they change their password
+	SessionRevokedPasswordChanged SessionRevokeReason = "password_changed"
+
+	// SessionRevokedPasswordReset is set when a reset token sets a new password
+	SessionRevokedPasswordReset SessionRevokeReason = "password_reset"
+)
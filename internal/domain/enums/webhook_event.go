@@ -0,0 +1,78 @@
+package enums
+
+// WebhookEvent names an event that can be delivered to webhook subscribers
+type WebhookEvent string
+
+const (
+	// WebhookResponseSubmitted fires when a response is submitted or a draft is finalized
+	WebhookResponseSubmitted WebhookEvent = "response.submitted"
+
+	// WebhookResponseReviewed fires on every review decision of a response
+	WebhookResponseReviewed WebhookEvent = "response.reviewed"
+
+	// WebhookFormPublished fires when a form is published
+	WebhookFormPublished WebhookEvent = "form.published"
+
+	// WebhookFormClosed fires when a form is closed
+	WebhookFormClosed WebhookEvent = "form.closed"
+)
+
+// IsValid checks if the WebhookEvent is a known event
+func (e WebhookEvent) IsValid() bool {
+	switch e {
+	case WebhookResponseSubmitted, WebhookResponseReviewed, WebhookFormPublished, WebhookFormClosed:
+		return true
+	default:
+		return false
+	}
+}
+
+// WebhookDeliveryStatus is the state of a queued webhook delivery
+type WebhookDeliveryStatus int16
+
+const (
+	// WebhookDeliveryPending is waiting for its next attempt
+	WebhookDeliveryPending WebhookDeliveryStatus = iota
+
+	// WebhookDeliveryDelivered was acknowledged with a 2xx response
+	WebhookDeliveryDelivered
+
+	// WebhookDeliveryDead failed every attempt and is no longer retried
+	WebhookDeliveryDead
+)
+
+// webhookDeliveryStatusNames maps delivery statuses to their API names
+var webhookDeliveryStatusNames = map[WebhookDeliveryStatus]string{
+	WebhookDeliveryPending:   "pending",
+	WebhookDeliveryDelivered: "delivered",
+	WebhookDeliveryDead:      "dead",
+}
+
+// IsValid checks if the WebhookDeliveryStatus is allowed
+func (s WebhookDeliveryStatus) IsValid() bool {
+	_, ok := webhookDeliveryStatusNames[s]
+	return ok
+}
+
+// String returns the API name of the status
+func (s WebhookDeliveryStatus) String() string {
+	if name, ok := webhookDeliveryStatusNames[s]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// ParseWebhookDeliveryStatus converts an API name like "dead" to a WebhookDeliveryStatus
+func ParseWebhookDeliveryStatus(name string) (WebhookDeliveryStatus, bool) {
+	for status, n := range webhookDeliveryStatusNames {
+		if n == name {
+			return status, true
+		}
+	}
+	return 0, false
+}
+
+// MarshalJSON serializes WebhookDeliveryStatus as a JSON string (e.g., "dead").
+func (s WebhookDeliveryStatus) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + s.String() + `"`), nil
+}
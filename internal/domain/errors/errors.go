@@ -7,6 +7,10 @@ var (
 	ErrNotFound     = errors.New("resource not found")
 	ErrInvalidInput = errors.New("invalid input")
 
+	// Access
+	ErrUnauthenticated = errors.New("authentication required")
+	ErrForbidden       = errors.New("permission denied")
+
 	// Form
 	ErrFormClosed       = errors.New("form is closed")
 	ErrFormNotPublished = errors.New("form is not published")
@@ -14,4 +18,5 @@ var (
 	// Response
 	ErrDuplicateResponse    = errors.New("duplicate response")
 	ErrMissingRequiredField = errors.New("missing required field")
+	ErrInvalidAnswers       = errors.New("invalid answers")
 )
@@ -0,0 +1,75 @@
+// Package embedding turns answer texts into vectors for semantic search.
+package embedding
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"Skillture_Form/internal/config"
+	"Skillture_Form/internal/domain/enums"
+)
+
+// Dimensions is the vector size stored in response_answer_vectors.embedding
+const Dimensions = 1536
+
+// Errors
+var (
+	ErrUnknownModel      = errors.New("unknown embedding model")
+	ErrDimensionMismatch = errors.New("embedding has unexpected dimensions")
+)
+
+// Embedder produces vectors for texts with a specific model
+type Embedder interface {
+	// Model identifies the vectors produced by this embedder
+	Model() enums.ModelName
+
+	// Embed returns one Dimensions-long vector per text, in the same order
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// IsZero reports whether vec has no direction, e.g. the hashing embedding of
+// text without letters or digits. Cosine similarity with it is undefined.
+func IsZero(vec []float32) bool {
+	for _, v := range vec {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Registry looks up embedders by model name
+type Registry map[enums.ModelName]Embedder
+
+// NewRegistry creates a registry of the given embedders
+func NewRegistry(embedders ...Embedder) Registry {
+	r := make(Registry, len(embedders))
+	for _, e := range embedders {
+		r[e.Model()] = e
+	}
+	return r
+}
+
+// Get returns the embedder for a model
+func (r Registry) Get(model enums.ModelName) (Embedder, error) {
+	e, ok := r[model]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownModel, model)
+	}
+	return e, nil
+}
+
+// FromConfig returns the embedder selected by cfg.Model.
+// The local hashing embedder is always available; OpenAI models need an API key.
+func FromConfig(cfg config.EmbeddingConfig) (Embedder, error) {
+	embedders := []Embedder{NewHashingEmbedder()}
+	if cfg.APIKey != "" {
+		embedders = append(embedders,
+			NewOpenAIEmbedder(enums.ModelTextEmbedding3Small, cfg.APIURL, cfg.APIKey, cfg.Timeout()),
+			NewOpenAIEmbedder(enums.ModelTextEmbedding3Large, cfg.APIURL, cfg.APIKey, cfg.Timeout()),
+		)
+	}
+
+	return NewRegistry(embedders...).Get(enums.ModelName(cfg.Model))
+}
@@ -0,0 +1,126 @@
+package embedding_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"Skillture_Form/internal/config"
+	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/embedding"
+
+	"github.com/stretchr/testify/require"
+)
+
+func cosine(a, b []float32) float64 {
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
+
+func TestHashingEmbedder(t *testing.T) {
+	e := embedding.NewHashingEmbedder()
+	require.Equal(t, enums.ModelLocalHashing, e.Model())
+
+	vectors, err := e.Embed(context.Background(), []string{
+		"The delivery was late again",
+		"Late delivery, again!",
+		"Great customer support team",
+		"التوصيل كان متأخرا",
+	})
+	require.NoError(t, err)
+	require.Len(t, vectors, 4)
+	for _, v := range vectors {
+		require.Len(t, v, embedding.Dimensions)
+		require.InDelta(t, 1.0, cosine(v, v), 1e-6)
+	}
+
+	// Similar texts are closer than unrelated ones
+	require.Greater(t, cosine(vectors[0], vectors[1]), cosine(vectors[0], vectors[2]))
+
+	// Deterministic across calls
+	again, err := e.Embed(context.Background(), []string{"The delivery was late again"})
+	require.NoError(t, err)
+	require.Equal(t, vectors[0], again[0])
+
+	// Text without letters or digits has no features
+	empty, err := e.Embed(context.Background(), []string{"?!... :)"})
+	require.NoError(t, err)
+	require.True(t, embedding.IsZero(empty[0]))
+	require.False(t, embedding.IsZero(vectors[0]))
+}
+
+func TestOpenAIEmbedder(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/v1/embeddings", r.URL.Path)
+		require.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		var req struct {
+			Model      string   `json:"model"`
+			Input      []string `json:"input"`
+			Dimensions int      `json:"dimensions"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "text-embedding-3-small", req.Model)
+		require.Equal(t, embedding.Dimensions, req.Dimensions)
+
+		// Answer out of order to check results are matched by index
+		type item struct {
+			Index     int       `json:"index"`
+			Embedding []float32 `json:"embedding"`
+		}
+		data := make([]item, 0, len(req.Input))
+		for i := len(req.Input) - 1; i >= 0; i-- {
+			vec := make([]float32, embedding.Dimensions)
+			vec[0] = float32(i)
+			data = append(data, item{Index: i, Embedding: vec})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+	defer srv.Close()
+
+	e := embedding.NewOpenAIEmbedder(enums.ModelTextEmbedding3Small, srv.URL+"/v1/", "secret", time.Second)
+	vectors, err := e.Embed(context.Background(), []string{"a", "b", "c"})
+	require.NoError(t, err)
+	require.Len(t, vectors, 3)
+	for i, v := range vectors {
+		require.Equal(t, float32(i), v[0])
+	}
+}
+
+func TestOpenAIEmbedder_APIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "rate limited", http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	e := embedding.NewOpenAIEmbedder(enums.ModelTextEmbedding3Small, srv.URL, "secret", time.Second)
+	_, err := e.Embed(context.Background(), []string{"a"})
+
+	var apiErr *embedding.APIError
+	require.True(t, errors.As(err, &apiErr))
+	require.Equal(t, http.StatusTooManyRequests, apiErr.StatusCode)
+}
+
+func TestFromConfig(t *testing.T) {
+	e, err := embedding.FromConfig(config.EmbeddingConfig{Model: "local-hashing-v1", TimeoutSec: 1})
+	require.NoError(t, err)
+	require.Equal(t, enums.ModelLocalHashing, e.Model())
+
+	// OpenAI models need an API key
+	_, err = embedding.FromConfig(config.EmbeddingConfig{Model: "text-embedding-3-small", TimeoutSec: 1})
+	require.ErrorIs(t, err, embedding.ErrUnknownModel)
+
+	e, err = embedding.FromConfig(config.EmbeddingConfig{Model: "text-embedding-3-small", APIKey: "key", TimeoutSec: 1})
+	require.NoError(t, err)
+	require.Equal(t, enums.ModelTextEmbedding3Small, e.Model())
+}
@@ -0,0 +1,91 @@
+package embedding
+
+import (
+	"context"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+
+	"Skillture_Form/internal/domain/enums"
+)
+
+// Feature weights of the hashing embedder
+const (
+	wordWeight    = 1.0
+	trigramWeight = 0.5
+)
+
+// HashingEmbedder is a deterministic, offline embedder based on feature hashing.
+// Words and character trigrams are hashed into Dimensions buckets with a sign
+// bit and the result is L2-normalized, so texts sharing words or word parts
+// get a high cosine similarity. It works for any script (e.g. Arabic) and
+// needs no network access, which makes it suitable for development and tests.
+type HashingEmbedder struct{}
+
+// NewHashingEmbedder creates a hashing embedder
+func NewHashingEmbedder() *HashingEmbedder {
+	return &HashingEmbedder{}
+}
+
+// Model returns the local hashing model name
+func (e *HashingEmbedder) Model() enums.ModelName {
+	return enums.ModelLocalHashing
+}
+
+// Embed hashes every text into a vector
+func (e *HashingEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		vectors[i] = hashText(text)
+	}
+	return vectors, nil
+}
+
+// hashText builds the normalized feature vector of a text.
+// Empty texts produce a zero vector.
+func hashText(text string) []float32 {
+	vec := make([]float64, Dimensions)
+
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+	for _, word := range words {
+		addFeature(vec, "w:"+word, wordWeight)
+
+		runes := []rune("#" + word + "#")
+		for i := 0; i+3 <= len(runes); i++ {
+			addFeature(vec, "c:"+string(runes[i:i+3]), trigramWeight)
+		}
+	}
+
+	var norm float64
+	for _, v := range vec {
+		norm += v * v
+	}
+	norm = math.Sqrt(norm)
+
+	out := make([]float32, Dimensions)
+	if norm == 0 {
+		return out
+	}
+	for i, v := range vec {
+		out[i] = float32(v / norm)
+	}
+	return out
+}
+
+// addFeature adds a signed weight to the bucket the feature hashes to
+func addFeature(vec []float64, feature string, weight float64) {
+	h := fnv.New64a()
+	h.Write([]byte(feature))
+	sum := h.Sum64()
+
+	if sum>>63 == 1 {
+		weight = -weight
+	}
+	vec[sum%uint64(len(vec))] += weight
+}
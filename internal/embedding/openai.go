@@ -0,0 +1,124 @@
+package embedding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"Skillture_Form/internal/domain/enums"
+)
+
+// maxErrorBody limits how much of an error response is kept
+const maxErrorBody = 1024
+
+// OpenAIEmbedder calls an OpenAI-compatible /embeddings endpoint
+type OpenAIEmbedder struct {
+	model   enums.ModelName
+	baseURL string
+	apiKey  string
+	client  *http.Client
+}
+
+// NewOpenAIEmbedder creates an embedder for model served at baseURL (e.g. https://api.openai.com/v1)
+func NewOpenAIEmbedder(model enums.ModelName, baseURL, apiKey string, timeout time.Duration) *OpenAIEmbedder {
+	return &OpenAIEmbedder{
+		model:   model,
+		baseURL: strings.TrimRight(baseURL, "/"),
+		apiKey:  apiKey,
+		client:  &http.Client{Timeout: timeout},
+	}
+}
+
+// Model returns the remote model name
+func (e *OpenAIEmbedder) Model() enums.ModelName {
+	return e.model
+}
+
+type embeddingsRequest struct {
+	Model      string   `json:"model"`
+	Input      []string `json:"input"`
+	Dimensions int      `json:"dimensions"`
+}
+
+type embeddingsResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+// APIError is a non-2xx response of the embeddings API
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("embeddings API returned %d: %s", e.StatusCode, e.Body)
+}
+
+// Embed requests vectors for texts in a single API call
+func (e *OpenAIEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if len(texts) == 0 {
+		return [][]float32{}, nil
+	}
+
+	body, err := json.Marshal(embeddingsRequest{
+		Model:      string(e.model),
+		Input:      texts,
+		Dimensions: Dimensions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.baseURL+"/embeddings", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+e.apiKey)
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("embeddings request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		return nil, &APIError{StatusCode: resp.StatusCode, Body: strings.TrimSpace(string(msg))}
+	}
+
+	var out embeddingsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&out); err != nil {
+		return nil, fmt.Errorf("decode embeddings response: %w", err)
+	}
+	if len(out.Data) != len(texts) {
+		return nil, fmt.Errorf("embeddings response has %d vectors for %d texts", len(out.Data), len(texts))
+	}
+
+	// Results carry their input index; don't rely on response order
+	vectors := make([][]float32, len(texts))
+	for _, d := range out.Data {
+		if d.Index < 0 || d.Index >= len(texts) {
+			return nil, fmt.Errorf("embeddings response has invalid index %d", d.Index)
+		}
+		if len(d.Embedding) != Dimensions {
+			return nil, fmt.Errorf("%w: got %d, want %d", ErrDimensionMismatch, len(d.Embedding), Dimensions)
+		}
+		vectors[d.Index] = d.Embedding
+	}
+	for i, v := range vectors {
+		if v == nil {
+			return nil, fmt.Errorf("embeddings response is missing index %d", i)
+		}
+	}
+
+	return vectors, nil
+}
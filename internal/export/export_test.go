@@ -0,0 +1,112 @@
+package export_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/export"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/xuri/excelize/v2"
+)
+
+func sampleTable() (*export.ResponseTable, *entities.Response) {
+	name := &entities.FormField{
+		ID: uuid.New(), Type: enums.FieldTypeText, FieldOrder: 1,
+		Label: map[string]string{"en": "Full name", "ar": "الاسم الكامل"},
+	}
+	langs := &entities.FormField{
+		ID: uuid.New(), Type: enums.FieldTypeCheckbox, FieldOrder: 2,
+		Label:   map[string]string{"en": "Languages"},
+		Options: map[string]any{"go": "Go", "js": map[string]any{"en": "JavaScript", "ar": "جافاسكربت"}},
+	}
+
+	resp := &entities.Response{
+		ID:          uuid.New(),
+		Status:      enums.ResponseSubmitted,
+		SubmittedAt: time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC),
+		Respondent:  map[string]any{"email": "jane@example.com", "name": "Jane", "city": "Basra"},
+		Answers: []*entities.ResponseAnswer{
+			{FieldID: name.ID, FieldType: name.Type, Value: map[string]any{"text": "=Jane Doe"}},
+			{FieldID: langs.ID, FieldType: langs.Type, Value: map[string]any{"selected": []any{"go", "js"}}},
+		},
+	}
+
+	// Fields passed out of order on purpose
+	table := export.NewResponseTable([]*entities.FormField{langs, name}, []string{"city", "email", "name"}, "ar")
+	return table, resp
+}
+
+func TestResponseTable_HeaderAndRow(t *testing.T) {
+	table, resp := sampleTable()
+
+	require.Equal(t, []string{
+		"response_id", "status", "submitted_at",
+		"respondent_name", "respondent_email", "respondent_city",
+		"الاسم الكامل", "Languages",
+	}, table.Header())
+
+	require.Equal(t, []string{
+		resp.ID.String(), "submitted", "2026-03-01T10:00:00Z",
+		"Jane", "jane@example.com", "Basra",
+		"=Jane Doe", "Go; جافاسكربت",
+	}, table.Row(resp))
+}
+
+func TestCSVWriter_EscapesFormulas(t *testing.T) {
+	table, resp := sampleTable()
+
+	var buf bytes.Buffer
+	w, err := export.NewRowWriter(export.FormatCSV, &buf)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteRow(table.Header()))
+	require.NoError(t, w.WriteRow(table.Row(resp)))
+	require.NoError(t, w.WriteRow([]string{"-12.5"}))
+	require.NoError(t, w.Close())
+
+	reader := csv.NewReader(&buf)
+	reader.FieldsPerRecord = -1
+	records, err := reader.ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	require.Equal(t, "'=Jane Doe", records[1][6])
+	require.Equal(t, "-12.5", records[2][0])
+}
+
+func TestXLSXWriter_WritesRows(t *testing.T) {
+	table, resp := sampleTable()
+
+	var buf bytes.Buffer
+	w, err := export.NewRowWriter(export.FormatXLSX, &buf)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteRow(table.Header()))
+	require.NoError(t, w.WriteRow(table.Row(resp)))
+	require.NoError(t, w.Close())
+
+	f, err := excelize.OpenReader(&buf)
+	require.NoError(t, err)
+	defer f.Close()
+
+	rows, err := f.GetRows("Responses")
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	require.Equal(t, "Go; جافاسكربت", rows[1][7])
+}
+
+func TestParseFormat(t *testing.T) {
+	f, err := export.ParseFormat("")
+	require.NoError(t, err)
+	require.Equal(t, export.FormatCSV, f)
+
+	f, err = export.ParseFormat("XLSX")
+	require.NoError(t, err)
+	require.Equal(t, export.FormatXLSX, f)
+
+	_, err = export.ParseFormat("pdf")
+	require.ErrorIs(t, err, export.ErrUnsupportedFormat)
+}
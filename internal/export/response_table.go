@@ -0,0 +1,130 @@
+package export
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	val "Skillture_Form/internal/validation"
+
+	"github.com/google/uuid"
+)
+
+// multiValueSeparator joins the selections of a checkbox answer in one cell
+const multiValueSeparator = "; "
+
+// ResponseTable maps responses to spreadsheet rows: fixed response columns,
+// one column per respondent key and one column per form field
+type ResponseTable struct {
+	fields         []*entities.FormField
+	respondentKeys []string
+	lang           string
+}
+
+// NewResponseTable builds the column layout for a form.
+// Fields are ordered by FieldOrder; labels and option labels use lang.
+func NewResponseTable(fields []*entities.FormField, respondentKeys []string, lang string) *ResponseTable {
+	ordered := make([]*entities.FormField, len(fields))
+	copy(ordered, fields)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].FieldOrder < ordered[j].FieldOrder })
+
+	return &ResponseTable{
+		fields:         ordered,
+		respondentKeys: orderRespondentKeys(respondentKeys),
+		lang:           lang,
+	}
+}
+
+// Header returns the column titles
+func (t *ResponseTable) Header() []string {
+	header := []string{"response_id", "status", "submitted_at"}
+	for _, key := range t.respondentKeys {
+		header = append(header, "respondent_"+key)
+	}
+	for _, f := range t.fields {
+		header = append(header, f.GetLabel(t.lang))
+	}
+	return header
+}
+
+// Row returns the cells of a response; Answers must be populated
+func (t *ResponseTable) Row(resp *entities.Response) []string {
+	row := []string{
+		resp.ID.String(),
+		resp.Status.String(),
+		resp.SubmittedAt.UTC().Format(time.RFC3339),
+	}
+
+	for _, key := range t.respondentKeys {
+		row = append(row, formatScalar(resp.Respondent[key]))
+	}
+
+	byField := make(map[uuid.UUID]*entities.ResponseAnswer, len(resp.Answers))
+	for _, a := range resp.Answers {
+		byField[a.FieldID] = a
+	}
+	for _, f := range t.fields {
+		row = append(row, FormatAnswer(f, byField[f.ID], t.lang))
+	}
+
+	return row
+}
+
+// FormatAnswer renders an answer as a single line of text, with the labels of
+// selected options in lang; a missing answer is empty
+func FormatAnswer(field *entities.FormField, ans *entities.ResponseAnswer, lang string) string {
+	if ans == nil {
+		return ""
+	}
+
+	switch field.Type {
+	case enums.FieldTypeSelect, enums.FieldTypeRadio, enums.FieldTypeCheckbox:
+		selected := val.AnswerSelections(ans)
+		labels := make([]string, len(selected))
+		for i, key := range selected {
+			labels[i] = field.GetOptionLabel(key, lang)
+		}
+		return strings.Join(labels, multiValueSeparator)
+	default:
+		return val.AnswerText(ans)
+	}
+}
+
+// orderRespondentKeys puts name and email first, then the other keys alphabetically
+func orderRespondentKeys(keys []string) []string {
+	rank := map[string]int{"name": 0, "email": 1}
+	ordered := make([]string, len(keys))
+	copy(ordered, keys)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		ri, okI := rank[ordered[i]]
+		rj, okJ := rank[ordered[j]]
+		switch {
+		case okI && okJ:
+			return ri < rj
+		case okI != okJ:
+			return okI
+		default:
+			return ordered[i] < ordered[j]
+		}
+	})
+	return ordered
+}
+
+// formatScalar renders a respondent value; nested values are written as JSON
+func formatScalar(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	default:
+		b, err := json.Marshal(t)
+		if err != nil {
+			return ""
+		}
+		return string(b)
+	}
+}
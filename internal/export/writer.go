@@ -0,0 +1,152 @@
+// Package export writes form responses as spreadsheet files.
+package export
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// Format is a supported export file format
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// ErrUnsupportedFormat is returned for unknown export formats
+var ErrUnsupportedFormat = errors.New("unsupported export format")
+
+// ParseFormat validates an export format name; an empty name means CSV
+func ParseFormat(name string) (Format, error) {
+	switch Format(strings.ToLower(name)) {
+	case "", FormatCSV:
+		return FormatCSV, nil
+	case FormatXLSX:
+		return FormatXLSX, nil
+	default:
+		return "", ErrUnsupportedFormat
+	}
+}
+
+// ContentType returns the MIME type of the format
+func (f Format) ContentType() string {
+	if f == FormatXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// Extension returns the file extension of the format
+func (f Format) Extension() string {
+	return string(f)
+}
+
+// RowWriter writes a table one row at a time
+type RowWriter interface {
+	WriteRow(cells []string) error
+	// Close flushes any buffered output; it does not close the underlying writer
+	Close() error
+}
+
+// NewRowWriter creates a RowWriter for the format writing to w
+func NewRowWriter(format Format, w io.Writer) (RowWriter, error) {
+	switch format {
+	case FormatCSV:
+		return &csvWriter{w: csv.NewWriter(w)}, nil
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	default:
+		return nil, ErrUnsupportedFormat
+	}
+}
+
+// -------------------
+// CSV
+// -------------------
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvWriter) WriteRow(cells []string) error {
+	safe := make([]string, len(cells))
+	for i, cell := range cells {
+		safe[i] = sanitizeCSVCell(cell)
+	}
+	return c.w.Write(safe)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// sanitizeCSVCell prevents spreadsheet formula injection by prefixing
+// cells that start with a formula character (numbers are left alone)
+func sanitizeCSVCell(cell string) string {
+	if cell == "" {
+		return cell
+	}
+	switch cell[0] {
+	case '=', '+', '-', '@', '\t', '\r':
+		if _, err := strconv.ParseFloat(cell, 64); err == nil {
+			return cell
+		}
+		return "'" + cell
+	}
+	return cell
+}
+
+// -------------------
+// XLSX
+// -------------------
+
+const xlsxSheet = "Responses"
+
+// xlsxWriter uses excelize's stream writer, which spills rows to a
+// temporary file instead of keeping the whole sheet in memory
+type xlsxWriter struct {
+	out  io.Writer
+	file *excelize.File
+	sw   *excelize.StreamWriter
+	row  int
+}
+
+func newXLSXWriter(out io.Writer) (*xlsxWriter, error) {
+	f := excelize.NewFile()
+	if err := f.SetSheetName("Sheet1", xlsxSheet); err != nil {
+		return nil, err
+	}
+	sw, err := f.NewStreamWriter(xlsxSheet)
+	if err != nil {
+		return nil, err
+	}
+	return &xlsxWriter{out: out, file: f, sw: sw}, nil
+}
+
+func (x *xlsxWriter) WriteRow(cells []string) error {
+	x.row++
+	cell, err := excelize.CoordinatesToCellName(1, x.row)
+	if err != nil {
+		return err
+	}
+	values := make([]any, len(cells))
+	for i, c := range cells {
+		values[i] = c
+	}
+	return x.sw.SetRow(cell, values)
+}
+
+func (x *xlsxWriter) Close() error {
+	defer x.file.Close()
+	if err := x.sw.Flush(); err != nil {
+		return err
+	}
+	return x.file.Write(x.out)
+}
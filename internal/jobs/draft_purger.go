@@ -0,0 +1,57 @@
+// Package jobs contains background workers started alongside the HTTP server.
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+// DraftStore is the part of the response use case the purger needs
+type DraftStore interface {
+	PurgeDrafts(ctx context.Context, maxAge time.Duration) (int64, error)
+}
+
+// DraftPurger periodically deletes draft responses that were abandoned
+type DraftPurger struct {
+	drafts   DraftStore
+	maxAge   time.Duration
+	interval time.Duration
+}
+
+// NewDraftPurger creates a purger removing drafts older than maxAge every interval
+func NewDraftPurger(drafts DraftStore, maxAge, interval time.Duration) *DraftPurger {
+	return &DraftPurger{
+		drafts:   drafts,
+		maxAge:   maxAge,
+		interval: interval,
+	}
+}
+
+// Run purges once immediately and then on every tick until ctx is cancelled
+func (p *DraftPurger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.PurgeOnce(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// PurgeOnce runs a single purge and logs the outcome
+func (p *DraftPurger) PurgeOnce(ctx context.Context) {
+	n, err := p.drafts.PurgeDrafts(ctx, p.maxAge)
+	if err != nil {
+		log.Printf("draft purge failed: %v", err)
+		return
+	}
+	if n > 0 {
+		log.Printf("purged %d abandoned draft responses", n)
+	}
+}
@@ -0,0 +1,55 @@
+package jobs
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDraftStore struct {
+	mu    sync.Mutex
+	calls []time.Duration
+}
+
+func (f *fakeDraftStore) PurgeDrafts(_ context.Context, maxAge time.Duration) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, maxAge)
+	return 1, nil
+}
+
+func (f *fakeDraftStore) count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.calls)
+}
+
+func TestDraftPurger_RunPurgesUntilCancelled(t *testing.T) {
+	store := &fakeDraftStore{}
+	purger := NewDraftPurger(store, 48*time.Hour, 5*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		purger.Run(ctx)
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool { return store.count() >= 2 }, time.Second, time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("purger did not stop after cancel")
+	}
+
+	store.mu.Lock()
+	defer store.mu.Unlock()
+	for _, age := range store.calls {
+		require.Equal(t, 48*time.Hour, age)
+	}
+}
@@ -0,0 +1,136 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/notification"
+)
+
+const (
+	// maxEmailBackoff caps the delay between retries of a failed email
+	maxEmailBackoff = 6 * time.Hour
+
+	// maxEmailError caps the error message kept on a job
+	maxEmailError = 1000
+)
+
+// EmailOutbox is the part of the email job repository the dispatcher needs
+type EmailOutbox interface {
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entities.EmailJob, error)
+	Update(ctx context.Context, job *entities.EmailJob) error
+}
+
+// EmailDispatcher polls the email queue and sends due jobs through a Mailer.
+// Jobs are sent one at a time so a batch does not open many SMTP sessions at
+// once. Failed sends are retried with exponential backoff and given up after
+// maxAttempts; a job claimed by a crashed instance is retried once its lease
+// expires.
+type EmailDispatcher struct {
+	outbox      EmailOutbox
+	mailer      notification.Mailer
+	batchSize   int
+	maxAttempts int
+	backoff     time.Duration
+	interval    time.Duration
+	lease       time.Duration
+}
+
+// NewEmailDispatcher creates a dispatcher sending up to batchSize emails
+// every interval. lease must exceed the time needed to send a whole batch.
+func NewEmailDispatcher(
+	outbox EmailOutbox,
+	mailer notification.Mailer,
+	batchSize, maxAttempts int,
+	backoff, interval, lease time.Duration,
+) *EmailDispatcher {
+	return &EmailDispatcher{
+		outbox:      outbox,
+		mailer:      mailer,
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+		interval:    interval,
+		lease:       lease,
+	}
+}
+
+// Run sends due emails on every tick until ctx is cancelled.
+// A full batch is followed immediately by the next one to drain backlogs.
+func (d *EmailDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		if d.DispatchOnce(ctx) == d.batchSize {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchOnce claims one batch of due emails, sends them and returns how many were claimed
+func (d *EmailDispatcher) DispatchOnce(ctx context.Context) int {
+	jobs, err := d.outbox.ClaimDue(ctx, time.Now(), d.lease, d.batchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("claiming email jobs failed: %v", err)
+		}
+		return 0
+	}
+
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			break
+		}
+		d.send(ctx, job)
+	}
+
+	return len(jobs)
+}
+
+// send makes one attempt and records its outcome
+func (d *EmailDispatcher) send(ctx context.Context, job *entities.EmailJob) {
+	err := d.mailer.Send(ctx, &notification.Message{
+		ID:      job.ID.String(),
+		To:      job.Recipients,
+		Subject: job.Subject,
+		Body:    job.Body,
+	})
+	if err != nil && ctx.Err() != nil {
+		// Shutting down: the attempt does not count and is retried after the lease
+		return
+	}
+
+	now := time.Now()
+	job.Attempts++
+
+	switch {
+	case err == nil:
+		job.Status = enums.EmailSent
+		job.SentAt = &now
+		job.LastError = ""
+	case job.Attempts >= d.maxAttempts:
+		job.Status = enums.EmailDead
+		job.LastError = truncate(err.Error(), maxEmailError)
+		log.Printf("email %s (%s) given up after %d attempts: %v", job.ID, job.Kind, job.Attempts, err)
+	default:
+		job.NextAttemptAt = now.Add(exponentialBackoff(d.backoff, job.Attempts-1, maxEmailBackoff))
+		job.LastError = truncate(err.Error(), maxEmailError)
+	}
+
+	if err := d.outbox.Update(ctx, job); err != nil {
+		log.Printf("recording email %s failed: %v", job.ID, err)
+	}
+}
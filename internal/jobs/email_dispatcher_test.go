@@ -0,0 +1,146 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/notification"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryEmailOutbox is an in-memory EmailOutbox
+type memoryEmailOutbox struct {
+	mu   sync.Mutex
+	jobs []*entities.EmailJob
+}
+
+func (o *memoryEmailOutbox) ClaimDue(_ context.Context, now time.Time, lease time.Duration, limit int) ([]*entities.EmailJob, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var due []*entities.EmailJob
+	for _, j := range o.jobs {
+		if len(due) == limit {
+			break
+		}
+		if j.Status == enums.EmailPending && !j.NextAttemptAt.After(now) {
+			j.NextAttemptAt = now.Add(lease)
+			copied := *j
+			due = append(due, &copied)
+		}
+	}
+	return due, nil
+}
+
+func (o *memoryEmailOutbox) Update(_ context.Context, job *entities.EmailJob) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for i, j := range o.jobs {
+		if j.ID == job.ID {
+			copied := *job
+			o.jobs[i] = &copied
+		}
+	}
+	return nil
+}
+
+func (o *memoryEmailOutbox) get(id uuid.UUID) entities.EmailJob {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for _, j := range o.jobs {
+		if j.ID == id {
+			return *j
+		}
+	}
+	return entities.EmailJob{}
+}
+
+func queueEmail(o *memoryEmailOutbox) uuid.UUID {
+	j := &entities.EmailJob{
+		ID:            uuid.New(),
+		Kind:          entities.EmailKindAdminAlert,
+		Recipients:    []string{"admin@example.com"},
+		Subject:       "New response",
+		Body:          "Body",
+		Status:        enums.EmailPending,
+		NextAttemptAt: time.Now().Add(-time.Second),
+	}
+	o.jobs = append(o.jobs, j)
+	return j.ID
+}
+
+func TestEmailDispatcher_SendsDueJobs(t *testing.T) {
+	outbox := &memoryEmailOutbox{}
+	id := queueEmail(outbox)
+	mailer := notification.NewMemoryMailer()
+
+	d := NewEmailDispatcher(outbox, mailer, 10, 3, time.Minute, time.Hour, time.Minute)
+	require.Equal(t, 1, d.DispatchOnce(context.Background()))
+
+	sent := mailer.Messages()
+	require.Len(t, sent, 1)
+	require.Equal(t, id.String(), sent[0].ID)
+	require.Equal(t, []string{"admin@example.com"}, sent[0].To)
+	require.Equal(t, "New response", sent[0].Subject)
+
+	job := outbox.get(id)
+	require.Equal(t, enums.EmailSent, job.Status)
+	require.Equal(t, 1, job.Attempts)
+	require.NotNil(t, job.SentAt)
+
+	require.Equal(t, 0, d.DispatchOnce(context.Background()), "sent jobs are not claimed again")
+}
+
+func TestEmailDispatcher_RetriesThenGivesUp(t *testing.T) {
+	outbox := &memoryEmailOutbox{}
+	id := queueEmail(outbox)
+	mailer := notification.NewMemoryMailer()
+	mailer.Fail(errors.New("connection refused"))
+
+	d := NewEmailDispatcher(outbox, mailer, 10, 2, time.Minute, time.Hour, time.Minute)
+
+	before := time.Now()
+	d.DispatchOnce(context.Background())
+	job := outbox.get(id)
+	require.Equal(t, enums.EmailPending, job.Status, "a mail server outage is retried")
+	require.Equal(t, 1, job.Attempts)
+	require.Equal(t, "connection refused", job.LastError)
+	require.WithinDuration(t, before.Add(time.Minute), job.NextAttemptAt, 5*time.Second)
+
+	require.Equal(t, 0, d.DispatchOnce(context.Background()), "not due before the backoff")
+
+	outbox.jobs[0].NextAttemptAt = time.Now().Add(-time.Second)
+	d.DispatchOnce(context.Background())
+	job = outbox.get(id)
+	require.Equal(t, enums.EmailDead, job.Status)
+	require.Equal(t, 2, job.Attempts)
+}
+
+func TestEmailDispatcher_RecoversAfterOutage(t *testing.T) {
+	outbox := &memoryEmailOutbox{}
+	id := queueEmail(outbox)
+	mailer := notification.NewMemoryMailer()
+	mailer.Fail(errors.New("timeout"))
+
+	d := NewEmailDispatcher(outbox, mailer, 10, 5, time.Minute, time.Hour, time.Minute)
+	d.DispatchOnce(context.Background())
+
+	mailer.Fail(nil)
+	outbox.jobs[0].NextAttemptAt = time.Now().Add(-time.Second)
+	d.DispatchOnce(context.Background())
+
+	job := outbox.get(id)
+	require.Equal(t, enums.EmailSent, job.Status)
+	require.Equal(t, 2, job.Attempts)
+	require.Empty(t, job.LastError)
+	require.Len(t, mailer.Messages(), 1)
+}
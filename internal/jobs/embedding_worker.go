@@ -0,0 +1,99 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxEmbeddingBackoff caps the delay between retries of a failed embedding job
+const maxEmbeddingBackoff = 5 * time.Minute
+
+// ResponseEmbedder is the part of the embedding use case the worker needs
+type ResponseEmbedder interface {
+	EmbedResponse(ctx context.Context, responseID uuid.UUID) (int, error)
+}
+
+// embeddingJob is a queued response and the number of failed attempts so far
+type embeddingJob struct {
+	responseID uuid.UUID
+	attempt    int
+}
+
+// EmbeddingWorker embeds the answers of submitted responses in the background.
+// Failed jobs are retried with exponential backoff; jobs dropped after the last
+// retry or because the queue is full are picked up by the backfill command.
+type EmbeddingWorker struct {
+	embedder   ResponseEmbedder
+	queue      chan embeddingJob
+	maxRetries int
+	backoff    time.Duration
+}
+
+// NewEmbeddingWorker creates a worker with a queue of queueSize responses
+func NewEmbeddingWorker(embedder ResponseEmbedder, queueSize, maxRetries int, backoff time.Duration) *EmbeddingWorker {
+	return &EmbeddingWorker{
+		embedder:   embedder,
+		queue:      make(chan embeddingJob, queueSize),
+		maxRetries: maxRetries,
+		backoff:    backoff,
+	}
+}
+
+// Enqueue schedules a committed response without blocking the caller
+func (w *EmbeddingWorker) Enqueue(responseID uuid.UUID) {
+	w.push(embeddingJob{responseID: responseID})
+}
+
+func (w *EmbeddingWorker) push(job embeddingJob) {
+	select {
+	case w.queue <- job:
+	default:
+		log.Printf("embedding queue full, response %s left for backfill", job.responseID)
+	}
+}
+
+// Run processes queued responses until ctx is cancelled
+func (w *EmbeddingWorker) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case job := <-w.queue:
+			w.process(ctx, job)
+		}
+	}
+}
+
+// process embeds one response and schedules a retry on failure
+func (w *EmbeddingWorker) process(ctx context.Context, job embeddingJob) {
+	_, err := w.embedder.EmbedResponse(ctx, job.responseID)
+	if err == nil {
+		return
+	}
+	if ctx.Err() != nil {
+		return
+	}
+
+	if job.attempt >= w.maxRetries {
+		log.Printf("embedding response %s failed after %d attempts: %v", job.responseID, job.attempt+1, err)
+		return
+	}
+
+	delay := w.retryDelay(job.attempt)
+	log.Printf("embedding response %s failed, retrying in %s: %v", job.responseID, delay, err)
+
+	job.attempt++
+	time.AfterFunc(delay, func() {
+		if ctx.Err() == nil {
+			w.push(job)
+		}
+	})
+}
+
+// retryDelay doubles the backoff with every attempt
+func (w *EmbeddingWorker) retryDelay(attempt int) time.Duration {
+	return exponentialBackoff(w.backoff, attempt, maxEmbeddingBackoff)
+}
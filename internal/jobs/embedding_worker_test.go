@@ -0,0 +1,94 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeResponseEmbedder struct {
+	mu       sync.Mutex
+	failures int // calls failing before the first success
+	calls    int
+	done     chan struct{}
+}
+
+func (f *fakeResponseEmbedder) EmbedResponse(_ context.Context, _ uuid.UUID) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if f.calls <= f.failures {
+		return 0, errors.New("embeddings API unavailable")
+	}
+	close(f.done)
+	return 1, nil
+}
+
+func (f *fakeResponseEmbedder) count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+func TestEmbeddingWorker_RetriesUntilSuccess(t *testing.T) {
+	embedder := &fakeResponseEmbedder{failures: 2, done: make(chan struct{})}
+	worker := NewEmbeddingWorker(embedder, 10, 3, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go worker.Run(ctx)
+
+	worker.Enqueue(uuid.New())
+
+	select {
+	case <-embedder.done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("response was not embedded")
+	}
+	require.Equal(t, 3, embedder.count())
+}
+
+func TestEmbeddingWorker_GivesUpAfterMaxRetries(t *testing.T) {
+	embedder := &fakeResponseEmbedder{failures: 100, done: make(chan struct{})}
+	worker := NewEmbeddingWorker(embedder, 10, 2, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go worker.Run(ctx)
+
+	worker.Enqueue(uuid.New())
+
+	require.Eventually(t, func() bool { return embedder.count() == 3 }, 2*time.Second, time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	require.Equal(t, 3, embedder.count(), "first attempt plus two retries")
+}
+
+func TestEmbeddingWorker_RetryDelay(t *testing.T) {
+	worker := NewEmbeddingWorker(nil, 1, 10, time.Second)
+
+	require.Equal(t, time.Second, worker.retryDelay(0))
+	require.Equal(t, 4*time.Second, worker.retryDelay(2))
+	require.Equal(t, maxEmbeddingBackoff, worker.retryDelay(20))
+}
+
+func TestEmbeddingWorker_EnqueueDoesNotBlockWhenFull(t *testing.T) {
+	worker := NewEmbeddingWorker(nil, 1, 0, time.Second)
+
+	finished := make(chan struct{})
+	go func() {
+		worker.Enqueue(uuid.New())
+		worker.Enqueue(uuid.New())
+		close(finished)
+	}()
+
+	select {
+	case <-finished:
+	case <-time.After(time.Second):
+		t.Fatal("Enqueue blocked on a full queue")
+	}
+}
@@ -0,0 +1,172 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+)
+
+const (
+	// maxWebhookBackoff caps the delay between retries of a failed delivery
+	maxWebhookBackoff = 6 * time.Hour
+
+	// maxWebhookError caps the error message kept in the delivery log
+	maxWebhookError = 1000
+)
+
+// WebhookOutbox is the part of the webhook repository the dispatcher needs
+type WebhookOutbox interface {
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entities.WebhookDelivery, error)
+	UpdateDelivery(ctx context.Context, delivery *entities.WebhookDelivery) error
+}
+
+// WebhookSender posts a signed delivery and returns the HTTP status code,
+// 0 when no response was received
+type WebhookSender interface {
+	Send(ctx context.Context, delivery *entities.WebhookDelivery) (int, error)
+}
+
+// WebhookDispatcher polls the webhook outbox and delivers due events.
+// Failed deliveries are retried with exponential backoff and dead-lettered
+// after maxAttempts; a delivery claimed by a crashed instance is retried once
+// its lease expires.
+type WebhookDispatcher struct {
+	outbox      WebhookOutbox
+	sender      WebhookSender
+	batchSize   int
+	maxAttempts int
+	backoff     time.Duration
+	interval    time.Duration
+	lease       time.Duration
+}
+
+// NewWebhookDispatcher creates a dispatcher sending up to batchSize deliveries
+// concurrently every interval. lease must exceed the sender's request timeout.
+func NewWebhookDispatcher(
+	outbox WebhookOutbox,
+	sender WebhookSender,
+	batchSize, maxAttempts int,
+	backoff, interval, lease time.Duration,
+) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		outbox:      outbox,
+		sender:      sender,
+		batchSize:   batchSize,
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+		interval:    interval,
+		lease:       lease,
+	}
+}
+
+// Run dispatches due deliveries on every tick until ctx is cancelled.
+// A full batch is followed immediately by the next one to drain backlogs.
+func (d *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		if d.DispatchOnce(ctx) == d.batchSize {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchOnce claims one batch of due deliveries, sends them and returns how many were claimed
+func (d *WebhookDispatcher) DispatchOnce(ctx context.Context) int {
+	deliveries, err := d.outbox.ClaimDue(ctx, time.Now(), d.lease, d.batchSize)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("claiming webhook deliveries failed: %v", err)
+		}
+		return 0
+	}
+
+	var wg sync.WaitGroup
+	for _, delivery := range deliveries {
+		wg.Add(1)
+		go func(delivery *entities.WebhookDelivery) {
+			defer wg.Done()
+			d.deliver(ctx, delivery)
+		}(delivery)
+	}
+	wg.Wait()
+
+	return len(deliveries)
+}
+
+// deliver makes one attempt and records its outcome
+func (d *WebhookDispatcher) deliver(ctx context.Context, delivery *entities.WebhookDelivery) {
+	code, err := d.sender.Send(ctx, delivery)
+	if err != nil && ctx.Err() != nil {
+		// Shutting down: the attempt does not count and is retried after the lease
+		return
+	}
+
+	now := time.Now()
+	delivery.Attempts++
+	delivery.LastStatusCode = nil
+	if code != 0 {
+		delivery.LastStatusCode = &code
+	}
+
+	switch {
+	case err == nil:
+		delivery.Status = enums.WebhookDeliveryDelivered
+		delivery.DeliveredAt = &now
+		delivery.LastError = ""
+	case delivery.Attempts >= d.maxAttempts:
+		delivery.Status = enums.WebhookDeliveryDead
+		delivery.LastError = truncate(err.Error(), maxWebhookError)
+		log.Printf("webhook delivery %s dead-lettered after %d attempts: %v", delivery.ID, delivery.Attempts, err)
+	default:
+		delivery.NextAttemptAt = now.Add(d.retryDelay(delivery.Attempts - 1))
+		delivery.LastError = truncate(err.Error(), maxWebhookError)
+	}
+
+	if err := d.outbox.UpdateDelivery(ctx, delivery); err != nil {
+		log.Printf("recording webhook delivery %s failed: %v", delivery.ID, err)
+	}
+}
+
+// retryDelay doubles the backoff with every attempt
+func (d *WebhookDispatcher) retryDelay(attempt int) time.Duration {
+	return exponentialBackoff(d.backoff, attempt, maxWebhookBackoff)
+}
+
+// exponentialBackoff doubles base once per attempt, capped at limit
+func exponentialBackoff(base time.Duration, attempt int, limit time.Duration) time.Duration {
+	delay := base
+	for i := 0; i < attempt && delay < limit; i++ {
+		delay *= 2
+	}
+	if delay > limit {
+		delay = limit
+	}
+	return delay
+}
+
+// truncate shortens s to at most n bytes without splitting a UTF-8 character
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
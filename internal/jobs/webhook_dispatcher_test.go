@@ -0,0 +1,181 @@
+package jobs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/webhook"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryOutbox is an in-memory WebhookOutbox
+type memoryOutbox struct {
+	mu         sync.Mutex
+	deliveries []*entities.WebhookDelivery
+}
+
+func (o *memoryOutbox) ClaimDue(_ context.Context, now time.Time, lease time.Duration, limit int) ([]*entities.WebhookDelivery, error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	var due []*entities.WebhookDelivery
+	for _, d := range o.deliveries {
+		if len(due) == limit {
+			break
+		}
+		if d.Status == enums.WebhookDeliveryPending && !d.NextAttemptAt.After(now) {
+			d.NextAttemptAt = now.Add(lease)
+			copied := *d
+			due = append(due, &copied)
+		}
+	}
+	return due, nil
+}
+
+func (o *memoryOutbox) UpdateDelivery(_ context.Context, delivery *entities.WebhookDelivery) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for i, d := range o.deliveries {
+		if d.ID == delivery.ID {
+			copied := *delivery
+			o.deliveries[i] = &copied
+		}
+	}
+	return nil
+}
+
+func (o *memoryOutbox) get(id uuid.UUID) entities.WebhookDelivery {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	for _, d := range o.deliveries {
+		if d.ID == id {
+			return *d
+		}
+	}
+	return entities.WebhookDelivery{}
+}
+
+func queueDelivery(o *memoryOutbox, url string) uuid.UUID {
+	d := &entities.WebhookDelivery{
+		ID:            uuid.New(),
+		Event:         enums.WebhookFormClosed,
+		Payload:       []byte(`{"type":"form.closed"}`),
+		Status:        enums.WebhookDeliveryPending,
+		NextAttemptAt: time.Now().Add(-time.Second),
+		URL:           url,
+		Secret:        "s3cret",
+	}
+	o.deliveries = append(o.deliveries, d)
+	return d.ID
+}
+
+func TestWebhookDispatcher_DeliversSignedPayload(t *testing.T) {
+	var verified atomic.Bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ts, _ := strconv.ParseInt(r.Header.Get(webhook.HeaderTimestamp), 10, 64)
+		verified.Store(webhook.Verify("s3cret", r.Header.Get(webhook.HeaderSignature), ts, []byte(`{"type":"form.closed"}`)))
+	}))
+	defer srv.Close()
+
+	outbox := &memoryOutbox{}
+	id := queueDelivery(outbox, srv.URL)
+	dispatcher := NewWebhookDispatcher(outbox, webhook.NewClient(time.Second, true), 10, 3, time.Minute, time.Second, time.Minute)
+
+	require.Equal(t, 1, dispatcher.DispatchOnce(context.Background()))
+
+	d := outbox.get(id)
+	require.True(t, verified.Load())
+	require.Equal(t, enums.WebhookDeliveryDelivered, d.Status)
+	require.Equal(t, 1, d.Attempts)
+	require.Equal(t, http.StatusOK, *d.LastStatusCode)
+	require.NotNil(t, d.DeliveredAt)
+
+	require.Zero(t, dispatcher.DispatchOnce(context.Background()), "delivered events are not sent again")
+}
+
+func TestWebhookDispatcher_RetriesWithBackoffThenDeadLetters(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	outbox := &memoryOutbox{}
+	id := queueDelivery(outbox, srv.URL)
+	dispatcher := NewWebhookDispatcher(outbox, webhook.NewClient(time.Second, true), 10, 3, time.Minute, time.Second, time.Minute)
+
+	// First failure is scheduled one backoff later
+	start := time.Now()
+	require.Equal(t, 1, dispatcher.DispatchOnce(context.Background()))
+	d := outbox.get(id)
+	require.Equal(t, enums.WebhookDeliveryPending, d.Status)
+	require.Equal(t, 1, d.Attempts)
+	require.Equal(t, http.StatusServiceUnavailable, *d.LastStatusCode)
+	require.Equal(t, "webhook: endpoint returned 503 Service Unavailable", d.LastError, "only the status is logged")
+	require.WithinDuration(t, start.Add(time.Minute), d.NextAttemptAt, 5*time.Second)
+
+	// Not due yet
+	require.Zero(t, dispatcher.DispatchOnce(context.Background()))
+
+	// Make it due twice more; the third failure dead-letters it
+	for attempt := 2; attempt <= 3; attempt++ {
+		outbox.mu.Lock()
+		outbox.deliveries[0].NextAttemptAt = time.Now().Add(-time.Second)
+		outbox.mu.Unlock()
+		require.Equal(t, 1, dispatcher.DispatchOnce(context.Background()))
+	}
+
+	d = outbox.get(id)
+	require.Equal(t, enums.WebhookDeliveryDead, d.Status)
+	require.Equal(t, 3, d.Attempts)
+	require.EqualValues(t, 3, calls.Load())
+
+	outbox.mu.Lock()
+	outbox.deliveries[0].NextAttemptAt = time.Now().Add(-time.Second)
+	outbox.mu.Unlock()
+	require.Zero(t, dispatcher.DispatchOnce(context.Background()), "dead letters are not retried")
+}
+
+func TestWebhookDispatcher_RecordsConnectionErrors(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	url := srv.URL
+	srv.Close()
+
+	outbox := &memoryOutbox{}
+	id := queueDelivery(outbox, url)
+	dispatcher := NewWebhookDispatcher(outbox, webhook.NewClient(time.Second, true), 10, 5, time.Minute, time.Second, time.Minute)
+
+	require.Equal(t, 1, dispatcher.DispatchOnce(context.Background()))
+
+	d := outbox.get(id)
+	require.Equal(t, enums.WebhookDeliveryPending, d.Status)
+	require.Nil(t, d.LastStatusCode)
+	require.NotEmpty(t, d.LastError)
+}
+
+func TestWebhookDispatcher_RetryDelay(t *testing.T) {
+	dispatcher := NewWebhookDispatcher(nil, nil, 1, 10, 30*time.Second, time.Second, time.Minute)
+
+	require.Equal(t, 30*time.Second, dispatcher.retryDelay(0))
+	require.Equal(t, 2*time.Minute, dispatcher.retryDelay(2))
+	require.Equal(t, maxWebhookBackoff, dispatcher.retryDelay(30))
+}
+
+func TestTruncate(t *testing.T) {
+	require.Equal(t, "abc", truncate("abc", 5))
+	require.Equal(t, "ab", truncate("abc", 2))
+	require.Equal(t, "a", truncate("aé", 2), "multi-byte characters are not split")
+}
@@ -0,0 +1,95 @@
+package notification
+
+import (
+	"net/mail"
+	"strings"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/export"
+
+	"github.com/google/uuid"
+)
+
+// ComposeSubmission renders the emails for a submitted response: a
+// confirmation to the respondent when enabled and an email address was
+// given, and an alert to the form's admin addresses. fields are in display
+// order; unanswered fields are left out. It returns nil when the form sends
+// no email.
+func ComposeSubmission(form *entities.Form, fields []*entities.FormField, response *entities.Response, answers []*entities.ResponseAnswer) ([]*entities.EmailJob, error) {
+	settings := form.Notifications
+	if settings.IsEmpty() {
+		return nil, nil
+	}
+
+	byField := make(map[uuid.UUID]*entities.ResponseAnswer, len(answers))
+	for _, a := range answers {
+		byField[a.FieldID] = a
+	}
+
+	data := func(lang string) *TemplateData {
+		d := &TemplateData{
+			FormTitle:       form.GetTitle(lang),
+			ResponseID:      response.ID.String(),
+			SubmittedAt:     response.SubmittedAt,
+			RespondentName:  response.GetName(),
+			RespondentEmail: response.GetEmail(),
+		}
+		for _, f := range fields {
+			value := export.FormatAnswer(f, byField[f.ID], lang)
+			if value == "" {
+				continue
+			}
+			d.Answers = append(d.Answers, AnswerLine{Label: f.GetLabel(lang), Value: value})
+		}
+		return d
+	}
+
+	job := func(kind string, recipients []string, custom map[string]entities.EmailTemplate, lang string) (*entities.EmailJob, error) {
+		subject, body, err := renderKind(custom, kind, lang, data(lang))
+		if err != nil {
+			return nil, err
+		}
+		responseID := response.ID
+		return &entities.EmailJob{
+			ResponseID: &responseID,
+			Kind:       kind,
+			Recipients: recipients,
+			Subject:    subject,
+			Body:       body,
+			Status:     enums.EmailPending,
+		}, nil
+	}
+
+	var jobs []*entities.EmailJob
+
+	if settings.ConfirmRespondent {
+		if addr, err := mail.ParseAddress(response.GetEmail()); err == nil {
+			lang := language(response.GetLanguage())
+			j, err := job(entities.EmailKindConfirmation, []string{addr.Address}, settings.Confirmation, lang)
+			if err != nil {
+				return nil, err
+			}
+			jobs = append(jobs, j)
+		}
+	}
+
+	if len(settings.AdminEmails) > 0 {
+		j, err := job(entities.EmailKindAdminAlert, settings.AdminEmails, settings.AdminAlert, language(settings.AdminLanguage))
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, j)
+	}
+
+	return jobs, nil
+}
+
+// language normalizes a language tag, defaulting to English
+func language(tag string) string {
+	tag = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(tag)), "_", "-")
+	if tag == "" {
+		return defaultLanguage
+	}
+	return tag
+}
@@ -0,0 +1,168 @@
+package notification_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/notification"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func submission(settings *entities.NotificationSettings, respondent map[string]any) (*entities.Form, []*entities.FormField, *entities.Response, []*entities.ResponseAnswer) {
+	form := &entities.Form{
+		ID:            uuid.New(),
+		Title:         map[string]string{"en": "Feedback", "ar": "ملاحظات"},
+		Notifications: settings,
+	}
+	name := &entities.FormField{
+		ID:    uuid.New(),
+		Type:  enums.FieldTypeText,
+		Label: map[string]string{"en": "Comment", "ar": "تعليق"},
+	}
+	color := &entities.FormField{
+		ID:      uuid.New(),
+		Type:    enums.FieldTypeRadio,
+		Label:   map[string]string{"en": "Color", "ar": "اللون"},
+		Options: map[string]any{"red": map[string]any{"en": "Red", "ar": "أحمر"}},
+	}
+	skipped := &entities.FormField{
+		ID:    uuid.New(),
+		Type:  enums.FieldTypeText,
+		Label: map[string]string{"en": "Skipped"},
+	}
+	response := &entities.Response{
+		ID:          uuid.New(),
+		FormID:      form.ID,
+		Respondent:  respondent,
+		SubmittedAt: time.Date(2025, 3, 4, 10, 30, 0, 0, time.UTC),
+	}
+	answers := []*entities.ResponseAnswer{
+		{FieldID: name.ID, FieldType: name.Type, Value: map[string]any{"text": "Great"}},
+		{FieldID: color.ID, FieldType: color.Type, Value: map[string]any{"selected": "red"}},
+	}
+	return form, []*entities.FormField{name, color, skipped}, response, answers
+}
+
+func TestComposeSubmission_DefaultTemplates(t *testing.T) {
+	settings := &entities.NotificationSettings{
+		ConfirmRespondent: true,
+		AdminEmails:       []string{"admin@example.com"},
+	}
+	form, fields, response, answers := submission(settings, map[string]any{
+		"name": "Sara", "email": "sara@example.com", "language": "ar",
+	})
+
+	jobs, err := notification.ComposeSubmission(form, fields, response, answers)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+
+	confirm := jobs[0]
+	require.Equal(t, entities.EmailKindConfirmation, confirm.Kind)
+	require.Equal(t, []string{"sara@example.com"}, confirm.Recipients)
+	require.Equal(t, response.ID, *confirm.ResponseID)
+	require.Equal(t, enums.EmailPending, confirm.Status)
+	require.Equal(t, "تم استلام ردك على ملاحظات", confirm.Subject, "respondent's language")
+	require.Contains(t, confirm.Body, "مرحباً Sara")
+	require.Contains(t, confirm.Body, "اللون: أحمر")
+	require.NotContains(t, confirm.Body, "Skipped", "unanswered fields are left out")
+
+	alert := jobs[1]
+	require.Equal(t, entities.EmailKindAdminAlert, alert.Kind)
+	require.Equal(t, []string{"admin@example.com"}, alert.Recipients)
+	require.Equal(t, "New response to Feedback", alert.Subject)
+	require.Contains(t, alert.Body, "Email: sara@example.com")
+	require.Contains(t, alert.Body, "Comment: Great")
+	require.Contains(t, alert.Body, "Color: Red")
+	require.Contains(t, alert.Body, "2025-03-04 10:30 UTC")
+}
+
+func TestComposeSubmission_CustomTemplates(t *testing.T) {
+	settings := &entities.NotificationSettings{
+		ConfirmRespondent: true,
+		Confirmation: map[string]entities.EmailTemplate{
+			"en": {Subject: "Thanks {{.RespondentName}}", Body: "{{range .Answers}}{{.Label}}={{.Value}};{{end}}"},
+			"fr": {Subject: "Merci {{.RespondentName}}", Body: "Merci"},
+		},
+	}
+
+	form, fields, response, answers := submission(settings, map[string]any{
+		"name": "Luc", "email": "luc@example.com", "language": "fr-CA",
+	})
+	jobs, err := notification.ComposeSubmission(form, fields, response, answers)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, "Merci Luc", jobs[0].Subject, "primary subtag of the respondent's language")
+
+	response.Respondent["language"] = "de"
+	jobs, err = notification.ComposeSubmission(form, fields, response, answers)
+	require.NoError(t, err)
+	require.Equal(t, "Thanks Luc", jobs[0].Subject, "falls back to English")
+	require.Equal(t, "Comment=Great;Color=Red;", jobs[0].Body)
+}
+
+func TestComposeSubmission_SkipsMissingOrInvalidEmail(t *testing.T) {
+	settings := &entities.NotificationSettings{ConfirmRespondent: true}
+
+	for _, respondent := range []map[string]any{
+		{"name": "No email"},
+		{"email": "not an address"},
+	} {
+		form, fields, response, answers := submission(settings, respondent)
+		jobs, err := notification.ComposeSubmission(form, fields, response, answers)
+		require.NoError(t, err)
+		require.Empty(t, jobs)
+	}
+
+	form, fields, response, answers := submission(nil, map[string]any{"email": "a@example.com"})
+	jobs, err := notification.ComposeSubmission(form, fields, response, answers)
+	require.NoError(t, err)
+	require.Empty(t, jobs, "no notification settings")
+}
+
+func TestComposeSubmission_BrokenTemplateFallsBackToDefault(t *testing.T) {
+	settings := &entities.NotificationSettings{
+		AdminEmails: []string{"admin@example.com"},
+		AdminAlert: map[string]entities.EmailTemplate{
+			"en": {Subject: "{{index .Answers 5}}", Body: "x"},
+		},
+	}
+	form, fields, response, answers := submission(settings, map[string]any{})
+
+	jobs, err := notification.ComposeSubmission(form, fields, response, answers)
+	require.NoError(t, err)
+	require.Len(t, jobs, 1)
+	require.Equal(t, "New response to Feedback", jobs[0].Subject)
+}
+
+func TestRender_SubjectIsSingleLine(t *testing.T) {
+	subject, body, err := notification.Render(entities.EmailTemplate{
+		Subject: "Hello\r\nBcc: victim@example.com {{.RespondentName}}",
+		Body:    "line 1\nline 2",
+	}, &notification.TemplateData{RespondentName: "x\nTo: other@example.com"})
+	require.NoError(t, err)
+	require.False(t, strings.ContainsAny(subject, "\r\n"))
+	require.Equal(t, "line 1\nline 2", body)
+}
+
+func TestValidateTemplates(t *testing.T) {
+	valid := &entities.NotificationSettings{
+		Confirmation: map[string]entities.EmailTemplate{
+			"en": {Subject: "{{.FormTitle}}", Body: "{{date .SubmittedAt}} {{range .Answers}}{{.Label}}{{end}}"},
+		},
+	}
+	require.NoError(t, notification.ValidateTemplates(valid))
+
+	for _, tmpl := range []entities.EmailTemplate{
+		{Subject: "{{.FormTitle", Body: "x"},
+		{Subject: "x", Body: "{{.Unknown}}"},
+		{Subject: "x", Body: "{{upper .FormTitle}}"},
+	} {
+		settings := &entities.NotificationSettings{AdminAlert: map[string]entities.EmailTemplate{"en": tmpl}}
+		require.ErrorIs(t, notification.ValidateTemplates(settings), entities.ErrInvalidNotificationSettings, tmpl.Body)
+	}
+}
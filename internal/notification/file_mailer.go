@@ -0,0 +1,45 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// FileMailer writes each email as an .eml file instead of sending it,
+// for development and for inspecting rendered templates
+type FileMailer struct {
+	dir  string
+	from *mail.Address
+}
+
+// NewFileMailer creates a mailer writing into dir, which is created if needed
+func NewFileMailer(dir, from string) (*FileMailer, error) {
+	addr, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("notification: invalid from address: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileMailer{dir: dir, from: addr}, nil
+}
+
+// Send writes msg to <dir>/<unix nanos>-<id>.eml
+func (m *FileMailer) Send(_ context.Context, msg *Message) error {
+	now := time.Now()
+	data, err := buildMessage(m.from, msg, now)
+	if err != nil {
+		return err
+	}
+
+	id := msg.ID
+	if id == "" {
+		id = "message"
+	}
+	path := filepath.Join(m.dir, fmt.Sprintf("%d-%s.eml", now.UnixNano(), id))
+	return os.WriteFile(path, data, 0o644)
+}
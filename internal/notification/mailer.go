@@ -0,0 +1,122 @@
+// Package notification renders notification emails from per-form templates
+// and sends them through a pluggable Mailer.
+package notification
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+
+	"Skillture_Form/internal/config"
+)
+
+// Message is a plain-text email
+type Message struct {
+	ID      string // Stable across retries; used for the Message-ID header
+	To      []string
+	Subject string
+	Body    string
+}
+
+// Mailer sends emails
+type Mailer interface {
+	Send(ctx context.Context, msg *Message) error
+}
+
+// FromConfig creates the mailer selected by cfg.Driver
+func FromConfig(cfg config.MailConfig) (Mailer, error) {
+	switch cfg.Driver {
+	case "smtp":
+		return NewSMTPMailer(cfg.SMTPHost, cfg.SMTPPort, cfg.SMTPUsername, cfg.SMTPPassword, cfg.From, cfg.Timeout())
+	case "file":
+		return NewFileMailer(cfg.Dir, cfg.From)
+	default:
+		return nil, fmt.Errorf("notification: unknown mail driver %q", cfg.Driver)
+	}
+}
+
+// buildMessage formats msg as an RFC 5322 message with a quoted-printable UTF-8 body
+func buildMessage(from *mail.Address, msg *Message, date time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+
+	header := func(name, value string) {
+		// Header values never span lines, whatever the template produced
+		value = strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+		fmt.Fprintf(&buf, "%s: %s\r\n", name, value)
+	}
+
+	header("From", from.String())
+	header("To", strings.Join(msg.To, ", "))
+	header("Subject", mime.QEncoding.Encode("utf-8", msg.Subject))
+	header("Date", date.Format(time.RFC1123Z))
+	if msg.ID != "" {
+		header("Message-ID", "<"+msg.ID+"@"+messageIDDomain(from)+">")
+	}
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=UTF-8")
+	header("Content-Transfer-Encoding", "quoted-printable")
+	buf.WriteString("\r\n")
+
+	qp := quotedprintable.NewWriter(&buf)
+	body := strings.ReplaceAll(strings.ReplaceAll(msg.Body, "\r\n", "\n"), "\n", "\r\n")
+	if _, err := qp.Write([]byte(body)); err != nil {
+		return nil, err
+	}
+	if err := qp.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// messageIDDomain returns the domain of the sender address
+func messageIDDomain(from *mail.Address) string {
+	if i := strings.LastIndex(from.Address, "@"); i >= 0 {
+		return from.Address[i+1:]
+	}
+	return "localhost"
+}
+
+// MemoryMailer keeps sent messages in memory, for tests
+type MemoryMailer struct {
+	mu       sync.Mutex
+	messages []Message
+	err      error
+}
+
+// NewMemoryMailer creates an empty MemoryMailer
+func NewMemoryMailer() *MemoryMailer {
+	return &MemoryMailer{}
+}
+
+// Send records msg, or returns the error set with Fail
+func (m *MemoryMailer) Send(_ context.Context, msg *Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.err != nil {
+		return m.err
+	}
+	m.messages = append(m.messages, *msg)
+	return nil
+}
+
+// Fail makes every following Send return err; nil makes sending succeed again
+func (m *MemoryMailer) Fail(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.err = err
+}
+
+// Messages returns the messages sent so far
+func (m *MemoryMailer) Messages() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Message(nil), m.messages...)
+}
@@ -0,0 +1,194 @@
+package notification_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"Skillture_Form/internal/notification"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testMessage() *notification.Message {
+	return &notification.Message{
+		ID:      "6f1c7d2e-job",
+		To:      []string{"a@example.com", "b@example.com"},
+		Subject: "تم استلام ردك",
+		Body:    "Hello,\nThanks for your response — مرحباً\n",
+	}
+}
+
+// parseMessage parses a sent message and decodes its subject and body
+func parseMessage(t *testing.T, raw []byte) (*mail.Message, string, string) {
+	t.Helper()
+
+	msg, err := mail.ReadMessage(strings.NewReader(string(raw)))
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+	require.NoError(t, err)
+	return msg, subject, string(body)
+}
+
+func TestFileMailer_WritesEML(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "mail")
+	mailer, err := notification.NewFileMailer(dir, "Forms <no-reply@example.com>")
+	require.NoError(t, err)
+
+	require.NoError(t, mailer.Send(context.Background(), testMessage()))
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	require.True(t, strings.HasSuffix(files[0].Name(), "-6f1c7d2e-job.eml"))
+
+	raw, err := os.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+
+	msg, subject, body := parseMessage(t, raw)
+	require.Equal(t, `"Forms" <no-reply@example.com>`, msg.Header.Get("From"))
+	require.Equal(t, "a@example.com, b@example.com", msg.Header.Get("To"))
+	require.Equal(t, "<6f1c7d2e-job@example.com>", msg.Header.Get("Message-ID"))
+	require.Equal(t, "text/plain; charset=UTF-8", msg.Header.Get("Content-Type"))
+	require.Equal(t, "تم استلام ردك", subject)
+	require.Equal(t, "Hello,\r\nThanks for your response — مرحباً\r\n", body)
+}
+
+func TestNewFileMailer_InvalidFrom(t *testing.T) {
+	_, err := notification.NewFileMailer(t.TempDir(), "not an address")
+	require.Error(t, err)
+}
+
+func TestMemoryMailer(t *testing.T) {
+	mailer := notification.NewMemoryMailer()
+	require.NoError(t, mailer.Send(context.Background(), testMessage()))
+
+	mailer.Fail(errors.New("down"))
+	require.Error(t, mailer.Send(context.Background(), testMessage()))
+
+	mailer.Fail(nil)
+	require.NoError(t, mailer.Send(context.Background(), testMessage()))
+	require.Len(t, mailer.Messages(), 2)
+}
+
+// fakeSMTP is a minimal SMTP server accepting one message per session
+type fakeSMTP struct {
+	addr       string
+	recipients chan []string
+	data       chan []byte
+}
+
+func startFakeSMTP(t *testing.T, rejectRcpt bool) *fakeSMTP {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	srv := &fakeSMTP{addr: ln.Addr().String(), recipients: make(chan []string, 1), data: make(chan []byte, 1)}
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		r := bufio.NewReader(conn)
+		reply := func(line string) { io.WriteString(conn, line+"\r\n") }
+		reply("220 localhost ESMTP")
+
+		var rcpts []string
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			cmd := strings.ToUpper(strings.TrimSpace(line))
+			switch {
+			case strings.HasPrefix(cmd, "EHLO"):
+				reply("250-localhost")
+				reply("250 8BITMIME")
+			case strings.HasPrefix(cmd, "MAIL FROM"):
+				reply("250 OK")
+			case strings.HasPrefix(cmd, "RCPT TO"):
+				if rejectRcpt {
+					reply("550 no such user")
+					continue
+				}
+				rcpts = append(rcpts, strings.Trim(strings.TrimSpace(line)[len("RCPT TO:"):], "<>"))
+				reply("250 OK")
+			case cmd == "DATA":
+				reply("354 go ahead")
+				var data strings.Builder
+				for {
+					l, err := r.ReadString('\n')
+					if err != nil {
+						return
+					}
+					if l == ".\r\n" {
+						break
+					}
+					data.WriteString(strings.TrimPrefix(l, "."))
+				}
+				srv.recipients <- rcpts
+				srv.data <- []byte(data.String())
+				reply("250 queued")
+			case cmd == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 OK")
+			}
+		}
+	}()
+	return srv
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	srv := startFakeSMTP(t, false)
+	host, port, _ := net.SplitHostPort(srv.addr)
+
+	mailer, err := notification.NewSMTPMailer(host, port, "", "", "no-reply@example.com", 5*time.Second)
+	require.NoError(t, err)
+	require.NoError(t, mailer.Send(context.Background(), testMessage()))
+
+	require.Equal(t, []string{"a@example.com", "b@example.com"}, <-srv.recipients)
+	msg, subject, body := parseMessage(t, <-srv.data)
+	require.Equal(t, "<no-reply@example.com>", msg.Header.Get("From"))
+	require.Equal(t, "تم استلام ردك", subject)
+	require.Contains(t, body, "مرحباً")
+}
+
+func TestSMTPMailer_RejectedRecipient(t *testing.T) {
+	srv := startFakeSMTP(t, true)
+	host, port, _ := net.SplitHostPort(srv.addr)
+
+	mailer, err := notification.NewSMTPMailer(host, port, "", "", "no-reply@example.com", 5*time.Second)
+	require.NoError(t, err)
+	require.ErrorContains(t, mailer.Send(context.Background(), testMessage()), "no such user")
+}
+
+func TestSMTPMailer_Unreachable(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	host, port, _ := net.SplitHostPort(ln.Addr().String())
+	ln.Close()
+
+	mailer, err := notification.NewSMTPMailer(host, port, "", "", "no-reply@example.com", time.Second)
+	require.NoError(t, err)
+	require.Error(t, mailer.Send(context.Background(), testMessage()))
+}
@@ -0,0 +1,115 @@
+package notification
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+)
+
+// implicitTLSPort is the SMTPS port, where TLS starts before the SMTP greeting
+const implicitTLSPort = "465"
+
+// SMTPMailer sends emails through an SMTP server. STARTTLS is used whenever
+// the server offers it; credentials are never sent over an unencrypted
+// connection except to localhost.
+type SMTPMailer struct {
+	host     string
+	port     string
+	username string
+	password string
+	from     *mail.Address
+	timeout  time.Duration
+}
+
+// NewSMTPMailer creates a mailer for host:port; username may be empty for servers without auth
+func NewSMTPMailer(host, port, username, password, from string, timeout time.Duration) (*SMTPMailer, error) {
+	addr, err := mail.ParseAddress(from)
+	if err != nil {
+		return nil, fmt.Errorf("notification: invalid from address: %w", err)
+	}
+	return &SMTPMailer{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     addr,
+		timeout:  timeout,
+	}, nil
+}
+
+// Send delivers msg in one SMTP session bounded by the mailer timeout
+func (m *SMTPMailer) Send(ctx context.Context, msg *Message) error {
+	data, err := buildMessage(m.from, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	conn, err := m.dial(ctx)
+	if err != nil {
+		return err
+	}
+	deadline := time.Now().Add(m.timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		conn.Close()
+		return err
+	}
+
+	c, err := smtp.NewClient(conn, m.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if m.port != implicitTLSPort {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(&tls.Config{ServerName: m.host}); err != nil {
+				return err
+			}
+		}
+	}
+	if m.username != "" {
+		if err := c.Auth(smtp.PlainAuth("", m.username, m.password, m.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(m.from.Address); err != nil {
+		return err
+	}
+	for _, to := range msg.To {
+		if err := c.Rcpt(to); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+// dial connects to the server, with TLS from the start on the SMTPS port
+func (m *SMTPMailer) dial(ctx context.Context) (net.Conn, error) {
+	addr := net.JoinHostPort(m.host, m.port)
+	dialer := &net.Dialer{Timeout: m.timeout}
+
+	if m.port == implicitTLSPort {
+		tlsDialer := &tls.Dialer{NetDialer: dialer, Config: &tls.Config{ServerName: m.host}}
+		return tlsDialer.DialContext(ctx, "tcp", addr)
+	}
+	return dialer.DialContext(ctx, "tcp", addr)
+}
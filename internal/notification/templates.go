@@ -0,0 +1,198 @@
+package notification
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+)
+
+// defaultLanguage is used when no template exists in the requested language
+const defaultLanguage = "en"
+
+// TemplateData is what email templates are rendered with
+type TemplateData struct {
+	FormTitle       string
+	ResponseID      string
+	SubmittedAt     time.Time
+	RespondentName  string
+	RespondentEmail string
+	Answers         []AnswerLine
+}
+
+// AnswerLine is one answered field
+type AnswerLine struct {
+	Label string
+	Value string
+}
+
+// templateFuncs are available in every template
+var templateFuncs = template.FuncMap{
+	"date": func(t time.Time) string { return t.UTC().Format("2006-01-02 15:04 UTC") },
+}
+
+// defaultTemplates are used when a form defines no template for an email kind
+var defaultTemplates = map[string]map[string]entities.EmailTemplate{
+	entities.EmailKindConfirmation: {
+		"en": {
+			Subject: `We received your response to {{.FormTitle}}`,
+			Body: `{{if .RespondentName}}Hello {{.RespondentName}},{{else}}Hello,{{end}}
+
+Thank you for completing {{.FormTitle}}. Your response was received on {{date .SubmittedAt}}.
+{{range .Answers}}
+{{.Label}}: {{.Value}}{{end}}
+
+Reference: {{.ResponseID}}
+`,
+		},
+		"ar": {
+			Subject: `تم استلام ردك على {{.FormTitle}}`,
+			Body: `{{if .RespondentName}}مرحباً {{.RespondentName}}،{{else}}مرحباً،{{end}}
+
+شكراً لإكمالك {{.FormTitle}}. تم استلام ردك بتاريخ {{date .SubmittedAt}}.
+{{range .Answers}}
+{{.Label}}: {{.Value}}{{end}}
+
+الرقم المرجعي: {{.ResponseID}}
+`,
+		},
+	},
+	entities.EmailKindAdminAlert: {
+		"en": {
+			Subject: `New response to {{.FormTitle}}`,
+			Body: `A new response to {{.FormTitle}} was submitted on {{date .SubmittedAt}}.
+
+Name: {{.RespondentName}}
+Email: {{.RespondentEmail}}
+{{range .Answers}}
+{{.Label}}: {{.Value}}{{end}}
+
+Response ID: {{.ResponseID}}
+`,
+		},
+		"ar": {
+			Subject: `رد جديد على {{.FormTitle}}`,
+			Body: `تم إرسال رد جديد على {{.FormTitle}} بتاريخ {{date .SubmittedAt}}.
+
+الاسم: {{.RespondentName}}
+البريد الإلكتروني: {{.RespondentEmail}}
+{{range .Answers}}
+{{.Label}}: {{.Value}}{{end}}
+
+معرّف الرد: {{.ResponseID}}
+`,
+		},
+	},
+}
+
+// sampleData is used to check that a template renders before it is saved
+var sampleData = &TemplateData{
+	FormTitle:       "Survey",
+	ResponseID:      "00000000-0000-0000-0000-000000000000",
+	SubmittedAt:     time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+	RespondentName:  "Respondent",
+	RespondentEmail: "respondent@example.com",
+	Answers:         []AnswerLine{{Label: "Question", Value: "Answer"}},
+}
+
+// Render executes an email template. Line breaks in the subject are replaced
+// by spaces so a template cannot inject headers.
+func Render(tmpl entities.EmailTemplate, data *TemplateData) (subject, body string, err error) {
+	subject, err = execute("subject", tmpl.Subject, data)
+	if err != nil {
+		return "", "", err
+	}
+	body, err = execute("body", tmpl.Body, data)
+	if err != nil {
+		return "", "", err
+	}
+
+	subject = strings.Join(strings.Fields(subject), " ")
+	return subject, body, nil
+}
+
+// ValidateTemplates checks that every custom template of the settings parses
+// and renders with sample data
+func ValidateTemplates(settings *entities.NotificationSettings) error {
+	for kind, templates := range map[string]map[string]entities.EmailTemplate{
+		entities.EmailKindConfirmation: settings.Confirmation,
+		entities.EmailKindAdminAlert:   settings.AdminAlert,
+	} {
+		for lang, tmpl := range templates {
+			if _, _, err := Render(tmpl, sampleData); err != nil {
+				return fmt.Errorf("%w: %s template (%s): %v", entities.ErrInvalidNotificationSettings, kind, lang, err)
+			}
+		}
+	}
+	return nil
+}
+
+// execute renders a single template text
+func execute(name, text string, data *TemplateData) (string, error) {
+	t, err := template.New(name).Funcs(templateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", err
+	}
+
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// pickTemplate returns the custom template for lang, falling back to its
+// primary subtag, English and then any language; ok is false when the form defines none
+func pickTemplate(custom map[string]entities.EmailTemplate, lang string) (tmpl entities.EmailTemplate, ok bool) {
+	for _, l := range candidates(lang) {
+		if tmpl, ok := custom[l]; ok {
+			return tmpl, true
+		}
+	}
+
+	langs := make([]string, 0, len(custom))
+	for l := range custom {
+		langs = append(langs, l)
+	}
+	if len(langs) == 0 {
+		return entities.EmailTemplate{}, false
+	}
+	sort.Strings(langs)
+	return custom[langs[0]], true
+}
+
+// defaultTemplate returns the built-in template of kind in lang, or in English
+func defaultTemplate(kind, lang string) entities.EmailTemplate {
+	for _, l := range candidates(lang) {
+		if tmpl, ok := defaultTemplates[kind][l]; ok {
+			return tmpl
+		}
+	}
+	return defaultTemplates[kind][defaultLanguage]
+}
+
+// candidates lists the languages to try for lang: the full tag, its primary
+// subtag ("ar-IQ" → "ar") and English
+func candidates(lang string) []string {
+	langs := []string{lang}
+	if primary, _, ok := strings.Cut(lang, "-"); ok {
+		langs = append(langs, primary)
+	}
+	return append(langs, defaultLanguage)
+}
+
+// renderKind renders the form's template of kind in lang. A custom template
+// that fails on real data (e.g. it indexes a missing answer) falls back to
+// the built-in template so the email is still sent.
+func renderKind(custom map[string]entities.EmailTemplate, kind, lang string, data *TemplateData) (subject, body string, err error) {
+	if tmpl, ok := pickTemplate(custom, lang); ok {
+		if subject, body, err = Render(tmpl, data); err == nil {
+			return subject, body, nil
+		}
+	}
+	return Render(defaultTemplate(kind, lang), data)
+}
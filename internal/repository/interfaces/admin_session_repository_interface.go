@@ -0,0 +1,37 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/google/uuid"
+)
+
+// AdminSessionRepository stores admin login sessions and the hash of their
+// current refresh token
+type AdminSessionRepository interface {
+	Create(ctx context.Context, session *entities.AdminSession) error
+
+	// GetByID returns a session, revoked or not, or pgx.ErrNoRows
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.AdminSession, error)
+
+	// ListActive returns the admin's sessions that are not revoked or expired
+	// at now, most recently used first
+	ListActive(ctx context.Context, adminID uuid.UUID, now time.Time) ([]*entities.AdminSession, error)
+
+	// Rotate replaces the refresh token hash of an active session if it is
+	// still oldHash, and reports whether it was. Of two refreshes with the
+	// same token only one succeeds.
+	Rotate(ctx context.Context, id uuid.UUID, oldHash, newHash, userAgent, ipAddress string, now, expiresAt time.Time) (bool, error)
+
+	// Revoke ends one active session of the admin and reports whether there
+	// was one
+	Revoke(ctx context.Context, id, adminID uuid.UUID, reason enums.SessionRevokeReason, now time.Time) (bool, error)
+
+	// RevokeAll ends every active session of the admin except keep, which may
+	// be uuid.Nil, and returns how many were ended
+	RevokeAll(ctx context.Context, adminID, keep uuid.UUID, reason enums.SessionRevokeReason, now time.Time) (int64, error)
+}
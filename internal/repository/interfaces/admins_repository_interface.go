@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
 	"context"
 
 	"github.com/google/uuid"
@@ -17,6 +18,18 @@ type AdminRepository interface {
 	GetByUsername(ctx context.Context, username string) (*entities.Admin, error)
 	// Update modifies admin details
 	Update(ctx context.Context, admin *entities.Admin) error
+	// UpdatePassword saves only the password hash
+	UpdatePassword(ctx context.Context, id uuid.UUID, hashedPassword string) error
+	// ReplacePasswordHash saves newHash if the stored hash is still oldHash,
+	// and reports whether it was
+	ReplacePasswordHash(ctx context.Context, id uuid.UUID, oldHash, newHash string) (bool, error)
+	// UpdateRole saves only the global role
+	UpdateRole(ctx context.Context, id uuid.UUID, role enums.AdminRole) error
+	// UpdateMFA saves the MFA secret, enablement and last used time step
+	UpdateMFA(ctx context.Context, admin *entities.Admin) error
+	// UseMFACounter stores counter as the last used TOTP time step if it is
+	// newer than the stored one, and reports whether it was
+	UseMFACounter(ctx context.Context, id uuid.UUID, counter int64) (bool, error)
 	// Delete removes an admin
 	Delete(ctx context.Context, id uuid.UUID) error
 	// List retrieves all admins (simple list, no filters yet)
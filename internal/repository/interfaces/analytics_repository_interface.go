@@ -0,0 +1,25 @@
+package interfaces
+
+import (
+	"context"
+
+	"Skillture_Form/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// AnalyticsRepository aggregates the answers of a form's submitted responses in
+// the database. Every method covers all fields of the form in one query and
+// returns results keyed by field ID.
+type AnalyticsRepository interface {
+	// SubmissionsPerDay counts submitted responses per day, oldest first
+	SubmissionsPerDay(ctx context.Context, formID uuid.UUID) ([]entities.DailyCount, error)
+	// AnswerCounts counts the non-empty answers of each field
+	AnswerCounts(ctx context.Context, formID uuid.UUID) (map[uuid.UUID]int, error)
+	// OptionCounts counts how often each option key of choice fields was selected
+	OptionCounts(ctx context.Context, formID uuid.UUID) (map[uuid.UUID]map[string]int, error)
+	// NumberStats describes number answers with a histogram of buckets equal-width buckets
+	NumberStats(ctx context.Context, formID uuid.UUID, buckets int) (map[uuid.UUID]*entities.NumberStats, error)
+	// DateCounts counts date answers per day, oldest first
+	DateCounts(ctx context.Context, formID uuid.UUID) (map[uuid.UUID][]entities.DailyCount, error)
+}
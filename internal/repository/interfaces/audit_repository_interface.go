@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+
+	"Skillture_Form/internal/domain/entities"
+)
+
+// AuditRepository stores the audit log
+type AuditRepository interface {
+	Record(ctx context.Context, event *entities.AuditEvent) error
+}
@@ -0,0 +1,20 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+)
+
+// EmailJobRepository is the durable queue of notification emails
+type EmailJobRepository interface {
+	// Create queues a rendered email; call it inside the transaction of the
+	// change the email is about
+	Create(ctx context.Context, job *entities.EmailJob) error
+	// ClaimDue locks up to limit pending jobs due at now and hides them from
+	// other workers until now+lease
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entities.EmailJob, error)
+	// Update stores the outcome of a send attempt
+	Update(ctx context.Context, job *entities.EmailJob) error
+}
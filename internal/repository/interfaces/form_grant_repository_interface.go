@@ -0,0 +1,21 @@
+package interfaces
+
+import (
+	"context"
+
+	"Skillture_Form/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// FormGrantRepository stores the per-form roles of admins
+type FormGrantRepository interface {
+	// Upsert grants a role on a form, replacing the admin's previous role there
+	Upsert(ctx context.Context, grant *entities.FormGrant) error
+	// Get returns the grant of an admin on a form, or pgx.ErrNoRows
+	Get(ctx context.Context, formID, adminID uuid.UUID) (*entities.FormGrant, error)
+	// ListByForm returns the grants of a form with the admins' usernames
+	ListByForm(ctx context.Context, formID uuid.UUID) ([]*entities.FormGrant, error)
+	// Delete revokes an admin's grant on a form
+	Delete(ctx context.Context, formID, adminID uuid.UUID) error
+}
@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/repository"
 	"context"
 
 	"github.com/google/uuid"
@@ -11,7 +12,10 @@ import (
 // Filter object
 type FormFilter struct {
 	Status *int16
-	Title  *string
+	Title  *string // case-insensitive substring match against the title in any language
+	// GrantedTo limits the list to forms the admin has a grant on
+	GrantedTo *uuid.UUID
+	repository.ListOptions
 }
 
 type FormRepository interface {
@@ -21,8 +25,18 @@ type FormRepository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.Form, error)
 	// Update modifies admin details
 	Update(ctx context.Context, form *entities.Form) error
+	// UpdateDuplicatePolicy replaces the duplicate policy of a form (nil clears it)
+	UpdateDuplicatePolicy(ctx context.Context, formID uuid.UUID, policy *entities.DuplicatePolicy) error
+	// UpdateNotificationSettings replaces the submission emails of a form (nil disables them)
+	UpdateNotificationSettings(ctx context.Context, formID uuid.UUID, settings *entities.NotificationSettings) error
 	// Delete removes an admin
 	Delete(ctx context.Context, id uuid.UUID) error
-	// List retrieves forms based on optional filter
-	List(ctx context.Context, filter FormFilter) ([]*entities.Form, error)
+	// List retrieves a page of forms based on optional filter
+	List(ctx context.Context, filter FormFilter) ([]*entities.Form, repository.CursorPageInfo, error)
+	// WithTx executes a function inside a transaction; webhookRepo queues
+	// webhook events that are committed together with the form change
+	WithTx(
+		ctx context.Context,
+		fn func(formRepo FormRepository, webhookRepo WebhookRepository, grantRepo FormGrantRepository) error,
+	) error
 }
@@ -0,0 +1,23 @@
+package interfaces
+
+import (
+	"context"
+
+	"Skillture_Form/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// FormSectionRepository defines CRUD for form sections
+type FormSectionRepository interface {
+	// Create saves a new section
+	Create(ctx context.Context, section *entities.FormSection) error
+	// GetByID retrieves a section by ID
+	GetByID(ctx context.Context, id uuid.UUID) (*entities.FormSection, error)
+	// Update modifies section details
+	Update(ctx context.Context, section *entities.FormSection) error
+	// Delete removes a section; its fields are kept without a section
+	Delete(ctx context.Context, id uuid.UUID) error
+	// ListByFormID retrieves the sections of a form ordered by SectionOrder
+	ListByFormID(ctx context.Context, formID uuid.UUID) ([]*entities.FormSection, error)
+}
@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+)
+
+// LoginAttemptRepository persists failed login counters and lockouts
+type LoginAttemptRepository interface {
+	// Get returns the counter of a username or IP, or nil when there is none
+	Get(ctx context.Context, scope enums.LoginScope, key string) (*entities.LoginAttempt, error)
+
+	// RecordFailure counts a failed login at now and returns the updated
+	// counter. Failures older than window start a new count.
+	RecordFailure(ctx context.Context, scope enums.LoginScope, key string, now time.Time, window time.Duration) (*entities.LoginAttempt, error)
+
+	// Lock locks a username or IP until the given time and clears its
+	// failures. It reports false when the key was already locked at now.
+	Lock(ctx context.Context, scope enums.LoginScope, key string, now, until time.Time) (bool, error)
+
+	// Reset removes the counter and any lockout of a username or IP
+	Reset(ctx context.Context, scope enums.LoginScope, key string) error
+}
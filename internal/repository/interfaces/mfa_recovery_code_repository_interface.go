@@ -0,0 +1,27 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// MFARecoveryCodeRepository stores the hashed one-time recovery codes of
+// admins using two-factor authentication
+type MFARecoveryCodeRepository interface {
+	// Replace deletes the admin's codes and stores codes in their place
+	Replace(ctx context.Context, adminID uuid.UUID, codes []*entities.MFARecoveryCode) error
+
+	// Consume marks the admin's unused code with codeHash as used at now and
+	// reports whether there was one. A code can only be consumed once.
+	Consume(ctx context.Context, adminID uuid.UUID, codeHash string, now time.Time) (bool, error)
+
+	// CountUnused returns how many of the admin's codes are left
+	CountUnused(ctx context.Context, adminID uuid.UUID) (int, error)
+
+	// DeleteAll removes all codes of the admin
+	DeleteAll(ctx context.Context, adminID uuid.UUID) error
+}
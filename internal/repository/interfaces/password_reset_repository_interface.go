@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// PasswordResetRepository stores one-time password reset tokens
+type PasswordResetRepository interface {
+	Create(ctx context.Context, reset *entities.PasswordReset) error
+
+	// Get returns the unused token with tokenHash that is still valid at now,
+	// or pgx.ErrNoRows
+	Get(ctx context.Context, tokenHash string, now time.Time) (*entities.PasswordReset, error)
+
+	// DeleteUnused removes an admin's tokens that were not used yet
+	DeleteUnused(ctx context.Context, adminID uuid.UUID) error
+
+	// Consume marks the unused, unexpired token with tokenHash as used at now
+	// and returns it, or pgx.ErrNoRows. A token can only be consumed once.
+	Consume(ctx context.Context, tokenHash string, now time.Time) (*entities.PasswordReset, error)
+}
@@ -10,8 +10,9 @@ import (
 
 // Filter object
 type ResponseAnswerFilter struct {
-	ResponseID *uuid.UUID
-	FieldID    *uuid.UUID
+	ResponseID  *uuid.UUID
+	ResponseIDs []uuid.UUID // batch: answers of any of these responses
+	FieldID     *uuid.UUID
 }
 
 type ResponseAnswerRepository interface {
@@ -19,6 +20,12 @@ type ResponseAnswerRepository interface {
 	CreateBulk(ctx context.Context, answers []*entities.ResponseAnswer) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.ResponseAnswer, error)
 	List(ctx context.Context, filter ResponseAnswerFilter) ([]*entities.ResponseAnswer, error)
+	// CountSelectionPairs counts, over a form's submitted responses, how often each
+	// option of rowFieldID was selected together with each option of columnFieldID.
+	// It also returns the number of responses with at least one pair.
+	CountSelectionPairs(ctx context.Context, formID, rowFieldID, columnFieldID uuid.UUID) ([]entities.SelectionPair, int, error)
+	// DeleteByResponseID removes every answer of a response
+	DeleteByResponseID(ctx context.Context, responseID uuid.UUID) error
 	// WithTx executes operations in a transaction
 	WithTx(ctx context.Context, fn func(txRepo ResponseAnswerRepository) error) error
 }
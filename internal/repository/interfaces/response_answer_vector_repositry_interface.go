@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
 	"context"
 
 	"github.com/google/uuid"
@@ -12,11 +13,38 @@ type ResponseAnswerVectorFilter struct {
 	ModelName        *string
 }
 
+// UnembeddedAnswerFilter selects non-empty answers of submitted responses
+// that have no vector for a model yet, ordered by answer ID
+type UnembeddedAnswerFilter struct {
+	ModelName  enums.ModelName
+	FieldTypes []enums.FieldType // answer types to embed
+	ResponseID *uuid.UUID        // only answers of this response
+	AfterID    uuid.UUID         // keyset: only answers with a greater ID
+	Limit      int
+}
+
+// SimilarityFilter narrows a similarity search
+type SimilarityFilter struct {
+	ModelName         enums.ModelName // only vectors of this model are comparable with the query
+	FieldID           *uuid.UUID      // only answers to this field
+	ExcludeResponseID *uuid.UUID      // skip answers of this response
+	Exact             bool            // compare every filtered vector instead of using the approximate index
+}
+
 type ResponseAnswerVectorRepository interface {
 	Create(ctx context.Context, vector *entities.ResponseAnswerVector) error
+	// CreateBulk inserts vectors, skipping answers that already have a vector for the same model
 	CreateBulk(ctx context.Context, vectors []*entities.ResponseAnswerVector) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.ResponseAnswerVector, error)
 	List(ctx context.Context, filter ResponseAnswerVectorFilter) ([]*entities.ResponseAnswerVector, error)
+	// ListUnembeddedAnswers returns answers that still need a vector for filter.ModelName
+	ListUnembeddedAnswers(ctx context.Context, filter UnembeddedAnswerFilter) ([]*entities.ResponseAnswer, error)
+	// SimilaritySearch returns the k answers of a form's submitted responses closest to
+	// queryEmbedding by cosine distance, most similar first
+	SimilaritySearch(ctx context.Context, formID uuid.UUID, queryEmbedding []float32, k int, filter SimilarityFilter) ([]*entities.AnswerMatch, error)
+	// ListFieldEmbeddings returns the answers to a field of submitted responses that have a
+	// vector for modelName, with their vectors, most recently submitted first (limit <= 0: all)
+	ListFieldEmbeddings(ctx context.Context, fieldID uuid.UUID, modelName enums.ModelName, limit int) ([]*entities.EmbeddedAnswer, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	// WithTxRepo creates a repository instance bound to the given transaction
 	WithTxRepo(txRepo ResponseRepository) ResponseAnswerVectorRepository
@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+
+	"Skillture_Form/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// ResponseFlagRepository stores review flags of responses
+type ResponseFlagRepository interface {
+	// Create stores a flag; a flag with the same response, reason and field is kept as is
+	Create(ctx context.Context, flag *entities.ResponseFlag) error
+	// ListByResponseIDs returns the flags of the given responses, oldest first
+	ListByResponseIDs(ctx context.Context, responseIDs []uuid.UUID) ([]*entities.ResponseFlag, error)
+	// WithTxRepo creates a repository instance bound to the given transaction
+	WithTxRepo(txRepo ResponseRepository) ResponseFlagRepository
+}
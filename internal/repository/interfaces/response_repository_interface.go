@@ -2,24 +2,78 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/repository"
 
 	"github.com/google/uuid"
 )
 
+// ResponseFilter selects a page of a form's submitted responses
+type ResponseFilter struct {
+	FormID        uuid.UUID
+	Status        *enums.ResponseStatus
+	SubmittedFrom *time.Time // inclusive
+	SubmittedTo   *time.Time // exclusive
+	Email         *string    // respondent email, case-insensitive exact match
+	Flagged       *bool      // only responses with (true) or without (false) review flags
+	Sort          repository.SortOrder
+	Pagination    repository.Pagination
+}
+
+// DuplicateFilter looks for an earlier submitted response of a form that
+// matches every set criterion
+type DuplicateFilter struct {
+	FormID      uuid.UUID
+	ExcludeID   uuid.UUID  // the response being submitted
+	Email       *string    // respondent email, case-insensitive
+	Fingerprint *string    // client fingerprint hash
+	Since       *time.Time // only responses submitted at or after
+}
+
 type ResponseRepository interface {
 	Create(ctx context.Context, response *entities.Response) error
+	Update(ctx context.Context, response *entities.Response) error
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.Response, error)
-	ListByFormID(ctx context.Context, formID uuid.UUID) ([]*entities.Response, error)
+	// GetDraftByTokenHash returns the pending response with the given resume token hash
+	GetDraftByTokenHash(ctx context.Context, tokenHash string) (*entities.Response, error)
+	// LockDraft is GetDraftByTokenHash that also locks the row until the
+	// surrounding transaction ends; it must be called inside WithTx
+	LockDraft(ctx context.Context, tokenHash string) (*entities.Response, error)
+	// UpdateDraft updates a response only while it is the pending draft with
+	// tokenHash, and returns pgx.ErrNoRows otherwise
+	UpdateDraft(ctx context.Context, response *entities.Response, tokenHash string) error
+	// List returns a page of submitted responses and the total number matching the filter
+	List(ctx context.Context, filter ResponseFilter) ([]*entities.Response, int, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	// WithTx executes a function inside a transaction
+	// StreamByFormID calls fn for each submitted response of a form with its answers,
+	// without loading all of them into memory
+	StreamByFormID(ctx context.Context, formID uuid.UUID, fn func(response *entities.Response) error) error
+	// ListRespondentKeys returns the distinct respondent JSON keys of a form's responses
+	ListRespondentKeys(ctx context.Context, formID uuid.UUID) ([]string, error)
+	// UpdateReview stores a review decision and its history entry atomically
+	UpdateReview(ctx context.Context, response *entities.Response, change *entities.ResponseStatusChange) error
+	ListStatusHistory(ctx context.Context, responseID uuid.UUID) ([]*entities.ResponseStatusChange, error)
+	// FindDuplicate returns the ID of the latest submitted response matching
+	// filter, or nil when there is none
+	FindDuplicate(ctx context.Context, filter DuplicateFilter) (*uuid.UUID, error)
+	// LockSubmissionKey serializes submissions sharing key within a form until the
+	// surrounding transaction ends; it must be called inside WithTx
+	LockSubmissionKey(ctx context.Context, formID uuid.UUID, key string) error
+	// DeleteDraftsBefore purges pending responses not updated since before
+	DeleteDraftsBefore(ctx context.Context, before time.Time) (int64, error)
+	// WithTx executes a function inside a transaction; webhookRepo and emailRepo
+	// queue webhook events and emails that are committed together with the response
 	WithTx(
 		ctx context.Context,
 		fn func(
 			responseRepo ResponseRepository,
 			answerRepo ResponseAnswerRepository,
 			vectorRepo ResponseAnswerVectorRepository,
+			webhookRepo WebhookRepository,
+			emailRepo EmailJobRepository,
 		) error,
 	) error
 }
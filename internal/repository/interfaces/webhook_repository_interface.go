@@ -0,0 +1,43 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/repository"
+
+	"github.com/google/uuid"
+)
+
+// WebhookDeliveryFilter selects a page of a subscription's delivery log, newest first
+type WebhookDeliveryFilter struct {
+	SubscriptionID uuid.UUID
+	Status         *enums.WebhookDeliveryStatus
+	Pagination     repository.Pagination
+}
+
+// WebhookRepository stores webhook subscriptions and their delivery outbox
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, sub *entities.WebhookSubscription) error
+	GetSubscription(ctx context.Context, id uuid.UUID) (*entities.WebhookSubscription, error)
+	// ListSubscriptions returns the subscriptions of a form, oldest first
+	ListSubscriptions(ctx context.Context, formID uuid.UUID) ([]*entities.WebhookSubscription, error)
+	UpdateSubscription(ctx context.Context, sub *entities.WebhookSubscription) error
+	// DeleteSubscription removes a subscription and its delivery log
+	DeleteSubscription(ctx context.Context, id uuid.UUID) error
+
+	// Enqueue queues event for every active subscription of its form that wants it
+	// and returns the number of deliveries created. Call it inside the transaction
+	// that makes the change, so the event is stored if and only if the change is.
+	Enqueue(ctx context.Context, event *entities.WebhookEvent) (int, error)
+	// ClaimDue locks up to limit pending deliveries due at now, with their URL and
+	// secret, and hides them from other dispatchers until now+lease
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entities.WebhookDelivery, error)
+	// UpdateDelivery stores the outcome of a delivery attempt
+	UpdateDelivery(ctx context.Context, delivery *entities.WebhookDelivery) error
+	GetDelivery(ctx context.Context, id uuid.UUID) (*entities.WebhookDelivery, error)
+	// ListDeliveries returns a page of the delivery log and the total number matching the filter
+	ListDeliveries(ctx context.Context, filter WebhookDeliveryFilter) ([]*entities.WebhookDelivery, int, error)
+}
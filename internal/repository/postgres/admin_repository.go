@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
 	"Skillture_Form/internal/repository/interfaces"
 
 	"github.com/google/uuid"
@@ -26,18 +27,33 @@ func NewAdminRepository(base *BaseRepository) interfaces.AdminRepository {
 	}
 }
 
+// adminColumns is the column list read by scanAdmin
+const adminColumns = `id, username, hashed_password, created_at, role,
+	mfa_secret, mfa_enabled_at, mfa_last_counter`
+
 // scanAdmin scans a single row into entities.Admin
 func scanAdmin(row pgx.Row) (*entities.Admin, error) {
 	var admin entities.Admin
+	var role, mfaSecret *string
 	err := row.Scan(
 		&admin.ID,
 		&admin.Username,
 		&admin.HashedPassword,
 		&admin.CreatedAt,
+		&role,
+		&mfaSecret,
+		&admin.MFAEnabledAt,
+		&admin.MFALastCounter,
 	)
 	if err != nil {
 		return nil, err
 	}
+	if role != nil {
+		admin.Role = enums.AdminRole(*role)
+	}
+	if mfaSecret != nil {
+		admin.MFASecret = *mfaSecret
+	}
 	return &admin, nil
 }
 
@@ -48,17 +64,17 @@ func (r *adminRepository) Create(ctx context.Context, admin *entities.Admin) err
 	}
 
 	query := `
-		INSERT INTO admins (id, username, hashed_password, created_at)
-		VALUES ($1, $2, $3, NOW())
+		INSERT INTO admins (id, username, hashed_password, created_at, role)
+		VALUES ($1, $2, $3, NOW(), $4)
 	`
 
-	return r.Exec(ctx, query, admin.ID, admin.Username, admin.HashedPassword)
+	return r.Exec(ctx, query, admin.ID, admin.Username, admin.HashedPassword, nullIfEmpty(string(admin.Role)))
 }
 
 // GetByID retrieves an admin by ID
 func (r *adminRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Admin, error) {
 	query := `
-		SELECT id, username, hashed_password, created_at
+		SELECT ` + adminColumns + `
 		FROM admins
 		WHERE id = $1
 	`
@@ -74,7 +90,7 @@ func (r *adminRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.
 // GetByUsername retrieves an admin by username
 func (r *adminRepository) GetByUsername(ctx context.Context, username string) (*entities.Admin, error) {
 	query := `
-		SELECT id, username, hashed_password, created_at
+		SELECT ` + adminColumns + `
 		FROM admins
 		WHERE username = $1
 	`
@@ -87,16 +103,35 @@ func (r *adminRepository) GetByUsername(ctx context.Context, username string) (*
 	return admin, err
 }
 
-// Update modifies an existing admin
+// Update modifies an existing admin. MFA settings are only written by
+// UpdateMFA and UseMFACounter. Prefer the single-column updates below when
+// changing one setting, so concurrent changes of others are not undone.
 func (r *adminRepository) Update(ctx context.Context, admin *entities.Admin) error {
 	query := `
 		UPDATE admins
 		SET username = $2,
-		    hashed_password = $3
+		    hashed_password = $3,
+		    role = $4
 		WHERE id = $1
 	`
 
-	tag, err := r.exec.Exec(ctx, query, admin.ID, admin.Username, admin.HashedPassword)
+	tag, err := r.exec.Exec(ctx, query, admin.ID, admin.Username, admin.HashedPassword, nullIfEmpty(string(admin.Role)))
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// UpdatePassword writes only the password hash of an admin
+func (r *adminRepository) UpdatePassword(ctx context.Context, id uuid.UUID, hashedPassword string) error {
+	query := `UPDATE admins SET hashed_password = $2 WHERE id = $1`
+
+	tag, err := r.exec.Exec(ctx, query, id, hashedPassword)
 	if err != nil {
 		return err
 	}
@@ -108,6 +143,77 @@ func (r *adminRepository) Update(ctx context.Context, admin *entities.Admin) err
 	return nil
 }
 
+// ReplacePasswordHash swaps the password hash only while it is still
+// oldHash, so upgrading a hash cannot undo a password change made meanwhile
+func (r *adminRepository) ReplacePasswordHash(ctx context.Context, id uuid.UUID, oldHash, newHash string) (bool, error) {
+	query := `UPDATE admins SET hashed_password = $3 WHERE id = $1 AND hashed_password = $2`
+
+	tag, err := r.exec.Exec(ctx, query, id, oldHash, newHash)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+// UpdateRole writes only the global role of an admin
+func (r *adminRepository) UpdateRole(ctx context.Context, id uuid.UUID, role enums.AdminRole) error {
+	query := `UPDATE admins SET role = $2 WHERE id = $1`
+
+	tag, err := r.exec.Exec(ctx, query, id, nullIfEmpty(string(role)))
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// UpdateMFA writes the MFA secret, enablement and last used time step
+func (r *adminRepository) UpdateMFA(ctx context.Context, admin *entities.Admin) error {
+	query := `
+		UPDATE admins
+		SET mfa_secret = $2,
+		    mfa_enabled_at = $3,
+		    mfa_last_counter = $4
+		WHERE id = $1
+	`
+
+	tag, err := r.exec.Exec(ctx, query, admin.ID, nullIfEmpty(admin.MFASecret), admin.MFAEnabledAt, admin.MFALastCounter)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+
+	return nil
+}
+
+// UseMFACounter records counter as the last used time step unless it is not
+// newer than the stored one. The single statement keeps concurrent logins
+// from accepting the same code twice.
+func (r *adminRepository) UseMFACounter(ctx context.Context, id uuid.UUID, counter int64) (bool, error) {
+	query := `
+		UPDATE admins
+		SET mfa_last_counter = $2
+		WHERE id = $1
+		  AND mfa_enabled_at IS NOT NULL
+		  AND (mfa_last_counter IS NULL OR mfa_last_counter < $2)
+	`
+
+	tag, err := r.exec.Exec(ctx, query, id, counter)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
 // Delete removes an admin by ID
 func (r *adminRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	query := `DELETE FROM admins WHERE id = $1`
@@ -127,7 +233,7 @@ func (r *adminRepository) Delete(ctx context.Context, id uuid.UUID) error {
 // List returns all admins
 func (r *adminRepository) List(ctx context.Context) ([]*entities.Admin, error) {
 	query := `
-		SELECT id, username, hashed_password, created_at
+		SELECT ` + adminColumns + `
 		FROM admins
 		ORDER BY created_at DESC
 	`
@@ -140,12 +246,11 @@ func (r *adminRepository) List(ctx context.Context) ([]*entities.Admin, error) {
 
 	var admins []*entities.Admin
 	for rows.Next() {
-		var admin entities.Admin
-		err := rows.Scan(&admin.ID, &admin.Username, &admin.HashedPassword, &admin.CreatedAt)
+		admin, err := scanAdmin(rows)
 		if err != nil {
 			return nil, err
 		}
-		admins = append(admins, &admin)
+		admins = append(admins, admin)
 	}
 
 	return admins, nil
@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// AdminSessionRepository implements the Postgres store of admin sessions
+type AdminSessionRepository struct {
+	base *BaseRepository
+}
+
+// NewAdminSessionRepository creates a new repository instance
+func NewAdminSessionRepository(base *BaseRepository) *AdminSessionRepository {
+	return &AdminSessionRepository{base: base}
+}
+
+// sessionColumns is the column list read by scanSession
+const sessionColumns = `id, admin_id, refresh_token_hash, user_agent, ip_address,
+	created_at, last_used_at, expires_at, revoked_at, revoke_reason`
+
+// scanSession scans a single row into entities.AdminSession
+func scanSession(row pgx.Row) (*entities.AdminSession, error) {
+	var (
+		s                             entities.AdminSession
+		userAgent, ipAddress, revoked *string
+	)
+	if err := row.Scan(
+		&s.ID, &s.AdminID, &s.RefreshTokenHash, &userAgent, &ipAddress,
+		&s.CreatedAt, &s.LastUsedAt, &s.ExpiresAt, &s.RevokedAt, &revoked,
+	); err != nil {
+		return nil, err
+	}
+	if userAgent != nil {
+		s.UserAgent = *userAgent
+	}
+	if ipAddress != nil {
+		s.IPAddress = *ipAddress
+	}
+	if revoked != nil {
+		s.RevokeReason = enums.SessionRevokeReason(*revoked)
+	}
+	return &s, nil
+}
+
+// Create inserts a new session
+func (r *AdminSessionRepository) Create(ctx context.Context, session *entities.AdminSession) error {
+	if session.ID == uuid.Nil {
+		session.ID = uuid.New()
+	}
+	if session.CreatedAt.IsZero() {
+		session.CreatedAt = time.Now()
+	}
+	if session.LastUsedAt.IsZero() {
+		session.LastUsedAt = session.CreatedAt
+	}
+
+	const query = `
+		INSERT INTO admin_sessions (id, admin_id, refresh_token_hash, user_agent, ip_address, created_at, last_used_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	if err := r.base.Exec(ctx, query,
+		session.ID, session.AdminID, session.RefreshTokenHash,
+		nullIfEmpty(session.UserAgent), nullIfEmpty(session.IPAddress),
+		session.CreatedAt, session.LastUsedAt, session.ExpiresAt,
+	); err != nil {
+		return fmt.Errorf("AdminSessionRepository.Create: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a session by ID
+func (r *AdminSessionRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.AdminSession, error) {
+	query := `SELECT ` + sessionColumns + ` FROM admin_sessions WHERE id = $1`
+
+	session, err := scanSession(r.base.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("AdminSessionRepository.GetByID: %w", err)
+	}
+	return session, nil
+}
+
+// ListActive lists the admin's sessions that can still be refreshed
+func (r *AdminSessionRepository) ListActive(ctx context.Context, adminID uuid.UUID, now time.Time) ([]*entities.AdminSession, error) {
+	query := `
+		SELECT ` + sessionColumns + `
+		FROM admin_sessions
+		WHERE admin_id = $1 AND revoked_at IS NULL AND expires_at > $2
+		ORDER BY last_used_at DESC
+	`
+
+	rows, err := r.base.Query(ctx, query, adminID, now)
+	if err != nil {
+		return nil, fmt.Errorf("AdminSessionRepository.ListActive: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []*entities.AdminSession
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			return nil, fmt.Errorf("AdminSessionRepository.ListActive: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("AdminSessionRepository.ListActive: %w", err)
+	}
+	return sessions, nil
+}
+
+// Rotate swaps the refresh token hash in a single statement conditioned on
+// the old hash
+func (r *AdminSessionRepository) Rotate(
+	ctx context.Context,
+	id uuid.UUID,
+	oldHash, newHash, userAgent, ipAddress string,
+	now, expiresAt time.Time,
+) (bool, error) {
+	const query = `
+		UPDATE admin_sessions
+		SET refresh_token_hash = $3,
+		    user_agent = COALESCE($4, user_agent),
+		    ip_address = COALESCE($5, ip_address),
+		    last_used_at = $6,
+		    expires_at = $7
+		WHERE id = $1 AND refresh_token_hash = $2
+		  AND revoked_at IS NULL AND expires_at > $6
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	tag, err := r.base.exec.Exec(ctx, query,
+		id, oldHash, newHash, nullIfEmpty(userAgent), nullIfEmpty(ipAddress), now, expiresAt,
+	)
+	if err != nil {
+		return false, fmt.Errorf("AdminSessionRepository.Rotate: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// Revoke ends one active session of the admin
+func (r *AdminSessionRepository) Revoke(
+	ctx context.Context,
+	id, adminID uuid.UUID,
+	reason enums.SessionRevokeReason,
+	now time.Time,
+) (bool, error) {
+	const query = `
+		UPDATE admin_sessions
+		SET revoked_at = $3, revoke_reason = $4
+		WHERE id = $1 AND admin_id = $2 AND revoked_at IS NULL
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	tag, err := r.base.exec.Exec(ctx, query, id, adminID, now, string(reason))
+	if err != nil {
+		return false, fmt.Errorf("AdminSessionRepository.Revoke: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// RevokeAll ends the admin's active sessions other than keep
+func (r *AdminSessionRepository) RevokeAll(
+	ctx context.Context,
+	adminID, keep uuid.UUID,
+	reason enums.SessionRevokeReason,
+	now time.Time,
+) (int64, error) {
+	const query = `
+		UPDATE admin_sessions
+		SET revoked_at = $3, revoke_reason = $4
+		WHERE admin_id = $1 AND id <> $2 AND revoked_at IS NULL
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	tag, err := r.base.exec.Exec(ctx, query, adminID, keep, now, string(reason))
+	if err != nil {
+		return 0, fmt.Errorf("AdminSessionRepository.RevokeAll: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
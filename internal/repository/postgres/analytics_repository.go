@@ -0,0 +1,313 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/google/uuid"
+)
+
+// Shared SQL fragments of the analytics queries
+const (
+	// submittedAnswers joins the answers of a form's submitted responses
+	// ($1 = form ID, $2 = enums.ResponsePending)
+	submittedAnswers = `
+		FROM response_answers ra
+		JOIN responses r ON r.id = ra.response_id
+		WHERE r.form_id = $1 AND r.status <> $2`
+
+	// answerScalar is the single value of a text, number or date answer;
+	// answers use {"text": ...}, older ones {"en": ...}
+	answerScalar = `btrim(COALESCE(ra.value->'text', ra.value->'en') #>> '{}')`
+
+	// hasScalar lets the GIN index on value skip answers without a single value
+	hasScalar = `(ra.value ? 'text' OR ra.value ? 'en')`
+
+	// selectedKeys expands {"selected": "key"} and {"selected": ["a", "b"]}
+	// into one row per key; use with CROSS JOIN LATERAL
+	selectedKeys = `(
+			SELECT ra.value->>'selected' AS key
+			WHERE jsonb_typeof(ra.value->'selected') = 'string'
+			UNION ALL
+			SELECT jsonb_array_elements_text(ra.value->'selected')
+			WHERE jsonb_typeof(ra.value->'selected') = 'array'
+		)`
+
+	// numberPattern matches the numbers accepted by answer validation
+	numberPattern = `^[-+]?([0-9]+\.?[0-9]*|\.[0-9]+)([eE][-+]?[0-9]+)?$`
+)
+
+// numberValues selects (field_id, v) for every numeric answer of a number field
+const numberValues = `
+	SELECT field_id, v::double precision AS v
+	FROM (
+		SELECT ra.field_id, ` + answerScalar + ` AS v
+		` + submittedAnswers + `
+		  AND ra.field_type = 'number'
+		  AND ` + hasScalar + `
+	) a
+	WHERE v ~ '` + numberPattern + `'`
+
+// AnalyticsRepository implements aggregate reporting queries over response answers
+type AnalyticsRepository struct {
+	base *BaseRepository
+}
+
+// NewAnalyticsRepository creates a new repository instance
+func NewAnalyticsRepository(base *BaseRepository) *AnalyticsRepository {
+	return &AnalyticsRepository{base: base}
+}
+
+// SubmissionsPerDay groups submitted responses by the day they were submitted
+func (r *AnalyticsRepository) SubmissionsPerDay(ctx context.Context, formID uuid.UUID) ([]entities.DailyCount, error) {
+	const query = `
+		SELECT to_char(submitted_at::date, 'YYYY-MM-DD') AS day, COUNT(*)
+		FROM responses
+		WHERE form_id = $1 AND status <> $2
+		GROUP BY day
+		ORDER BY day
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	rows, err := r.base.exec.Query(ctx, query, formID, enums.ResponsePending)
+	if err != nil {
+		return nil, fmt.Errorf("SubmissionsPerDay: %w", err)
+	}
+	defer rows.Close()
+
+	days := []entities.DailyCount{}
+	for rows.Next() {
+		var d entities.DailyCount
+		if err := rows.Scan(&d.Date, &d.Count); err != nil {
+			return nil, fmt.Errorf("SubmissionsPerDay.Scan: %w", err)
+		}
+		days = append(days, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("SubmissionsPerDay: %w", err)
+	}
+
+	return days, nil
+}
+
+// AnswerCounts counts answers that carry a value: a non-blank text or at least one selected option
+func (r *AnalyticsRepository) AnswerCounts(ctx context.Context, formID uuid.UUID) (map[uuid.UUID]int, error) {
+	query := `
+		SELECT ra.field_id, COUNT(*)
+		` + submittedAnswers + `
+		  AND CASE
+			WHEN ra.field_type IN ('select', 'radio', 'checkbox') THEN
+				CASE jsonb_typeof(ra.value->'selected')
+					WHEN 'string' THEN ra.value->>'selected' <> ''
+					WHEN 'array' THEN jsonb_array_length(ra.value->'selected') > 0
+					ELSE false
+				END
+			ELSE COALESCE(` + answerScalar + `, '') <> ''
+		  END
+		GROUP BY ra.field_id
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	rows, err := r.base.exec.Query(ctx, query, formID, enums.ResponsePending)
+	if err != nil {
+		return nil, fmt.Errorf("AnswerCounts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[uuid.UUID]int)
+	for rows.Next() {
+		var fieldID uuid.UUID
+		var n int
+		if err := rows.Scan(&fieldID, &n); err != nil {
+			return nil, fmt.Errorf("AnswerCounts.Scan: %w", err)
+		}
+		counts[fieldID] = n
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("AnswerCounts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// OptionCounts expands {"selected": "key"} and {"selected": ["a", "b"]} into one row per key
+func (r *AnalyticsRepository) OptionCounts(ctx context.Context, formID uuid.UUID) (map[uuid.UUID]map[string]int, error) {
+	query := `
+		SELECT ra.field_id, sel.key, COUNT(*)
+		FROM response_answers ra
+		JOIN responses r ON r.id = ra.response_id
+		CROSS JOIN LATERAL ` + selectedKeys + ` sel
+		WHERE r.form_id = $1 AND r.status <> $2
+		  AND ra.field_type IN ('select', 'radio', 'checkbox')
+		  AND ra.value ? 'selected'
+		  AND sel.key <> ''
+		GROUP BY ra.field_id, sel.key
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	rows, err := r.base.exec.Query(ctx, query, formID, enums.ResponsePending)
+	if err != nil {
+		return nil, fmt.Errorf("OptionCounts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[uuid.UUID]map[string]int)
+	for rows.Next() {
+		var fieldID uuid.UUID
+		var key string
+		var n int
+		if err := rows.Scan(&fieldID, &key, &n); err != nil {
+			return nil, fmt.Errorf("OptionCounts.Scan: %w", err)
+		}
+		if counts[fieldID] == nil {
+			counts[fieldID] = make(map[string]int)
+		}
+		counts[fieldID][key] = n
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("OptionCounts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// NumberStats computes count, min, max, mean and median per field and a
+// histogram of equal-width buckets between min and max
+func (r *AnalyticsRepository) NumberStats(ctx context.Context, formID uuid.UUID, buckets int) (map[uuid.UUID]*entities.NumberStats, error) {
+	if buckets < 1 {
+		buckets = 1
+	}
+
+	statsQuery := `
+		SELECT field_id, COUNT(*), MIN(v), MAX(v), AVG(v),
+			percentile_cont(0.5) WITHIN GROUP (ORDER BY v)
+		FROM (` + numberValues + `) vals
+		GROUP BY field_id
+	`
+	// Values equal to max fall into the last bucket
+	histogramQuery := `
+		WITH vals AS (` + numberValues + `),
+		bounds AS (
+			SELECT field_id, MIN(v) AS lo, MAX(v) AS hi FROM vals GROUP BY field_id
+		)
+		SELECT vals.field_id,
+			CASE WHEN bounds.hi = bounds.lo THEN 1
+				ELSE LEAST(width_bucket(vals.v, bounds.lo, bounds.hi, $3), $3)
+			END AS bucket,
+			COUNT(*)
+		FROM vals
+		JOIN bounds ON bounds.field_id = vals.field_id
+		GROUP BY vals.field_id, bucket
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	rows, err := r.base.exec.Query(ctx, statsQuery, formID, enums.ResponsePending)
+	if err != nil {
+		return nil, fmt.Errorf("NumberStats: %w", err)
+	}
+	defer rows.Close()
+
+	stats := make(map[uuid.UUID]*entities.NumberStats)
+	for rows.Next() {
+		var fieldID uuid.UUID
+		var s entities.NumberStats
+		if err := rows.Scan(&fieldID, &s.Count, &s.Min, &s.Max, &s.Mean, &s.Median); err != nil {
+			return nil, fmt.Errorf("NumberStats.Scan: %w", err)
+		}
+		s.Histogram = histogramBuckets(s.Min, s.Max, buckets)
+		stats[fieldID] = &s
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("NumberStats: %w", err)
+	}
+	rows.Close()
+
+	rows, err = r.base.exec.Query(ctx, histogramQuery, formID, enums.ResponsePending, buckets)
+	if err != nil {
+		return nil, fmt.Errorf("NumberStats.Histogram: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var fieldID uuid.UUID
+		var bucket, n int
+		if err := rows.Scan(&fieldID, &bucket, &n); err != nil {
+			return nil, fmt.Errorf("NumberStats.Histogram.Scan: %w", err)
+		}
+		if s, ok := stats[fieldID]; ok && bucket >= 1 && bucket <= len(s.Histogram) {
+			s.Histogram[bucket-1].Count = n
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("NumberStats.Histogram: %w", err)
+	}
+
+	return stats, nil
+}
+
+// histogramBuckets splits [lo, hi] into n empty buckets; a single value gets one bucket
+func histogramBuckets(lo, hi float64, n int) []entities.HistogramBucket {
+	if hi == lo {
+		return []entities.HistogramBucket{{From: lo, To: hi}}
+	}
+
+	width := (hi - lo) / float64(n)
+	buckets := make([]entities.HistogramBucket, n)
+	for i := range buckets {
+		buckets[i].From = lo + float64(i)*width
+		buckets[i].To = lo + float64(i+1)*width
+	}
+	buckets[n-1].To = hi
+	return buckets
+}
+
+// DateCounts groups date answers by their YYYY-MM-DD part
+func (r *AnalyticsRepository) DateCounts(ctx context.Context, formID uuid.UUID) (map[uuid.UUID][]entities.DailyCount, error) {
+	query := `
+		SELECT field_id, left(v, 10) AS day, COUNT(*)
+		FROM (
+			SELECT ra.field_id, ` + answerScalar + ` AS v
+			` + submittedAnswers + `
+			  AND ra.field_type = 'date'
+			  AND ` + hasScalar + `
+		) a
+		WHERE v ~ '^[0-9]{4}-[0-9]{2}-[0-9]{2}'
+		GROUP BY field_id, day
+		ORDER BY field_id, day
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	rows, err := r.base.exec.Query(ctx, query, formID, enums.ResponsePending)
+	if err != nil {
+		return nil, fmt.Errorf("DateCounts: %w", err)
+	}
+	defer rows.Close()
+
+	counts := make(map[uuid.UUID][]entities.DailyCount)
+	for rows.Next() {
+		var fieldID uuid.UUID
+		var d entities.DailyCount
+		if err := rows.Scan(&fieldID, &d.Date, &d.Count); err != nil {
+			return nil, fmt.Errorf("DateCounts.Scan: %w", err)
+		}
+		counts[fieldID] = append(counts[fieldID], d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("DateCounts: %w", err)
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// AuditRepository implements the Postgres store of the audit log
+type AuditRepository struct {
+	base *BaseRepository
+}
+
+// NewAuditRepository creates a new repository instance
+func NewAuditRepository(base *BaseRepository) *AuditRepository {
+	return &AuditRepository{base: base}
+}
+
+// Record appends an event to the audit log
+func (r *AuditRepository) Record(ctx context.Context, event *entities.AuditEvent) error {
+	if event.ID == uuid.Nil {
+		event.ID = uuid.New()
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = time.Now()
+	}
+
+	const query = `
+		INSERT INTO audit_events (id, event, admin_id, actor_id, subject, client_ip, details, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	if err := r.base.Exec(ctx, query,
+		event.ID, event.Event, event.AdminID, event.ActorID,
+		nullIfEmpty(event.Subject), nullIfEmpty(event.ClientIP), event.Details, event.CreatedAt,
+	); err != nil {
+		return fmt.Errorf("AuditRepository.Record: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// EmailJobRepository implements the Postgres queue of notification emails
+type EmailJobRepository struct {
+	base *BaseRepository
+}
+
+// NewEmailJobRepository creates a new repository instance
+func NewEmailJobRepository(base *BaseRepository) *EmailJobRepository {
+	return &EmailJobRepository{base: base}
+}
+
+// Create inserts a pending job, due immediately unless NextAttemptAt is set
+func (r *EmailJobRepository) Create(ctx context.Context, job *entities.EmailJob) error {
+	if job.ID == uuid.Nil {
+		job.ID = uuid.New()
+	}
+	if job.CreatedAt.IsZero() {
+		job.CreatedAt = time.Now()
+	}
+	if job.NextAttemptAt.IsZero() {
+		job.NextAttemptAt = job.CreatedAt
+	}
+	job.Status = enums.EmailPending
+
+	const query = `
+		INSERT INTO email_jobs (id, response_id, kind, recipients, subject, body, status, next_attempt_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	if err := r.base.Exec(ctx, query,
+		job.ID, job.ResponseID, job.Kind, job.Recipients, job.Subject, job.Body,
+		job.Status, job.NextAttemptAt, job.CreatedAt,
+	); err != nil {
+		return fmt.Errorf("EmailJobRepository.Create: %w", err)
+	}
+	return nil
+}
+
+// ClaimDue leases due jobs by pushing their next attempt past the lease.
+// SKIP LOCKED lets several API instances send without claiming the same jobs.
+func (r *EmailJobRepository) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]*entities.EmailJob, error) {
+	const query = `
+		WITH due AS (
+			SELECT id FROM email_jobs
+			WHERE status = $1 AND next_attempt_at <= $2
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		UPDATE email_jobs j
+		SET next_attempt_at = $4
+		FROM due
+		WHERE j.id = due.id
+		RETURNING j.id, j.response_id, j.kind, j.recipients, j.subject, j.body, j.status,
+		          j.attempts, j.next_attempt_at, j.last_error, j.created_at, j.sent_at
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	rows, err := r.base.exec.Query(ctx, query, enums.EmailPending, now, limit, now.Add(lease))
+	if err != nil {
+		return nil, fmt.Errorf("EmailJobRepository.ClaimDue: %w", err)
+	}
+	defer rows.Close()
+
+	var jobs []*entities.EmailJob
+	for rows.Next() {
+		var job entities.EmailJob
+		var lastError *string
+		if err := rows.Scan(
+			&job.ID, &job.ResponseID, &job.Kind, &job.Recipients, &job.Subject, &job.Body, &job.Status,
+			&job.Attempts, &job.NextAttemptAt, &lastError, &job.CreatedAt, &job.SentAt,
+		); err != nil {
+			return nil, fmt.Errorf("EmailJobRepository.ClaimDue.Scan: %w", err)
+		}
+		if lastError != nil {
+			job.LastError = *lastError
+		}
+		jobs = append(jobs, &job)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("EmailJobRepository.ClaimDue: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// Update stores the status, attempt count and last error of a job
+func (r *EmailJobRepository) Update(ctx context.Context, job *entities.EmailJob) error {
+	const query = `
+		UPDATE email_jobs
+		SET status=$1, attempts=$2, next_attempt_at=$3, last_error=$4, sent_at=$5
+		WHERE id=$6
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	tag, err := r.base.exec.Exec(ctx, query,
+		job.Status, job.Attempts, job.NextAttemptAt, nullIfEmpty(job.LastError), job.SentAt, job.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("EmailJobRepository.Update: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("EmailJobRepository.Update: %w", pgx.ErrNoRows)
+	}
+	return nil
+}
@@ -35,6 +35,7 @@ func scanFormField(row pgx.Row) (*entities.FormField, error) {
 	err := row.Scan(
 		&ff.ID,
 		&ff.FormID,
+		&ff.SectionID,
 		&ff.Label,
 		&typeStr,
 		&ff.FieldOrder,
@@ -42,6 +43,8 @@ func scanFormField(row pgx.Row) (*entities.FormField, error) {
 		&ff.Placeholder,
 		&ff.HelpText,
 		&ff.Options,
+		&ff.Rules,
+		&ff.ShowIf,
 		&ff.CreatedAt,
 		&ff.UpdatedAt,
 	)
@@ -66,9 +69,9 @@ func (r *formFieldRepository) Create(ctx context.Context, ff *entities.FormField
 
 	query := `
 		INSERT INTO form_fields
-			(id, form_id, label, type, position, is_required, placeholder, help_text, options, created_at, updated_at)
+			(id, form_id, section_id, label, type, position, is_required, placeholder, help_text, options, validation_rules, show_if, created_at, updated_at)
 		VALUES
-			($1,$2,$3,$4,$5,$6,$7,$8,$9,NOW(),NOW())
+			($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,NOW(),NOW())
 	`
 
 	// Map enum to string for DB using centralized method
@@ -77,6 +80,7 @@ func (r *formFieldRepository) Create(ctx context.Context, ff *entities.FormField
 	return r.Exec(ctx, query,
 		ff.ID,
 		ff.FormID,
+		ff.SectionID,
 		ff.Label,
 		typeStr,
 		ff.FieldOrder,
@@ -84,13 +88,15 @@ func (r *formFieldRepository) Create(ctx context.Context, ff *entities.FormField
 		ff.Placeholder,
 		ff.HelpText,
 		ff.Options,
+		ff.Rules,
+		ff.ShowIf,
 	)
 }
 
 // GetByID retrieves a form field by ID
 func (r *formFieldRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.FormField, error) {
 	query := `
-		SELECT id, form_id, label, type, position, is_required, placeholder, help_text, options, created_at, updated_at
+		SELECT id, form_id, section_id, label, type, position, is_required, placeholder, help_text, options, validation_rules, show_if, created_at, updated_at
 		FROM form_fields
 		WHERE id = $1
 	`
@@ -113,6 +119,9 @@ func (r *formFieldRepository) Update(ctx context.Context, ff *entities.FormField
 		    placeholder = $6,
 		    help_text = $7,
 		    options = $8,
+		    validation_rules = $9,
+		    show_if = $10,
+		    section_id = $11,
 		    updated_at = NOW()
 		WHERE id = $1
 	`
@@ -149,6 +158,9 @@ func (r *formFieldRepository) Update(ctx context.Context, ff *entities.FormField
 		ff.Placeholder,
 		ff.HelpText,
 		ff.Options,
+		ff.Rules,
+		ff.ShowIf,
+		ff.SectionID,
 	)
 	if err != nil {
 		return err
@@ -178,7 +190,7 @@ func (r *formFieldRepository) Delete(ctx context.Context, id uuid.UUID) error {
 // List returns all form fields, optionally filtered by form ID
 func (r *formFieldRepository) List(ctx context.Context, filter interfaces.FormFieldFilter) ([]*entities.FormField, error) {
 	baseQuery := `
-		SELECT id, form_id, label, type, position, is_required, placeholder, help_text, options, created_at, updated_at
+		SELECT id, form_id, section_id, label, type, position, is_required, placeholder, help_text, options, validation_rules, show_if, created_at, updated_at
 		FROM form_fields
 	`
 	var rows pgx.Rows
@@ -203,6 +215,7 @@ func (r *formFieldRepository) List(ctx context.Context, filter interfaces.FormFi
 		err := rows.Scan(
 			&ff.ID,
 			&ff.FormID,
+			&ff.SectionID,
 			&ff.Label,
 			&typeStr,
 			&ff.FieldOrder,
@@ -210,6 +223,8 @@ func (r *formFieldRepository) List(ctx context.Context, filter interfaces.FormFi
 			&ff.Placeholder,
 			&ff.HelpText,
 			&ff.Options,
+			&ff.Rules,
+			&ff.ShowIf,
 			&ff.CreatedAt,
 			&ff.UpdatedAt,
 		)
@@ -0,0 +1,113 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// FormGrantRepository implements the Postgres store of per-form roles
+type FormGrantRepository struct {
+	base *BaseRepository
+}
+
+// NewFormGrantRepository creates a new repository instance
+func NewFormGrantRepository(base *BaseRepository) *FormGrantRepository {
+	return &FormGrantRepository{base: base}
+}
+
+// Upsert inserts a grant or replaces the role of an existing one
+func (r *FormGrantRepository) Upsert(ctx context.Context, grant *entities.FormGrant) error {
+	if grant.CreatedAt.IsZero() {
+		grant.CreatedAt = time.Now()
+	}
+
+	const query = `
+		INSERT INTO form_grants (form_id, admin_id, role, granted_by, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (form_id, admin_id)
+		DO UPDATE SET role = EXCLUDED.role, granted_by = EXCLUDED.granted_by, created_at = EXCLUDED.created_at
+	`
+
+	if err := r.base.Exec(ctx, query,
+		grant.FormID, grant.AdminID, grant.Role, grant.GrantedBy, grant.CreatedAt,
+	); err != nil {
+		return fmt.Errorf("FormGrantRepository.Upsert: %w", err)
+	}
+	return nil
+}
+
+// Get retrieves the grant of an admin on a form
+func (r *FormGrantRepository) Get(ctx context.Context, formID, adminID uuid.UUID) (*entities.FormGrant, error) {
+	const query = `
+		SELECT form_id, admin_id, role, granted_by, created_at
+		FROM form_grants
+		WHERE form_id = $1 AND admin_id = $2
+	`
+
+	var grant entities.FormGrant
+	if err := r.base.QueryRow(ctx, query, formID, adminID).Scan(
+		&grant.FormID, &grant.AdminID, &grant.Role, &grant.GrantedBy, &grant.CreatedAt,
+	); err != nil {
+		return nil, fmt.Errorf("FormGrantRepository.Get: %w", err)
+	}
+	return &grant, nil
+}
+
+// ListByForm retrieves the grants of a form, oldest first
+func (r *FormGrantRepository) ListByForm(ctx context.Context, formID uuid.UUID) ([]*entities.FormGrant, error) {
+	const query = `
+		SELECT g.form_id, g.admin_id, a.username, g.role, g.granted_by, g.created_at
+		FROM form_grants g
+		JOIN admins a ON a.id = g.admin_id
+		WHERE g.form_id = $1
+		ORDER BY g.created_at, a.username
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	rows, err := r.base.exec.Query(ctx, query, formID)
+	if err != nil {
+		return nil, fmt.Errorf("FormGrantRepository.ListByForm: %w", err)
+	}
+	defer rows.Close()
+
+	grants := []*entities.FormGrant{}
+	for rows.Next() {
+		var grant entities.FormGrant
+		if err := rows.Scan(
+			&grant.FormID, &grant.AdminID, &grant.Username, &grant.Role, &grant.GrantedBy, &grant.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("FormGrantRepository.ListByForm.Scan: %w", err)
+		}
+		grants = append(grants, &grant)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("FormGrantRepository.ListByForm: %w", err)
+	}
+
+	return grants, nil
+}
+
+// Delete removes the grant of an admin on a form
+func (r *FormGrantRepository) Delete(ctx context.Context, formID, adminID uuid.UUID) error {
+	const query = `DELETE FROM form_grants WHERE form_id = $1 AND admin_id = $2`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	tag, err := r.base.exec.Exec(ctx, query, formID, adminID)
+	if err != nil {
+		return fmt.Errorf("FormGrantRepository.Delete: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("FormGrantRepository.Delete: %w", pgx.ErrNoRows)
+	}
+	return nil
+}
@@ -3,11 +3,14 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/repository"
 	"Skillture_Form/internal/repository/interfaces"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 // FormRepository implements Postgres CRUD operations for forms.
@@ -28,10 +31,16 @@ func NewFormRepository(base *BaseRepository) *FormRepository {
 
 // WithTx executes a function within a database transaction.
 // This allows multiple operations to be committed/rolled back atomically.
-func (r *FormRepository) WithTx(ctx context.Context, fn func(txRepo *FormRepository) error) error {
+func (r *FormRepository) WithTx(
+	ctx context.Context,
+	fn func(
+		formRepo interfaces.FormRepository,
+		webhookRepo interfaces.WebhookRepository,
+		grantRepo interfaces.FormGrantRepository,
+	) error,
+) error {
 	return r.base.WithTx(ctx, func(txBase *BaseRepository) error {
-		txRepo := &FormRepository{base: txBase}
-		return fn(txRepo)
+		return fn(&FormRepository{base: txBase}, NewWebhookRepository(txBase), NewFormGrantRepository(txBase))
 	})
 }
 
@@ -46,32 +55,27 @@ func (r *FormRepository) Create(ctx context.Context, form *entities.Form) error
 		VALUES ($1, $2, $3, $4, NOW())
 	`
 
-	// Convert to JSONB map
-	titleMap := map[string]string{"en": form.Title}
-	descMap := map[string]string{"en": form.Description}
-
-	return r.base.Exec(ctx, query, form.ID, titleMap, descMap, form.Status)
+	return r.base.Exec(ctx, query, form.ID, form.Title, nullIfEmptyMap(form.Description), form.Status)
 }
 
 // GetByID retrieves a form by its ID
 func (r *FormRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Form, error) {
 	const query = `
-		SELECT id, title, description, status, created_at
+		SELECT id, title, description, status, created_at, duplicate_policy, notification_settings
 		FROM forms
 		WHERE id=$1
 	`
 
 	row := r.base.QueryRow(ctx, query, id)
 	var form entities.Form
-	var titleMap, descMap map[string]string
 
-	if err := row.Scan(&form.ID, &titleMap, &descMap, &form.Status, &form.CreatedAt); err != nil {
+	if err := row.Scan(
+		&form.ID, &form.Title, &form.Description, &form.Status, &form.CreatedAt,
+		&form.DuplicatePolicy, &form.Notifications,
+	); err != nil {
 		return nil, fmt.Errorf("FormRepository.GetByID: %w", err)
 	}
 
-	form.Title = titleMap["en"]
-	form.Description = descMap["en"]
-
 	return &form, nil
 }
 
@@ -82,10 +86,47 @@ func (r *FormRepository) Update(ctx context.Context, form *entities.Form) error
 		SET title=$1, description=$2, status=$3
 		WHERE id=$4
 	`
-	titleMap := map[string]string{"en": form.Title}
-	descMap := map[string]string{"en": form.Description}
 
-	return r.base.Exec(ctx, query, titleMap, descMap, form.Status, form.ID)
+	return r.base.Exec(ctx, query, form.Title, nullIfEmptyMap(form.Description), form.Status, form.ID)
+}
+
+// UpdateDuplicatePolicy stores the duplicate policy of a form.
+// It is kept apart from Update so metadata edits never reset the policy.
+func (r *FormRepository) UpdateDuplicatePolicy(ctx context.Context, formID uuid.UUID, policy *entities.DuplicatePolicy) error {
+	const query = `UPDATE forms SET duplicate_policy=$1 WHERE id=$2`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	tag, err := r.base.exec.Exec(ctx, query, policy, formID)
+	if err != nil {
+		return fmt.Errorf("FormRepository.UpdateDuplicatePolicy: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("FormRepository.UpdateDuplicatePolicy: %w", pgx.ErrNoRows)
+	}
+	return nil
+}
+
+// UpdateNotificationSettings stores the notification settings of a form
+func (r *FormRepository) UpdateNotificationSettings(
+	ctx context.Context,
+	formID uuid.UUID,
+	settings *entities.NotificationSettings,
+) error {
+	const query = `UPDATE forms SET notification_settings=$1 WHERE id=$2`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	tag, err := r.base.exec.Exec(ctx, query, settings, formID)
+	if err != nil {
+		return fmt.Errorf("FormRepository.UpdateNotificationSettings: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("FormRepository.UpdateNotificationSettings: %w", pgx.ErrNoRows)
+	}
+	return nil
 }
 
 // Delete removes a form by ID
@@ -94,58 +135,120 @@ func (r *FormRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return r.base.Exec(ctx, query, id)
 }
 
-// List retrieves forms ordered by creation date.
-func (r *FormRepository) List(ctx context.Context, filter interfaces.FormFilter) ([]*entities.Form, error) {
+// List retrieves a page of forms ordered by creation date, using keyset
+// pagination on (created_at, id)
+func (r *FormRepository) List(ctx context.Context, filter interfaces.FormFilter) ([]*entities.Form, repository.CursorPageInfo, error) {
+	filter.Normalize()
+	page := repository.CursorPageInfo{Limit: filter.Limit}
+
+	order, ok := repository.ParseSortOrder(string(filter.Sort), repository.SortDesc)
+	if !ok {
+		return nil, page, repository.ErrInvalidInput
+	}
 
-	query := `
-		SELECT
-			id,
-			title,
-			description,
-			status,
-			created_at
-		FROM forms
-	`
 	var args []interface{}
 	// Simple query builder
-	whereClause := ""
+	var conditions []string
 
 	if filter.Status != nil {
-		whereClause += " WHERE status=$1"
 		args = append(args, *filter.Status)
+		conditions = append(conditions, fmt.Sprintf("status=$%d", len(args)))
 	}
 
 	if filter.Title != nil {
-		if whereClause != "" {
-			whereClause += " AND title->>'en' ILIKE $" + fmt.Sprint(len(args)+1)
-		} else {
-			whereClause += " WHERE title->>'en' ILIKE $" + fmt.Sprint(len(args)+1)
+		// Match the title in any language
+		args = append(args, "%"+escapeLike(*filter.Title)+"%")
+		conditions = append(conditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM jsonb_each_text(title) t WHERE t.value ILIKE $%d)", len(args)))
+	}
+
+	if filter.GrantedTo != nil {
+		args = append(args, *filter.GrantedTo)
+		conditions = append(conditions, fmt.Sprintf(
+			"EXISTS (SELECT 1 FROM form_grants g WHERE g.form_id = forms.id AND g.admin_id = $%d)", len(args)))
+	}
+
+	whereClause := ""
+	if len(conditions) > 0 {
+		whereClause = " WHERE " + strings.Join(conditions, " AND ")
+	}
+
+	// Total ignores the cursor so it stays the same across pages
+	if err := r.base.QueryRow(ctx, "SELECT COUNT(*) FROM forms"+whereClause, args...).Scan(&page.Total); err != nil {
+		return nil, page, fmt.Errorf("FormRepository.List.Count: %w", err)
+	}
+
+	if filter.Cursor != "" {
+		cursor, err := repository.DecodeCursor(filter.Cursor)
+		if err != nil {
+			return nil, page, err
 		}
-		args = append(args, "%"+*filter.Title+"%")
+		cmp := "<"
+		if order == repository.SortAsc {
+			cmp = ">"
+		}
+		args = append(args, cursor.CreatedAt, cursor.ID)
+		conditions = append(conditions, fmt.Sprintf("(created_at, id) %s ($%d, $%d)", cmp, len(args)-1, len(args)))
+		whereClause = " WHERE " + strings.Join(conditions, " AND ")
 	}
 
-	query += whereClause + " ORDER BY created_at DESC"
+	// Fetch one extra row to know whether another page exists
+	args = append(args, filter.Limit+1)
+	query := `
+		SELECT
+			id,
+			title,
+			description,
+			status,
+			created_at,
+			duplicate_policy,
+			notification_settings
+		FROM forms
+	` + whereClause + fmt.Sprintf(" ORDER BY created_at %s, id %s LIMIT $%d", order, order, len(args))
 
 	rows, err := r.base.Query(ctx, query, args...)
 	if err != nil {
-		return nil, fmt.Errorf("FormRepository.List: %w", err)
+		return nil, page, fmt.Errorf("FormRepository.List: %w", err)
 	}
 	defer rows.Close()
 
-	var forms []*entities.Form
+	forms := make([]*entities.Form, 0, filter.Limit)
 	for rows.Next() {
 		var f entities.Form
-		var titleMap, descMap map[string]string
 
-		if err := rows.Scan(&f.ID, &titleMap, &descMap, &f.Status, &f.CreatedAt); err != nil {
-			return nil, fmt.Errorf("FormRepository.List.Scan: %w", err)
+		if err := rows.Scan(
+			&f.ID, &f.Title, &f.Description, &f.Status, &f.CreatedAt,
+			&f.DuplicatePolicy, &f.Notifications,
+		); err != nil {
+			return nil, page, fmt.Errorf("FormRepository.List.Scan: %w", err)
 		}
-		f.Title = titleMap["en"]
-		f.Description = descMap["en"]
 		forms = append(forms, &f)
 	}
+	if err := rows.Err(); err != nil {
+		return nil, page, fmt.Errorf("FormRepository.List: %w", err)
+	}
+
+	if len(forms) > filter.Limit {
+		forms = forms[:filter.Limit]
+		last := forms[len(forms)-1]
+		page.HasMore = true
+		page.NextCursor = repository.Cursor{CreatedAt: last.CreatedAt, ID: last.ID}.Encode()
+	}
+
+	return forms, page, nil
+}
+
+// nullIfEmptyMap stores missing translations as NULL
+func nullIfEmptyMap(m map[string]string) any {
+	if len(m) == 0 {
+		return nil
+	}
+	return m
+}
 
-	return forms, nil
+// escapeLike escapes LIKE wildcards so user input matches literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
 }
 
 // Base returns the underlying BaseRepository to allow transactional composition
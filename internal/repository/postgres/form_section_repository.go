@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/repository/interfaces"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// formSectionRepository implements interfaces.FormSectionRepository
+type formSectionRepository struct {
+	*BaseRepository
+}
+
+// Compile-time check
+var _ interfaces.FormSectionRepository = (*formSectionRepository)(nil)
+
+// NewFormSectionRepository creates a new FormSectionRepository instance
+func NewFormSectionRepository(base *BaseRepository) interfaces.FormSectionRepository {
+	return &formSectionRepository{
+		BaseRepository: base,
+	}
+}
+
+// scanFormSection scans a single row into entities.FormSection
+func scanFormSection(row pgx.Row) (*entities.FormSection, error) {
+	var s entities.FormSection
+	err := row.Scan(
+		&s.ID,
+		&s.FormID,
+		&s.Title,
+		&s.Description,
+		&s.SectionOrder,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// Create inserts a new form section
+func (r *formSectionRepository) Create(ctx context.Context, s *entities.FormSection) error {
+	if s.ID == uuid.Nil {
+		s.ID = uuid.New()
+	}
+
+	query := `
+		INSERT INTO form_sections
+			(id, form_id, title, description, section_order, created_at, updated_at)
+		VALUES
+			($1,$2,$3,$4,$5,NOW(),NOW())
+	`
+
+	return r.Exec(ctx, query, s.ID, s.FormID, s.Title, s.Description, s.SectionOrder)
+}
+
+// GetByID retrieves a form section by ID
+func (r *formSectionRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.FormSection, error) {
+	query := `
+		SELECT id, form_id, title, description, section_order, created_at, updated_at
+		FROM form_sections
+		WHERE id = $1
+	`
+
+	s, err := scanFormSection(r.QueryRow(ctx, query, id))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	return s, err
+}
+
+// Update modifies an existing form section
+func (r *formSectionRepository) Update(ctx context.Context, s *entities.FormSection) error {
+	query := `
+		UPDATE form_sections
+		SET title = $2,
+		    description = $3,
+		    section_order = $4,
+		    updated_at = NOW()
+		WHERE id = $1
+	`
+
+	tag, err := r.exec.Exec(ctx, query, s.ID, s.Title, s.Description, s.SectionOrder)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// Delete removes a form section by ID
+func (r *formSectionRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	query := `DELETE FROM form_sections WHERE id = $1`
+
+	tag, err := r.exec.Exec(ctx, query, id)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return pgx.ErrNoRows
+	}
+	return nil
+}
+
+// ListByFormID returns the sections of a form ordered by section_order
+func (r *formSectionRepository) ListByFormID(ctx context.Context, formID uuid.UUID) ([]*entities.FormSection, error) {
+	query := `
+		SELECT id, form_id, title, description, section_order, created_at, updated_at
+		FROM form_sections
+		WHERE form_id = $1
+		ORDER BY section_order ASC
+	`
+
+	rows, err := r.Query(ctx, query, formID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var sections []*entities.FormSection
+	for rows.Next() {
+		s, err := scanFormSection(rows)
+		if err != nil {
+			return nil, err
+		}
+		sections = append(sections, s)
+	}
+
+	return sections, rows.Err()
+}
@@ -0,0 +1,107 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// LoginAttemptRepository implements the Postgres store of failed logins
+type LoginAttemptRepository struct {
+	base *BaseRepository
+}
+
+// NewLoginAttemptRepository creates a new repository instance
+func NewLoginAttemptRepository(base *BaseRepository) *LoginAttemptRepository {
+	return &LoginAttemptRepository{base: base}
+}
+
+// Get retrieves the counter of a username or IP
+func (r *LoginAttemptRepository) Get(ctx context.Context, scope enums.LoginScope, key string) (*entities.LoginAttempt, error) {
+	const query = `
+		SELECT scope, key, failures, window_start, locked_until
+		FROM login_attempts
+		WHERE scope = $1 AND key = $2
+	`
+
+	var attempt entities.LoginAttempt
+	err := r.base.QueryRow(ctx, query, scope, key).Scan(
+		&attempt.Scope, &attempt.Key, &attempt.Failures, &attempt.WindowStart, &attempt.LockedUntil,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("LoginAttemptRepository.Get: %w", err)
+	}
+	return &attempt, nil
+}
+
+// RecordFailure increments the counter in a single statement, so concurrent
+// failures are all counted
+func (r *LoginAttemptRepository) RecordFailure(
+	ctx context.Context,
+	scope enums.LoginScope,
+	key string,
+	now time.Time,
+	window time.Duration,
+) (*entities.LoginAttempt, error) {
+	const query = `
+		INSERT INTO login_attempts (scope, key, failures, window_start)
+		VALUES ($1, $2, 1, $3)
+		ON CONFLICT (scope, key) DO UPDATE SET
+			failures = CASE WHEN login_attempts.window_start < $4 THEN 1
+			                ELSE login_attempts.failures + 1 END,
+			window_start = CASE WHEN login_attempts.window_start < $4 THEN $3
+			                    ELSE login_attempts.window_start END
+		RETURNING scope, key, failures, window_start, locked_until
+	`
+
+	var attempt entities.LoginAttempt
+	if err := r.base.QueryRow(ctx, query, scope, key, now, now.Add(-window)).Scan(
+		&attempt.Scope, &attempt.Key, &attempt.Failures, &attempt.WindowStart, &attempt.LockedUntil,
+	); err != nil {
+		return nil, fmt.Errorf("LoginAttemptRepository.RecordFailure: %w", err)
+	}
+	return &attempt, nil
+}
+
+// Lock sets locked_until unless a lockout is already running
+func (r *LoginAttemptRepository) Lock(
+	ctx context.Context,
+	scope enums.LoginScope,
+	key string,
+	now, until time.Time,
+) (bool, error) {
+	const query = `
+		UPDATE login_attempts
+		SET locked_until = $4, failures = 0, window_start = $3
+		WHERE scope = $1 AND key = $2
+		  AND (locked_until IS NULL OR locked_until <= $3)
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	tag, err := r.base.exec.Exec(ctx, query, scope, key, now, until)
+	if err != nil {
+		return false, fmt.Errorf("LoginAttemptRepository.Lock: %w", err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// Reset deletes the counter of a username or IP
+func (r *LoginAttemptRepository) Reset(ctx context.Context, scope enums.LoginScope, key string) error {
+	const query = `DELETE FROM login_attempts WHERE scope = $1 AND key = $2`
+
+	if err := r.base.Exec(ctx, query, scope, key); err != nil {
+		return fmt.Errorf("LoginAttemptRepository.Reset: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// MFARecoveryCodeRepository implements the Postgres store of recovery codes
+type MFARecoveryCodeRepository struct {
+	base *BaseRepository
+}
+
+// NewMFARecoveryCodeRepository creates a new repository instance
+func NewMFARecoveryCodeRepository(base *BaseRepository) *MFARecoveryCodeRepository {
+	return &MFARecoveryCodeRepository{base: base}
+}
+
+// Replace swaps the admin's codes for new ones in one transaction, so an
+// admin never ends up with both sets or none
+func (r *MFARecoveryCodeRepository) Replace(ctx context.Context, adminID uuid.UUID, codes []*entities.MFARecoveryCode) error {
+	const insert = `
+		INSERT INTO mfa_recovery_codes (id, admin_id, code_hash, created_at)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	err := r.base.WithTx(ctx, func(tx *BaseRepository) error {
+		if err := tx.Exec(ctx, `DELETE FROM mfa_recovery_codes WHERE admin_id = $1`, adminID); err != nil {
+			return err
+		}
+
+		for _, code := range codes {
+			if code.ID == uuid.Nil {
+				code.ID = uuid.New()
+			}
+			if code.CreatedAt.IsZero() {
+				code.CreatedAt = time.Now()
+			}
+			code.AdminID = adminID
+
+			if err := tx.Exec(ctx, insert, code.ID, code.AdminID, code.CodeHash, code.CreatedAt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("MFARecoveryCodeRepository.Replace: %w", err)
+	}
+	return nil
+}
+
+// Consume uses a code in a single statement, so concurrent requests cannot
+// both succeed
+func (r *MFARecoveryCodeRepository) Consume(ctx context.Context, adminID uuid.UUID, codeHash string, now time.Time) (bool, error) {
+	const query = `
+		UPDATE mfa_recovery_codes
+		SET used_at = $3
+		WHERE admin_id = $1 AND code_hash = $2 AND used_at IS NULL
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	tag, err := r.base.exec.Exec(ctx, query, adminID, codeHash, now)
+	if err != nil {
+		return false, fmt.Errorf("MFARecoveryCodeRepository.Consume: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+// CountUnused counts the admin's codes that were not used yet
+func (r *MFARecoveryCodeRepository) CountUnused(ctx context.Context, adminID uuid.UUID) (int, error) {
+	const query = `SELECT COUNT(*) FROM mfa_recovery_codes WHERE admin_id = $1 AND used_at IS NULL`
+
+	var count int
+	if err := r.base.QueryRow(ctx, query, adminID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("MFARecoveryCodeRepository.CountUnused: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteAll removes every code of the admin
+func (r *MFARecoveryCodeRepository) DeleteAll(ctx context.Context, adminID uuid.UUID) error {
+	if err := r.base.Exec(ctx, `DELETE FROM mfa_recovery_codes WHERE admin_id = $1`, adminID); err != nil {
+		return fmt.Errorf("MFARecoveryCodeRepository.DeleteAll: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// PasswordResetRepository implements the Postgres store of reset tokens
+type PasswordResetRepository struct {
+	base *BaseRepository
+}
+
+// NewPasswordResetRepository creates a new repository instance
+func NewPasswordResetRepository(base *BaseRepository) *PasswordResetRepository {
+	return &PasswordResetRepository{base: base}
+}
+
+// Create inserts a new reset token
+func (r *PasswordResetRepository) Create(ctx context.Context, reset *entities.PasswordReset) error {
+	if reset.ID == uuid.Nil {
+		reset.ID = uuid.New()
+	}
+	if reset.CreatedAt.IsZero() {
+		reset.CreatedAt = time.Now()
+	}
+
+	const query = `
+		INSERT INTO password_resets (id, admin_id, token_hash, created_by, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	if err := r.base.Exec(ctx, query,
+		reset.ID, reset.AdminID, reset.TokenHash, reset.CreatedBy, reset.ExpiresAt, reset.CreatedAt,
+	); err != nil {
+		return fmt.Errorf("PasswordResetRepository.Create: %w", err)
+	}
+	return nil
+}
+
+// Get retrieves a usable token by its hash
+func (r *PasswordResetRepository) Get(ctx context.Context, tokenHash string, now time.Time) (*entities.PasswordReset, error) {
+	const query = `
+		SELECT id, admin_id, token_hash, created_by, expires_at, used_at, created_at
+		FROM password_resets
+		WHERE token_hash = $1 AND used_at IS NULL AND expires_at > $2
+	`
+
+	var reset entities.PasswordReset
+	if err := r.base.QueryRow(ctx, query, tokenHash, now).Scan(
+		&reset.ID, &reset.AdminID, &reset.TokenHash, &reset.CreatedBy,
+		&reset.ExpiresAt, &reset.UsedAt, &reset.CreatedAt,
+	); err != nil {
+		return nil, fmt.Errorf("PasswordResetRepository.Get: %w", err)
+	}
+	return &reset, nil
+}
+
+// DeleteUnused removes an admin's pending tokens
+func (r *PasswordResetRepository) DeleteUnused(ctx context.Context, adminID uuid.UUID) error {
+	const query = `DELETE FROM password_resets WHERE admin_id = $1 AND used_at IS NULL`
+
+	if err := r.base.Exec(ctx, query, adminID); err != nil {
+		return fmt.Errorf("PasswordResetRepository.DeleteUnused: %w", err)
+	}
+	return nil
+}
+
+// Consume uses a token in a single statement, so concurrent requests cannot
+// both succeed
+func (r *PasswordResetRepository) Consume(ctx context.Context, tokenHash string, now time.Time) (*entities.PasswordReset, error) {
+	const query = `
+		UPDATE password_resets
+		SET used_at = $2
+		WHERE token_hash = $1 AND used_at IS NULL AND expires_at > $2
+		RETURNING id, admin_id, token_hash, created_by, expires_at, used_at, created_at
+	`
+
+	var reset entities.PasswordReset
+	if err := r.base.QueryRow(ctx, query, tokenHash, now).Scan(
+		&reset.ID, &reset.AdminID, &reset.TokenHash, &reset.CreatedBy,
+		&reset.ExpiresAt, &reset.UsedAt, &reset.CreatedAt,
+	); err != nil {
+		return nil, fmt.Errorf("PasswordResetRepository.Consume: %w", err)
+	}
+	return &reset, nil
+}
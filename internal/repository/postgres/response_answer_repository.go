@@ -137,6 +137,12 @@ func (r *ResponseAnswerRepository) List(ctx context.Context, filter interfaces.R
 		argPos++
 	}
 
+	if len(filter.ResponseIDs) > 0 {
+		query += fmt.Sprintf(" AND response_id = ANY($%d)", argPos)
+		args = append(args, filter.ResponseIDs)
+		argPos++
+	}
+
 	if filter.FieldID != nil {
 		query += fmt.Sprintf(" AND field_id=$%d", argPos)
 		args = append(args, *filter.FieldID)
@@ -163,12 +169,75 @@ func (r *ResponseAnswerRepository) List(ctx context.Context, filter interfaces.R
 	return answers, nil
 }
 
+// CountSelectionPairs joins the selections of two choice fields on their response.
+// Checkbox answers contribute one pair per selected option, so the respondents
+// are counted separately; the count is repeated on every row.
+func (r *ResponseAnswerRepository) CountSelectionPairs(
+	ctx context.Context,
+	formID, rowFieldID, columnFieldID uuid.UUID,
+) ([]entities.SelectionPair, int, error) {
+	query := `
+		WITH selections AS (
+			SELECT ra.response_id, ra.field_id, sel.key
+			FROM response_answers ra
+			JOIN responses r ON r.id = ra.response_id
+			CROSS JOIN LATERAL ` + selectedKeys + ` sel
+			WHERE r.form_id = $1 AND r.status <> $4
+			  AND ra.field_id IN ($2, $3)
+			  AND ra.value ? 'selected'
+			  AND sel.key <> ''
+		),
+		pairs AS (
+			SELECT a.response_id, a.key AS row_key, b.key AS column_key
+			FROM selections a
+			JOIN selections b ON b.response_id = a.response_id
+			WHERE a.field_id = $2 AND b.field_id = $3
+		)
+		SELECT row_key, column_key, COUNT(*),
+			(SELECT COUNT(DISTINCT response_id) FROM pairs)
+		FROM pairs
+		GROUP BY row_key, column_key
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	rows, err := r.base.exec.Query(ctx, query, formID, rowFieldID, columnFieldID, enums.ResponsePending)
+	if err != nil {
+		return nil, 0, fmt.Errorf("CountSelectionPairs: %w", err)
+	}
+	defer rows.Close()
+
+	var (
+		pairs       []entities.SelectionPair
+		respondents int
+	)
+	for rows.Next() {
+		var p entities.SelectionPair
+		if err := rows.Scan(&p.RowKey, &p.ColumnKey, &p.Count, &respondents); err != nil {
+			return nil, 0, fmt.Errorf("CountSelectionPairs.Scan: %w", err)
+		}
+		pairs = append(pairs, p)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("CountSelectionPairs: %w", err)
+	}
+
+	return pairs, respondents, nil
+}
+
 // Delete removes an answer by ID
 func (r *ResponseAnswerRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	const query = `DELETE FROM response_answers WHERE id=$1`
 	return r.base.Exec(ctx, query, id)
 }
 
+// DeleteByResponseID removes every answer of a response
+func (r *ResponseAnswerRepository) DeleteByResponseID(ctx context.Context, responseID uuid.UUID) error {
+	const query = `DELETE FROM response_answers WHERE response_id=$1`
+	return r.base.Exec(ctx, query, responseID)
+}
+
 // Base returns the underlying BaseRepository for transactional use
 func (r *ResponseAnswerRepository) Base() *BaseRepository {
 	return r.base
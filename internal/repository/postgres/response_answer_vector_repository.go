@@ -3,13 +3,19 @@ package postgres
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
 	"Skillture_Form/internal/repository/interfaces"
 
 	"github.com/google/uuid"
+	"github.com/pgvector/pgvector-go"
 )
 
+// maxEfSearch is the largest hnsw.ef_search pgvector accepts
+const maxEfSearch = 1000
+
 // ResponseAnswerVectorRepository implements Postgres CRUD for embeddings/vectors
 // Responsibilities:
 // - Create single or bulk vectors
@@ -53,7 +59,7 @@ func (r *ResponseAnswerVectorRepository) Create(ctx context.Context, vector *ent
 		) VALUES ($1, $2, $3, $4, NOW())
 	`
 
-	return r.base.Exec(ctx, query, vector.ID, vector.ResponseAnswerID, vector.Embedding, vector.ModelName)
+	return r.base.Exec(ctx, query, vector.ID, vector.ResponseAnswerID, pgvector.NewVector(vector.Embedding), vector.ModelName)
 }
 
 // CreateBulk inserts multiple vectors at once
@@ -72,10 +78,11 @@ func (r *ResponseAnswerVectorRepository) CreateBulk(ctx context.Context, vectors
 		INSERT INTO response_answer_vectors (
 			id, response_answer_id, embedding, model_name, created_at
 		) VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (response_answer_id, model_name) DO NOTHING
 	`
 
 	for _, v := range vectors {
-		if err := r.base.Exec(ctx, query, v.ID, v.ResponseAnswerID, v.Embedding, v.ModelName); err != nil {
+		if err := r.base.Exec(ctx, query, v.ID, v.ResponseAnswerID, pgvector.NewVector(v.Embedding), v.ModelName); err != nil {
 			return fmt.Errorf("CreateBulk: %w", err)
 		}
 	}
@@ -93,9 +100,11 @@ func (r *ResponseAnswerVectorRepository) GetByID(ctx context.Context, id uuid.UU
 
 	row := r.base.QueryRow(ctx, query, id)
 	var v entities.ResponseAnswerVector
-	if err := row.Scan(&v.ID, &v.ResponseAnswerID, &v.Embedding, &v.ModelName, &v.CreatedAt); err != nil {
+	var embedding pgvector.Vector
+	if err := row.Scan(&v.ID, &v.ResponseAnswerID, &embedding, &v.ModelName, &v.CreatedAt); err != nil {
 		return nil, fmt.Errorf("GetByID: %w", err)
 	}
+	v.Embedding = embedding.Slice()
 
 	return &v, nil
 }
@@ -131,15 +140,230 @@ func (r *ResponseAnswerVectorRepository) List(ctx context.Context, filter interf
 	var vectors []*entities.ResponseAnswerVector
 	for rows.Next() {
 		var v entities.ResponseAnswerVector
-		if err := rows.Scan(&v.ID, &v.ResponseAnswerID, &v.Embedding, &v.ModelName, &v.CreatedAt); err != nil {
+		var embedding pgvector.Vector
+		if err := rows.Scan(&v.ID, &v.ResponseAnswerID, &embedding, &v.ModelName, &v.CreatedAt); err != nil {
 			return nil, fmt.Errorf("List.Scan: %w", err)
 		}
+		v.Embedding = embedding.Slice()
 		vectors = append(vectors, &v)
 	}
 
 	return vectors, nil
 }
 
+// ListUnembeddedAnswers returns non-empty answers of submitted responses that
+// have no vector for the model yet. Drafts are skipped until they are submitted.
+func (r *ResponseAnswerVectorRepository) ListUnembeddedAnswers(
+	ctx context.Context,
+	filter interfaces.UnembeddedAnswerFilter,
+) ([]*entities.ResponseAnswer, error) {
+	types := make([]string, len(filter.FieldTypes))
+	for i, t := range filter.FieldTypes {
+		types[i] = t.String()
+	}
+
+	query := `
+		SELECT ra.id, ra.response_id, ra.field_id, ra.field_type, ra.value, ra.created_at
+		FROM response_answers ra
+		JOIN responses r ON r.id = ra.response_id
+		WHERE r.status <> $4
+		  AND ra.field_type = ANY($1)
+		  AND btrim(COALESCE(ra.value->>'text', ra.value->>'en', '')) <> ''
+		  AND NOT EXISTS (
+			SELECT 1 FROM response_answer_vectors v
+			WHERE v.response_answer_id = ra.id AND v.model_name = $2
+		  )
+		  AND ra.id > $3
+	`
+	args := []interface{}{types, filter.ModelName, filter.AfterID, enums.ResponsePending}
+
+	if filter.ResponseID != nil {
+		args = append(args, *filter.ResponseID)
+		query += fmt.Sprintf(" AND ra.response_id = $%d", len(args))
+	}
+
+	query += " ORDER BY ra.id"
+	if filter.Limit > 0 {
+		args = append(args, filter.Limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	// Keep the timeout context alive while rows are read
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	rows, err := r.base.exec.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ListUnembeddedAnswers: %w", err)
+	}
+	defer rows.Close()
+
+	var answers []*entities.ResponseAnswer
+	for rows.Next() {
+		var a entities.ResponseAnswer
+		var fieldTypeStr string
+		if err := rows.Scan(&a.ID, &a.ResponseID, &a.FieldID, &fieldTypeStr, &a.Value, &a.CreatedAt); err != nil {
+			return nil, fmt.Errorf("ListUnembeddedAnswers.Scan: %w", err)
+		}
+		a.FieldType = enums.ParseFieldType(fieldTypeStr)
+		answers = append(answers, &a)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListUnembeddedAnswers: %w", err)
+	}
+
+	return answers, nil
+}
+
+// SimilaritySearch ranks answers by cosine distance using the HNSW index.
+// The index is filtered after the scan, so ef_search is raised with k to
+// still find k matches when only part of the vectors belong to the form.
+// With filter.Exact the filters are applied first and the remaining vectors
+// are sorted without the index, which is exact but scans every candidate.
+func (r *ResponseAnswerVectorRepository) SimilaritySearch(
+	ctx context.Context,
+	formID uuid.UUID,
+	queryEmbedding []float32,
+	k int,
+	filter interfaces.SimilarityFilter,
+) ([]*entities.AnswerMatch, error) {
+	query := `
+		SELECT
+			ra.id, ra.response_id, ra.field_id, ra.field_type, ra.value, ra.created_at,
+			r.id, r.form_id, r.respondent, r.status, r.submitted_at, r.updated_at,
+			r.reviewed_by, r.reviewed_at, r.review_notes,
+			1 - (v.embedding <=> $1) AS similarity
+		FROM response_answer_vectors v
+		JOIN response_answers ra ON ra.id = v.response_answer_id
+		JOIN responses r ON r.id = ra.response_id
+		WHERE r.form_id = $2
+		  AND r.status <> $4
+		  AND v.model_name = $3
+	`
+	args := []interface{}{pgvector.NewVector(queryEmbedding), formID, filter.ModelName, enums.ResponsePending}
+
+	if filter.FieldID != nil {
+		args = append(args, *filter.FieldID)
+		query += fmt.Sprintf(" AND ra.field_id = $%d", len(args))
+	}
+	if filter.ExcludeResponseID != nil {
+		args = append(args, *filter.ExcludeResponseID)
+		query += fmt.Sprintf(" AND ra.response_id <> $%d", len(args))
+	}
+
+	args = append(args, k)
+	if filter.Exact {
+		// Ordering by similarity rather than the bare distance keeps the
+		// planner off the HNSW index. Zero vectors have no direction and
+		// a NaN distance, which would otherwise sort first.
+		query += fmt.Sprintf(" AND (v.embedding <=> $1) <> 'NaN'::float8 ORDER BY similarity DESC LIMIT $%d", len(args))
+	} else {
+		query += fmt.Sprintf(" ORDER BY v.embedding <=> $1 LIMIT $%d", len(args))
+	}
+
+	efSearch := min(max(k*4, 100), maxEfSearch)
+
+	var matches []*entities.AnswerMatch
+	err := r.base.WithTx(ctx, func(tx *BaseRepository) error {
+		ctx, cancel := tx.context(ctx)
+		defer cancel()
+
+		// Scoped to this transaction
+		if !filter.Exact {
+			if _, err := tx.exec.Exec(ctx, `SELECT set_config('hnsw.ef_search', $1, true)`, strconv.Itoa(efSearch)); err != nil {
+				return err
+			}
+		}
+
+		rows, err := tx.exec.Query(ctx, query, args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var (
+				a            entities.ResponseAnswer
+				resp         entities.Response
+				fieldTypeStr string
+				notes        *string
+				similarity   float64
+			)
+			if err := rows.Scan(
+				&a.ID, &a.ResponseID, &a.FieldID, &fieldTypeStr, &a.Value, &a.CreatedAt,
+				&resp.ID, &resp.FormID, &resp.Respondent, &resp.Status, &resp.SubmittedAt, &resp.UpdatedAt,
+				&resp.ReviewedBy, &resp.ReviewedAt, &notes,
+				&similarity,
+			); err != nil {
+				return err
+			}
+			a.FieldType = enums.ParseFieldType(fieldTypeStr)
+			if notes != nil {
+				resp.ReviewNotes = *notes
+			}
+			matches = append(matches, &entities.AnswerMatch{Similarity: similarity, Answer: &a, Response: &resp})
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, fmt.Errorf("SimilaritySearch: %w", err)
+	}
+
+	return matches, nil
+}
+
+// ListFieldEmbeddings loads the vectors of a field's answers for clustering
+func (r *ResponseAnswerVectorRepository) ListFieldEmbeddings(
+	ctx context.Context,
+	fieldID uuid.UUID,
+	modelName enums.ModelName,
+	limit int,
+) ([]*entities.EmbeddedAnswer, error) {
+	query := `
+		SELECT ra.id, ra.response_id, ra.field_id, ra.field_type, ra.value, ra.created_at, v.embedding
+		FROM response_answer_vectors v
+		JOIN response_answers ra ON ra.id = v.response_answer_id
+		JOIN responses r ON r.id = ra.response_id
+		WHERE ra.field_id = $1
+		  AND r.status <> $3
+		  AND v.model_name = $2
+		ORDER BY r.submitted_at DESC, ra.id
+	`
+	args := []interface{}{fieldID, modelName, enums.ResponsePending}
+
+	if limit > 0 {
+		args = append(args, limit)
+		query += fmt.Sprintf(" LIMIT $%d", len(args))
+	}
+
+	// Keep the timeout context alive while rows are read
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	rows, err := r.base.exec.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("ListFieldEmbeddings: %w", err)
+	}
+	defer rows.Close()
+
+	var answers []*entities.EmbeddedAnswer
+	for rows.Next() {
+		var a entities.ResponseAnswer
+		var fieldTypeStr string
+		var embedding pgvector.Vector
+		if err := rows.Scan(&a.ID, &a.ResponseID, &a.FieldID, &fieldTypeStr, &a.Value, &a.CreatedAt, &embedding); err != nil {
+			return nil, fmt.Errorf("ListFieldEmbeddings.Scan: %w", err)
+		}
+		a.FieldType = enums.ParseFieldType(fieldTypeStr)
+		answers = append(answers, &entities.EmbeddedAnswer{Answer: &a, Embedding: embedding.Slice()})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ListFieldEmbeddings: %w", err)
+	}
+
+	return answers, nil
+}
+
 // Delete removes a vector by ID
 func (r *ResponseAnswerVectorRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	const query = `DELETE FROM response_answer_vectors WHERE id=$1`
@@ -0,0 +1,88 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/repository/interfaces"
+
+	"github.com/google/uuid"
+)
+
+// ResponseFlagRepository implements Postgres storage of response review flags
+type ResponseFlagRepository struct {
+	base *BaseRepository
+}
+
+// NewResponseFlagRepository creates a new repository instance
+func NewResponseFlagRepository(base *BaseRepository) *ResponseFlagRepository {
+	return &ResponseFlagRepository{base: base}
+}
+
+// Create inserts a flag unless the response already has one for the same reason and field
+func (r *ResponseFlagRepository) Create(ctx context.Context, flag *entities.ResponseFlag) error {
+	if flag.ID == uuid.Nil {
+		flag.ID = uuid.New()
+	}
+
+	const query = `
+		INSERT INTO response_flags (
+			id, response_id, reason, field_id, matched_response_id, similarity, created_at
+		) VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		ON CONFLICT (response_id, reason, field_id) DO NOTHING
+	`
+
+	if err := r.base.Exec(ctx, query,
+		flag.ID, flag.ResponseID, flag.Reason, flag.FieldID, flag.MatchedResponseID, flag.Similarity,
+	); err != nil {
+		return fmt.Errorf("ResponseFlagRepository.Create: %w", err)
+	}
+	return nil
+}
+
+// ListByResponseIDs loads the flags of a page of responses in one query
+func (r *ResponseFlagRepository) ListByResponseIDs(ctx context.Context, responseIDs []uuid.UUID) ([]*entities.ResponseFlag, error) {
+	if len(responseIDs) == 0 {
+		return nil, nil
+	}
+
+	const query = `
+		SELECT id, response_id, reason, field_id, matched_response_id, similarity, created_at
+		FROM response_flags
+		WHERE response_id = ANY($1)
+		ORDER BY created_at ASC, id ASC
+	`
+
+	// Keep the timeout context alive while rows are read
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	rows, err := r.base.exec.Query(ctx, query, responseIDs)
+	if err != nil {
+		return nil, fmt.Errorf("ResponseFlagRepository.ListByResponseIDs: %w", err)
+	}
+	defer rows.Close()
+
+	var flags []*entities.ResponseFlag
+	for rows.Next() {
+		var f entities.ResponseFlag
+		if err := rows.Scan(&f.ID, &f.ResponseID, &f.Reason, &f.FieldID, &f.MatchedResponseID, &f.Similarity, &f.CreatedAt); err != nil {
+			return nil, fmt.Errorf("ResponseFlagRepository.ListByResponseIDs.Scan: %w", err)
+		}
+		flags = append(flags, &f)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("ResponseFlagRepository.ListByResponseIDs: %w", err)
+	}
+
+	return flags, nil
+}
+
+// WithTxRepo creates a repository instance bound to the given transaction
+func (r *ResponseFlagRepository) WithTxRepo(txRepo interfaces.ResponseRepository) interfaces.ResponseFlagRepository {
+	if impl, ok := txRepo.(*ResponseRepository); ok {
+		return &ResponseFlagRepository{base: impl.base}
+	}
+	return r
+}
@@ -2,12 +2,17 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/repository"
 	"Skillture_Form/internal/repository/interfaces"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 // ResponseRepository implements PostgreSQL operations for Responses
@@ -28,6 +33,8 @@ func (r *ResponseRepository) WithTx(
 		responseRepo interfaces.ResponseRepository,
 		answerRepo interfaces.ResponseAnswerRepository,
 		vectorRepo interfaces.ResponseAnswerVectorRepository,
+		webhookRepo interfaces.WebhookRepository,
+		emailRepo interfaces.EmailJobRepository,
 	) error,
 ) error {
 	return r.base.WithTx(ctx, func(txBase *BaseRepository) error {
@@ -35,67 +42,441 @@ func (r *ResponseRepository) WithTx(
 			NewResponseRepository(txBase),
 			NewResponseAnswerRepository(txBase),
 			NewResponseAnswerVectorRepository(txBase),
+			NewWebhookRepository(txBase),
+			NewEmailJobRepository(txBase),
 		)
 	})
 }
 
+// responseColumns is the column list read by scanResponse
+const responseColumns = `id, form_id, respondent, status, submitted_at, updated_at, reviewed_by, reviewed_at, review_notes`
+
+// scanResponse scans a row into Response
+func scanResponse(row pgx.Row) (*entities.Response, error) {
+	var resp entities.Response
+	var notes *string
+	if err := row.Scan(
+		&resp.ID, &resp.FormID, &resp.Respondent, &resp.Status, &resp.SubmittedAt, &resp.UpdatedAt,
+		&resp.ReviewedBy, &resp.ReviewedAt, &notes,
+	); err != nil {
+		return nil, err
+	}
+	if notes != nil {
+		resp.ReviewNotes = *notes
+	}
+	return &resp, nil
+}
+
 // Create inserts a new response
 func (r *ResponseRepository) Create(ctx context.Context, response *entities.Response) error {
 	if response.ID == uuid.Nil {
 		response.ID = uuid.New()
 	}
+	if response.UpdatedAt.IsZero() {
+		response.UpdatedAt = time.Now()
+	}
 
 	const query = `
 		INSERT INTO responses (
-			id, form_id, respondent, status, submitted_at
-		) VALUES ($1, $2, $3, $4, $5)
+			id, form_id, respondent, status, submitted_at, updated_at, resume_token_hash, fingerprint
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	return r.base.Exec(ctx, query,
+		response.ID, response.FormID, response.Respondent, response.Status,
+		response.SubmittedAt, response.UpdatedAt, nullIfEmpty(response.ResumeTokenHash),
+		nullIfEmpty(response.Fingerprint),
+	)
+}
+
+// Update updates the respondent, status, timestamps, resume token and fingerprint of a response
+func (r *ResponseRepository) Update(ctx context.Context, response *entities.Response) error {
+	const query = `
+		UPDATE responses
+		SET respondent=$2, status=$3, submitted_at=$4, updated_at=$5, resume_token_hash=$6, fingerprint=$7
+		WHERE id=$1
 	`
 
-	return r.base.Exec(ctx, query, response.ID, response.FormID, response.Respondent, response.Status, response.SubmittedAt)
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	tag, err := r.base.exec.Exec(ctx, query,
+		response.ID, response.Respondent, response.Status,
+		response.SubmittedAt, response.UpdatedAt, nullIfEmpty(response.ResumeTokenHash),
+		nullIfEmpty(response.Fingerprint),
+	)
+	if err != nil {
+		return fmt.Errorf("Update: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("Update: %w", pgx.ErrNoRows)
+	}
+	return nil
 }
 
 // GetByID retrieves a response by ID
 func (r *ResponseRepository) GetByID(ctx context.Context, id uuid.UUID) (*entities.Response, error) {
 	const query = `
-		SELECT id, form_id, respondent, status, submitted_at
+		SELECT ` + responseColumns + `
 		FROM responses
 		WHERE id=$1
 	`
 
-	row := r.base.QueryRow(ctx, query, id)
-	var resp entities.Response
-	if err := row.Scan(&resp.ID, &resp.FormID, &resp.Respondent, &resp.Status, &resp.SubmittedAt); err != nil {
+	resp, err := scanResponse(r.base.QueryRow(ctx, query, id))
+	if err != nil {
 		return nil, fmt.Errorf("GetByID: %w", err)
 	}
 
-	return &resp, nil
+	return resp, nil
 }
 
-// ListByFormID lists all responses of a form
-func (r *ResponseRepository) ListByFormID(ctx context.Context, formID uuid.UUID) ([]*entities.Response, error) {
+// GetDraftByTokenHash retrieves a pending response by the hash of its resume token
+func (r *ResponseRepository) GetDraftByTokenHash(ctx context.Context, tokenHash string) (*entities.Response, error) {
 	const query = `
-		SELECT id, form_id, respondent, status, submitted_at
+		SELECT ` + responseColumns + `
 		FROM responses
-		WHERE form_id=$1
-		ORDER BY submitted_at DESC
+		WHERE resume_token_hash=$1 AND status=$2
+	`
+
+	resp, err := scanResponse(r.base.QueryRow(ctx, query, tokenHash, enums.ResponsePending))
+	if err != nil {
+		return nil, fmt.Errorf("GetDraftByTokenHash: %w", err)
+	}
+	resp.ResumeTokenHash = tokenHash
+
+	return resp, nil
+}
+
+// LockDraft retrieves a pending response by the hash of its resume token and
+// locks its row until the surrounding transaction ends
+func (r *ResponseRepository) LockDraft(ctx context.Context, tokenHash string) (*entities.Response, error) {
+	const query = `
+		SELECT ` + responseColumns + `
+		FROM responses
+		WHERE resume_token_hash=$1 AND status=$2
+		FOR UPDATE
+	`
+
+	resp, err := scanResponse(r.base.QueryRow(ctx, query, tokenHash, enums.ResponsePending))
+	if err != nil {
+		return nil, fmt.Errorf("LockDraft: %w", err)
+	}
+	resp.ResumeTokenHash = tokenHash
+
+	return resp, nil
+}
+
+// UpdateDraft updates a response like Update, but only while it is still the
+// pending draft with tokenHash; otherwise pgx.ErrNoRows is returned. A draft
+// that was submitted meanwhile is never reopened.
+func (r *ResponseRepository) UpdateDraft(ctx context.Context, response *entities.Response, tokenHash string) error {
+	const query = `
+		UPDATE responses
+		SET respondent=$2, status=$3, submitted_at=$4, updated_at=$5, resume_token_hash=$6, fingerprint=$7
+		WHERE id=$1 AND status=$8 AND resume_token_hash=$9
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	tag, err := r.base.exec.Exec(ctx, query,
+		response.ID, response.Respondent, response.Status,
+		response.SubmittedAt, response.UpdatedAt, nullIfEmpty(response.ResumeTokenHash),
+		nullIfEmpty(response.Fingerprint), enums.ResponsePending, tokenHash,
+	)
+	if err != nil {
+		return fmt.Errorf("UpdateDraft: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("UpdateDraft: %w", pgx.ErrNoRows)
+	}
+	return nil
+}
+
+// UpdateReview stores a review decision and appends it to the status history.
+// The update only applies while the response still has change.FromStatus, so
+// concurrent reviews cannot skip a step; otherwise pgx.ErrNoRows is returned.
+func (r *ResponseRepository) UpdateReview(ctx context.Context, response *entities.Response, change *entities.ResponseStatusChange) error {
+	const updateQuery = `
+		UPDATE responses
+		SET status=$2, reviewed_by=$3, reviewed_at=$4, review_notes=$5, updated_at=$6
+		WHERE id=$1 AND status=$7
+	`
+	const historyQuery = `
+		INSERT INTO response_status_history (
+			id, response_id, from_status, to_status, changed_by, notes, changed_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	return r.base.WithTx(ctx, func(tx *BaseRepository) error {
+		tag, err := tx.exec.Exec(ctx, updateQuery,
+			response.ID, response.Status, response.ReviewedBy, response.ReviewedAt,
+			nullIfEmpty(response.ReviewNotes), response.UpdatedAt, change.FromStatus,
+		)
+		if err != nil {
+			return fmt.Errorf("UpdateReview: %w", err)
+		}
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("UpdateReview: %w", pgx.ErrNoRows)
+		}
+
+		if err := tx.Exec(ctx, historyQuery,
+			change.ID, change.ResponseID, change.FromStatus, change.ToStatus,
+			change.ChangedBy, nullIfEmpty(change.Notes), change.ChangedAt,
+		); err != nil {
+			return fmt.Errorf("UpdateReview.History: %w", err)
+		}
+		return nil
+	})
+}
+
+// ListStatusHistory lists the status changes of a response, oldest first
+func (r *ResponseRepository) ListStatusHistory(ctx context.Context, responseID uuid.UUID) ([]*entities.ResponseStatusChange, error) {
+	const query = `
+		SELECT id, response_id, from_status, to_status, changed_by, notes, changed_at
+		FROM response_status_history
+		WHERE response_id=$1
+		ORDER BY changed_at ASC
+	`
+
+	rows, err := r.base.Query(ctx, query, responseID)
+	if err != nil {
+		return nil, fmt.Errorf("ListStatusHistory: %w", err)
+	}
+	defer rows.Close()
+
+	var history []*entities.ResponseStatusChange
+	for rows.Next() {
+		var c entities.ResponseStatusChange
+		var notes *string
+		if err := rows.Scan(&c.ID, &c.ResponseID, &c.FromStatus, &c.ToStatus, &c.ChangedBy, &notes, &c.ChangedAt); err != nil {
+			return nil, fmt.Errorf("ListStatusHistory.Scan: %w", err)
+		}
+		if notes != nil {
+			c.Notes = *notes
+		}
+		history = append(history, &c)
+	}
+
+	return history, rows.Err()
+}
+
+// List returns a page of a form's submitted responses (drafts are excluded)
+// ordered by submitted_at, together with the total number of matches
+func (r *ResponseRepository) List(ctx context.Context, filter interfaces.ResponseFilter) ([]*entities.Response, int, error) {
+	filter.Pagination.Normalize()
+	order, ok := repository.ParseSortOrder(string(filter.Sort), repository.SortDesc)
+	if !ok {
+		return nil, 0, repository.ErrInvalidInput
+	}
+
+	where := " WHERE form_id=$1 AND status<>$2"
+	args := []any{filter.FormID, enums.ResponsePending}
+	argPos := 3
+
+	if filter.Status != nil {
+		where += fmt.Sprintf(" AND status=$%d", argPos)
+		args = append(args, *filter.Status)
+		argPos++
+	}
+	if filter.SubmittedFrom != nil {
+		where += fmt.Sprintf(" AND submitted_at >= $%d", argPos)
+		args = append(args, *filter.SubmittedFrom)
+		argPos++
+	}
+	if filter.SubmittedTo != nil {
+		where += fmt.Sprintf(" AND submitted_at < $%d", argPos)
+		args = append(args, *filter.SubmittedTo)
+		argPos++
+	}
+	if filter.Email != nil {
+		where += fmt.Sprintf(" AND lower(respondent->>'email') = lower($%d)", argPos)
+		args = append(args, *filter.Email)
+		argPos++
+	}
+	if filter.Flagged != nil {
+		not := ""
+		if !*filter.Flagged {
+			not = "NOT "
+		}
+		where += " AND " + not + "EXISTS (SELECT 1 FROM response_flags f WHERE f.response_id = responses.id)"
+	}
+
+	var total int
+	if err := r.base.QueryRow(ctx, "SELECT COUNT(*) FROM responses"+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("List.Count: %w", err)
+	}
+
+	query := "SELECT " + responseColumns + " FROM responses" + where +
+		fmt.Sprintf(" ORDER BY submitted_at %s, id %s LIMIT $%d OFFSET $%d", order, order, argPos, argPos+1)
+	args = append(args, filter.Pagination.Limit, filter.Pagination.Offset)
+
+	rows, err := r.base.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("List: %w", err)
+	}
+	defer rows.Close()
+
+	responses := make([]*entities.Response, 0, filter.Pagination.Limit)
+	for rows.Next() {
+		resp, err := scanResponse(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("List.Scan: %w", err)
+		}
+		responses = append(responses, resp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("List: %w", err)
+	}
+
+	return responses, total, nil
+}
+
+// StreamByFormID calls fn for every submitted response of a form, oldest first,
+// with its answers populated. Rows are read from the cursor one at a time, and
+// the query is bounded by ctx instead of the repository timeout so that large
+// exports can complete.
+func (r *ResponseRepository) StreamByFormID(
+	ctx context.Context,
+	formID uuid.UUID,
+	fn func(response *entities.Response) error,
+) error {
+	const query = `
+		SELECT r.id, r.form_id, r.respondent, r.status, r.submitted_at, r.updated_at,
+		       r.reviewed_by, r.reviewed_at, r.review_notes,
+		       COALESCE(
+		           jsonb_agg(jsonb_build_object(
+		               'id', a.id, 'field_id', a.field_id, 'field_type', a.field_type, 'value', a.value
+		           )) FILTER (WHERE a.id IS NOT NULL),
+		           '[]'::jsonb
+		       )
+		FROM responses r
+		LEFT JOIN response_answers a ON a.response_id = r.id
+		WHERE r.form_id=$1 AND r.status<>$2
+		GROUP BY r.id
+		ORDER BY r.submitted_at ASC, r.id ASC
 	`
 
-	rows, err := r.base.Query(ctx, query, formID)
+	rows, err := r.base.exec.Query(ctx, query, formID, enums.ResponsePending)
 	if err != nil {
-		return nil, fmt.Errorf("ListByFormID: %w", err)
+		return fmt.Errorf("StreamByFormID: %w", err)
 	}
 	defer rows.Close()
 
-	var responses []*entities.Response
 	for rows.Next() {
 		var resp entities.Response
-		if err := rows.Scan(&resp.ID, &resp.FormID, &resp.Respondent, &resp.Status, &resp.SubmittedAt); err != nil {
-			return nil, fmt.Errorf("ListByFormID.Scan: %w", err)
+		var notes *string
+		var answers []struct {
+			ID        uuid.UUID      `json:"id"`
+			FieldID   uuid.UUID      `json:"field_id"`
+			FieldType string         `json:"field_type"`
+			Value     map[string]any `json:"value"`
+		}
+		if err := rows.Scan(
+			&resp.ID, &resp.FormID, &resp.Respondent, &resp.Status, &resp.SubmittedAt, &resp.UpdatedAt,
+			&resp.ReviewedBy, &resp.ReviewedAt, &notes, &answers,
+		); err != nil {
+			return fmt.Errorf("StreamByFormID.Scan: %w", err)
+		}
+		if notes != nil {
+			resp.ReviewNotes = *notes
+		}
+		for _, a := range answers {
+			resp.Answers = append(resp.Answers, &entities.ResponseAnswer{
+				ID:         a.ID,
+				ResponseID: resp.ID,
+				FieldID:    a.FieldID,
+				FieldType:  enums.ParseFieldType(a.FieldType),
+				Value:      a.Value,
+			})
+		}
+
+		if err := fn(&resp); err != nil {
+			return err
 		}
-		responses = append(responses, &resp)
 	}
 
-	return responses, nil
+	return rows.Err()
+}
+
+// ListRespondentKeys returns the distinct keys used in the respondent JSON of a form's responses
+func (r *ResponseRepository) ListRespondentKeys(ctx context.Context, formID uuid.UUID) ([]string, error) {
+	const query = `
+		SELECT DISTINCT jsonb_object_keys(respondent)
+		FROM responses
+		WHERE form_id=$1 AND status<>$2 AND jsonb_typeof(respondent) = 'object'
+	`
+
+	rows, err := r.base.Query(ctx, query, formID, enums.ResponsePending)
+	if err != nil {
+		return nil, fmt.Errorf("ListRespondentKeys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []string
+	for rows.Next() {
+		var key string
+		if err := rows.Scan(&key); err != nil {
+			return nil, fmt.Errorf("ListRespondentKeys.Scan: %w", err)
+		}
+		keys = append(keys, key)
+	}
+
+	return keys, rows.Err()
+}
+
+// FindDuplicate looks for the latest earlier submitted response matching filter
+func (r *ResponseRepository) FindDuplicate(ctx context.Context, filter interfaces.DuplicateFilter) (*uuid.UUID, error) {
+	query := `SELECT id FROM responses WHERE form_id=$1 AND id<>$2 AND status<>$3`
+	args := []any{filter.FormID, filter.ExcludeID, enums.ResponsePending}
+
+	if filter.Email != nil {
+		args = append(args, *filter.Email)
+		query += fmt.Sprintf(" AND lower(respondent->>'email') = lower($%d)", len(args))
+	}
+	if filter.Fingerprint != nil {
+		args = append(args, *filter.Fingerprint)
+		query += fmt.Sprintf(" AND fingerprint = $%d", len(args))
+	}
+	if filter.Since != nil {
+		args = append(args, *filter.Since)
+		query += fmt.Sprintf(" AND submitted_at >= $%d", len(args))
+	}
+	query += " ORDER BY submitted_at DESC LIMIT 1"
+
+	var id uuid.UUID
+	if err := r.base.QueryRow(ctx, query, args...).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("FindDuplicate: %w", err)
+	}
+	return &id, nil
+}
+
+// LockSubmissionKey takes a transaction-scoped advisory lock on form and key,
+// so two concurrent submissions cannot both pass a duplicate check
+func (r *ResponseRepository) LockSubmissionKey(ctx context.Context, formID uuid.UUID, key string) error {
+	const query = `SELECT pg_advisory_xact_lock(hashtextextended($1, 0))`
+	if err := r.base.Exec(ctx, query, formID.String()+":"+key); err != nil {
+		return fmt.Errorf("LockSubmissionKey: %w", err)
+	}
+	return nil
+}
+
+// DeleteDraftsBefore removes pending responses last updated before the given time
+// and returns how many were deleted. Their answers are removed by cascade.
+func (r *ResponseRepository) DeleteDraftsBefore(ctx context.Context, before time.Time) (int64, error) {
+	const query = `DELETE FROM responses WHERE status=$1 AND updated_at < $2`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	tag, err := r.base.exec.Exec(ctx, query, enums.ResponsePending, before)
+	if err != nil {
+		return 0, fmt.Errorf("DeleteDraftsBefore: %w", err)
+	}
+	return tag.RowsAffected(), nil
 }
 
 // Delete removes a response by ID
@@ -108,3 +489,11 @@ func (r *ResponseRepository) Delete(ctx context.Context, id uuid.UUID) error {
 func (r *ResponseRepository) Base() *BaseRepository {
 	return r.base
 }
+
+// nullIfEmpty maps an empty string to SQL NULL
+func nullIfEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,339 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/repository/interfaces"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// WebhookRepository implements Postgres storage of webhook subscriptions and deliveries
+type WebhookRepository struct {
+	base *BaseRepository
+}
+
+// NewWebhookRepository creates a new repository instance
+func NewWebhookRepository(base *BaseRepository) *WebhookRepository {
+	return &WebhookRepository{base: base}
+}
+
+// subscriptionColumns is the column list read by scanSubscription
+const subscriptionColumns = `id, form_id, url, secret, events, active, created_at, updated_at`
+
+// scanSubscription scans a row into WebhookSubscription
+func scanSubscription(row pgx.Row) (*entities.WebhookSubscription, error) {
+	var sub entities.WebhookSubscription
+	var events []string
+	if err := row.Scan(
+		&sub.ID, &sub.FormID, &sub.URL, &sub.Secret, &events, &sub.Active, &sub.CreatedAt, &sub.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	sub.Events = make([]enums.WebhookEvent, len(events))
+	for i, e := range events {
+		sub.Events[i] = enums.WebhookEvent(e)
+	}
+	return &sub, nil
+}
+
+// eventNames converts events to the TEXT[] stored in the events column
+func eventNames(events []enums.WebhookEvent) []string {
+	names := make([]string, len(events))
+	for i, e := range events {
+		names[i] = string(e)
+	}
+	return names
+}
+
+// CreateSubscription inserts a new subscription
+func (r *WebhookRepository) CreateSubscription(ctx context.Context, sub *entities.WebhookSubscription) error {
+	if sub.ID == uuid.Nil {
+		sub.ID = uuid.New()
+	}
+
+	const query = `
+		INSERT INTO webhook_subscriptions (id, form_id, url, secret, events, active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	if err := r.base.Exec(ctx, query,
+		sub.ID, sub.FormID, sub.URL, sub.Secret, eventNames(sub.Events), sub.Active, sub.CreatedAt, sub.UpdatedAt,
+	); err != nil {
+		return fmt.Errorf("WebhookRepository.CreateSubscription: %w", err)
+	}
+	return nil
+}
+
+// GetSubscription retrieves a subscription by ID
+func (r *WebhookRepository) GetSubscription(ctx context.Context, id uuid.UUID) (*entities.WebhookSubscription, error) {
+	const query = `SELECT ` + subscriptionColumns + ` FROM webhook_subscriptions WHERE id=$1`
+
+	sub, err := scanSubscription(r.base.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("WebhookRepository.GetSubscription: %w", err)
+	}
+	return sub, nil
+}
+
+// ListSubscriptions retrieves the subscriptions of a form
+func (r *WebhookRepository) ListSubscriptions(ctx context.Context, formID uuid.UUID) ([]*entities.WebhookSubscription, error) {
+	const query = `
+		SELECT ` + subscriptionColumns + `
+		FROM webhook_subscriptions
+		WHERE form_id=$1
+		ORDER BY created_at ASC, id ASC
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	rows, err := r.base.exec.Query(ctx, query, formID)
+	if err != nil {
+		return nil, fmt.Errorf("WebhookRepository.ListSubscriptions: %w", err)
+	}
+	defer rows.Close()
+
+	subs := []*entities.WebhookSubscription{}
+	for rows.Next() {
+		sub, err := scanSubscription(rows)
+		if err != nil {
+			return nil, fmt.Errorf("WebhookRepository.ListSubscriptions.Scan: %w", err)
+		}
+		subs = append(subs, sub)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("WebhookRepository.ListSubscriptions: %w", err)
+	}
+
+	return subs, nil
+}
+
+// UpdateSubscription modifies the URL, secret, events and active flag of a subscription
+func (r *WebhookRepository) UpdateSubscription(ctx context.Context, sub *entities.WebhookSubscription) error {
+	const query = `
+		UPDATE webhook_subscriptions
+		SET url=$1, secret=$2, events=$3, active=$4, updated_at=$5
+		WHERE id=$6
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	tag, err := r.base.exec.Exec(ctx, query, sub.URL, sub.Secret, eventNames(sub.Events), sub.Active, sub.UpdatedAt, sub.ID)
+	if err != nil {
+		return fmt.Errorf("WebhookRepository.UpdateSubscription: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("WebhookRepository.UpdateSubscription: %w", pgx.ErrNoRows)
+	}
+	return nil
+}
+
+// DeleteSubscription removes a subscription; its deliveries are removed by cascade
+func (r *WebhookRepository) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	const query = `DELETE FROM webhook_subscriptions WHERE id=$1`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	tag, err := r.base.exec.Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("WebhookRepository.DeleteSubscription: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("WebhookRepository.DeleteSubscription: %w", pgx.ErrNoRows)
+	}
+	return nil
+}
+
+// Enqueue fans an event out to the matching subscriptions in a single statement
+func (r *WebhookRepository) Enqueue(ctx context.Context, event *entities.WebhookEvent) (int, error) {
+	payload, err := event.Payload()
+	if err != nil {
+		return 0, fmt.Errorf("WebhookRepository.Enqueue: %w", err)
+	}
+
+	const query = `
+		INSERT INTO webhook_deliveries (id, subscription_id, event_id, event, payload, status, next_attempt_at, created_at)
+		SELECT gen_random_uuid(), s.id, $1::uuid, $2::text, $3::jsonb, $4::smallint, $5::timestamp, $5::timestamp
+		FROM webhook_subscriptions s
+		WHERE s.form_id = $6 AND s.active AND $2::text = ANY(s.events)
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	tag, err := r.base.exec.Exec(ctx, query,
+		event.ID, string(event.Type), payload, enums.WebhookDeliveryPending, event.CreatedAt, event.FormID,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("WebhookRepository.Enqueue: %w", err)
+	}
+	return int(tag.RowsAffected()), nil
+}
+
+// deliveryColumns is the column list read by scanDelivery
+const deliveryColumns = `d.id, d.subscription_id, d.event_id, d.event, d.payload, d.status, d.attempts,
+	d.next_attempt_at, d.last_status_code, d.last_error, d.created_at, d.delivered_at`
+
+// scanDelivery scans a row into WebhookDelivery, followed by extra destinations
+func scanDelivery(row pgx.Row, extra ...any) (*entities.WebhookDelivery, error) {
+	var d entities.WebhookDelivery
+	var lastError *string
+	dest := []any{
+		&d.ID, &d.SubscriptionID, &d.EventID, &d.Event, &d.Payload, &d.Status, &d.Attempts,
+		&d.NextAttemptAt, &d.LastStatusCode, &lastError, &d.CreatedAt, &d.DeliveredAt,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	if lastError != nil {
+		d.LastError = *lastError
+	}
+	return &d, nil
+}
+
+// ClaimDue leases due deliveries by pushing their next attempt past the lease.
+// SKIP LOCKED lets several API instances dispatch without claiming the same rows.
+func (r *WebhookRepository) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]*entities.WebhookDelivery, error) {
+	const query = `
+		WITH due AS (
+			SELECT id FROM webhook_deliveries
+			WHERE status = $1 AND next_attempt_at <= $2
+			ORDER BY next_attempt_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		), claimed AS (
+			UPDATE webhook_deliveries d
+			SET next_attempt_at = $4
+			FROM due
+			WHERE d.id = due.id
+			RETURNING d.*
+		)
+		SELECT ` + deliveryColumns + `, s.url, s.secret
+		FROM claimed d
+		JOIN webhook_subscriptions s ON s.id = d.subscription_id
+		ORDER BY d.created_at
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	rows, err := r.base.exec.Query(ctx, query, enums.WebhookDeliveryPending, now, limit, now.Add(lease))
+	if err != nil {
+		return nil, fmt.Errorf("WebhookRepository.ClaimDue: %w", err)
+	}
+	defer rows.Close()
+
+	var deliveries []*entities.WebhookDelivery
+	for rows.Next() {
+		var url, secret string
+		d, err := scanDelivery(rows, &url, &secret)
+		if err != nil {
+			return nil, fmt.Errorf("WebhookRepository.ClaimDue.Scan: %w", err)
+		}
+		d.URL, d.Secret = url, secret
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("WebhookRepository.ClaimDue: %w", err)
+	}
+
+	return deliveries, nil
+}
+
+// UpdateDelivery stores the status, attempt count and last outcome of a delivery
+func (r *WebhookRepository) UpdateDelivery(ctx context.Context, delivery *entities.WebhookDelivery) error {
+	const query = `
+		UPDATE webhook_deliveries
+		SET status=$1, attempts=$2, next_attempt_at=$3, last_status_code=$4, last_error=$5, delivered_at=$6
+		WHERE id=$7
+	`
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	tag, err := r.base.exec.Exec(ctx, query,
+		delivery.Status, delivery.Attempts, delivery.NextAttemptAt, delivery.LastStatusCode,
+		nullIfEmpty(delivery.LastError), delivery.DeliveredAt, delivery.ID,
+	)
+	if err != nil {
+		return fmt.Errorf("WebhookRepository.UpdateDelivery: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("WebhookRepository.UpdateDelivery: %w", pgx.ErrNoRows)
+	}
+	return nil
+}
+
+// GetDelivery retrieves a delivery by ID
+func (r *WebhookRepository) GetDelivery(ctx context.Context, id uuid.UUID) (*entities.WebhookDelivery, error) {
+	const query = `SELECT ` + deliveryColumns + ` FROM webhook_deliveries d WHERE d.id=$1`
+
+	d, err := scanDelivery(r.base.QueryRow(ctx, query, id))
+	if err != nil {
+		return nil, fmt.Errorf("WebhookRepository.GetDelivery: %w", err)
+	}
+	return d, nil
+}
+
+// ListDeliveries retrieves a page of a subscription's deliveries, newest first
+func (r *WebhookRepository) ListDeliveries(
+	ctx context.Context,
+	filter interfaces.WebhookDeliveryFilter,
+) ([]*entities.WebhookDelivery, int, error) {
+	filter.Pagination.Normalize()
+
+	where := " WHERE d.subscription_id=$1"
+	args := []any{filter.SubscriptionID}
+	argPos := 2
+
+	if filter.Status != nil {
+		where += fmt.Sprintf(" AND d.status=$%d", argPos)
+		args = append(args, *filter.Status)
+		argPos++
+	}
+
+	var total int
+	if err := r.base.QueryRow(ctx, "SELECT COUNT(*) FROM webhook_deliveries d"+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("WebhookRepository.ListDeliveries.Count: %w", err)
+	}
+
+	query := "SELECT " + deliveryColumns + " FROM webhook_deliveries d" + where +
+		fmt.Sprintf(" ORDER BY d.created_at DESC, d.id DESC LIMIT $%d OFFSET $%d", argPos, argPos+1)
+	args = append(args, filter.Pagination.Limit, filter.Pagination.Offset)
+
+	ctx, cancel := r.base.context(ctx)
+	defer cancel()
+
+	rows, err := r.base.exec.Query(ctx, query, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("WebhookRepository.ListDeliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]*entities.WebhookDelivery, 0, filter.Pagination.Limit)
+	for rows.Next() {
+		d, err := scanDelivery(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("WebhookRepository.ListDeliveries.Scan: %w", err)
+		}
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("WebhookRepository.ListDeliveries: %w", err)
+	}
+
+	return deliveries, total, nil
+}
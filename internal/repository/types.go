@@ -1,6 +1,14 @@
 package repository
 
-import "errors"
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
 
 // ---------- Errors ----------
 
@@ -15,25 +23,100 @@ var (
 
 // ---------- Pagination ----------
 
-// type Pagination struct {
-// 	Limit  int
-// 	Offset int
-// }
+const (
+	// DefaultPageLimit is used when no limit is requested
+	DefaultPageLimit = 20
+	// MaxPageLimit caps the page size
+	MaxPageLimit = 100
+)
+
+// Pagination holds limit/offset paging parameters
+type Pagination struct {
+	Limit  int
+	Offset int
+}
+
 // Normalize ensures safe defaults
-// func (p *Pagination) Normalize() {
-// 	if p.Limit <= 0 || p.Limit > 100 {
-// 		p.Limit = 20
-// 	}
-// 	if p.Offset < 0 {
-// 		p.Offset = 0
-// 	}
-// }
+func (p *Pagination) Normalize() {
+	if p.Limit <= 0 {
+		p.Limit = DefaultPageLimit
+	}
+	if p.Limit > MaxPageLimit {
+		p.Limit = MaxPageLimit
+	}
+	if p.Offset < 0 {
+		p.Offset = 0
+	}
+}
+
+// PageInfo describes the page returned by a paginated list
+type PageInfo struct {
+	Total  int `json:"total"`
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
 
 // ---------- List Options ----------
-// ListOptions provides common options for list queries
-// type ListOptions struct {
-// 	Pagination *Pagination
-// }
+
+// ListOptions provides common options for cursor-paginated list queries.
+// Results are ordered by creation time (then ID) in Sort order; Cursor is
+// the NextCursor of the previous page.
+type ListOptions struct {
+	Limit  int
+	Cursor string
+	Sort   SortOrder
+}
+
+// Normalize ensures safe defaults
+func (o *ListOptions) Normalize() {
+	if o.Limit <= 0 {
+		o.Limit = DefaultPageLimit
+	}
+	if o.Limit > MaxPageLimit {
+		o.Limit = MaxPageLimit
+	}
+	if o.Sort == "" {
+		o.Sort = SortDesc
+	}
+}
+
+// CursorPageInfo describes the page returned by a cursor-paginated list
+type CursorPageInfo struct {
+	Total      int    `json:"total"`
+	Limit      int    `json:"limit"`
+	HasMore    bool   `json:"has_more"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// ---------- Cursors ----------
+
+// ErrInvalidCursor is returned for malformed or tampered cursors
+var ErrInvalidCursor = errors.New("repository: invalid cursor")
+
+// Cursor is the position of the last row of a page (keyset pagination)
+type Cursor struct {
+	CreatedAt time.Time `json:"t"`
+	ID        uuid.UUID `json:"id"`
+}
+
+// Encode returns the opaque string form of the cursor
+func (c Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// DecodeCursor parses a cursor produced by Cursor.Encode
+func DecodeCursor(s string) (Cursor, error) {
+	var c Cursor
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return c, ErrInvalidCursor
+	}
+	if err := json.Unmarshal(b, &c); err != nil || c.ID == uuid.Nil || c.CreatedAt.IsZero() {
+		return c, ErrInvalidCursor
+	}
+	return c, nil
+}
 
 // ---------- Sorting ----------
 
@@ -43,3 +126,18 @@ const (
 	SortAsc  SortOrder = "ASC"
 	SortDesc SortOrder = "DESC"
 )
+
+// ParseSortOrder converts "asc"/"desc" (any case) to a SortOrder;
+// an empty string yields def
+func ParseSortOrder(s string, def SortOrder) (SortOrder, bool) {
+	switch SortOrder(strings.ToUpper(s)) {
+	case "":
+		return def, true
+	case SortAsc:
+		return SortAsc, true
+	case SortDesc:
+		return SortDesc, true
+	default:
+		return "", false
+	}
+}
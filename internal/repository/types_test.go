@@ -0,0 +1,46 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"Skillture_Form/internal/repository"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPagination_Normalize(t *testing.T) {
+	p := repository.Pagination{Limit: 0, Offset: -5}
+	p.Normalize()
+	require.Equal(t, repository.Pagination{Limit: repository.DefaultPageLimit, Offset: 0}, p)
+
+	p = repository.Pagination{Limit: 1000, Offset: 40}
+	p.Normalize()
+	require.Equal(t, repository.Pagination{Limit: repository.MaxPageLimit, Offset: 40}, p)
+}
+
+func TestParseSortOrder(t *testing.T) {
+	order, ok := repository.ParseSortOrder("", repository.SortDesc)
+	require.True(t, ok)
+	require.Equal(t, repository.SortDesc, order)
+
+	order, ok = repository.ParseSortOrder("asc", repository.SortDesc)
+	require.True(t, ok)
+	require.Equal(t, repository.SortAsc, order)
+
+	_, ok = repository.ParseSortOrder("sideways", repository.SortDesc)
+	require.False(t, ok)
+}
+
+func TestCursor_RoundTrip(t *testing.T) {
+	c := repository.Cursor{CreatedAt: time.Date(2026, 5, 1, 12, 30, 0, 123456000, time.UTC), ID: uuid.New()}
+
+	got, err := repository.DecodeCursor(c.Encode())
+	require.NoError(t, err)
+	require.True(t, c.CreatedAt.Equal(got.CreatedAt))
+	require.Equal(t, c.ID, got.ID)
+
+	_, err = repository.DecodeCursor("not-a-cursor")
+	require.ErrorIs(t, err, repository.ErrInvalidCursor)
+}
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	domainErr "Skillture_Form/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+)
+
+// writeAccessError answers authorization failures raised by the use cases
+// and reports whether err was one of them
+func writeAccessError(c *gin.Context, err error) bool {
+	switch {
+	case errors.Is(err, domainErr.ErrUnauthenticated):
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+	case errors.Is(err, domainErr.ErrForbidden):
+		c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+	default:
+		return false
+	}
+	return true
+}
@@ -1,30 +1,91 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
+	"time"
 
+	"Skillture_Form/internal/auth"
 	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
 	"Skillture_Form/internal/usecase/interfaces"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type AdminHandler struct {
-	adminUC interfaces.AdminUseCase
+	adminUC   interfaces.AdminUseCase
+	sessionUC interfaces.SessionUseCase
+	tokens    *auth.TokenManager
 }
 
-func NewAdminHandler(adminUC interfaces.AdminUseCase) *AdminHandler {
+func NewAdminHandler(adminUC interfaces.AdminUseCase, sessionUC interfaces.SessionUseCase, tokens *auth.TokenManager) *AdminHandler {
 	return &AdminHandler{
-		adminUC: adminUC,
+		adminUC:   adminUC,
+		sessionUC: sessionUC,
+		tokens:    tokens,
 	}
 }
 
-// Create handles admin creation
+// writeAdminError maps admin management errors to HTTP responses
+func writeAdminError(c *gin.Context, err error) {
+	if writeAccessError(c, err) || writeLockoutError(c, err) {
+		return
+	}
+	switch {
+	case errors.Is(err, pgx.ErrNoRows), errors.Is(err, domainErr.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "admin not found"})
+	case errors.Is(err, entities.ErrInvalidRole), errors.Is(err, entities.ErrWeakPassword),
+		errors.Is(err, entities.ErrWrongPassword), errors.Is(err, entities.ErrInvalidResetToken),
+		errors.Is(err, entities.ErrInvalidMFACode):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, entities.ErrMFAEnabled), errors.Is(err, entities.ErrMFANotEnabled),
+		errors.Is(err, entities.ErrMFANotEnrolled):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// writeLockoutError writes 429 with a Retry-After header for a LockoutError
+// and reports whether it did
+func writeLockoutError(c *gin.Context, err error) bool {
+	var lockout *entities.LockoutError
+	if !errors.As(err, &lockout) {
+		return false
+	}
+	retryAfter := int(lockout.RetryAfter(time.Now()).Seconds())
+	c.Header("Retry-After", strconv.Itoa(retryAfter))
+	c.JSON(http.StatusTooManyRequests, gin.H{"error": err.Error(), "retry_after": retryAfter})
+	return true
+}
+
+// writeLoginError maps errors of both login steps to HTTP responses
+func writeLoginError(c *gin.Context, err error) {
+	if writeLockoutError(c, err) {
+		return
+	}
+	switch {
+	case errors.Is(err, entities.ErrInvalidCredentials):
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid credentials"})
+	case errors.Is(err, entities.ErrInvalidMFACode):
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// Create handles admin creation.
+// Role is optional; without it the admin only sees forms granted to them.
 func (h *AdminHandler) Create(c *gin.Context) {
 	var req struct {
-		Username string `json:"username" binding:"required"`
-		Password string `json:"password" binding:"required,min=6"`
+		Username string          `json:"username" binding:"required"`
+		Password string          `json:"password" binding:"required"` // Checked against the password policy
+		Role     enums.AdminRole `json:"role"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -35,10 +96,11 @@ func (h *AdminHandler) Create(c *gin.Context) {
 	admin := &entities.Admin{
 		Username:       req.Username,
 		HashedPassword: req.Password, // UC will hash it
+		Role:           req.Role,
 	}
 
 	if err := h.adminUC.Create(c.Request.Context(), admin); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeAdminError(c, err)
 		return
 	}
 
@@ -49,6 +111,9 @@ func (h *AdminHandler) Create(c *gin.Context) {
 func (h *AdminHandler) List(c *gin.Context) {
 	admins, err := h.adminUC.List(c.Request.Context())
 	if err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -67,6 +132,9 @@ func (h *AdminHandler) GetByID(c *gin.Context) {
 
 	admin, err := h.adminUC.GetByID(c.Request.Context(), id)
 	if err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -88,14 +156,203 @@ func (h *AdminHandler) Delete(c *gin.Context) {
 	}
 
 	if err := h.adminUC.Delete(c.Request.Context(), id); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeAdminError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// SetRole handles changing the global role of an admin.
+// Body: {"role": "owner"|"editor"|"reviewer"|"viewer"|""}; the empty role
+// leaves the admin with per-form grants only.
+func (h *AdminHandler) SetRole(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid UUID format"})
+		return
+	}
+
+	var req struct {
+		Role *enums.AdminRole `json:"role" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	admin, err := h.adminUC.SetRole(c.Request.Context(), id, *req.Role)
+	if err != nil {
+		writeAdminError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, admin)
+}
+
+// Unlock handles lifting the login lockout of an admin's account
+func (h *AdminHandler) Unlock(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid UUID format"})
+		return
+	}
+
+	if err := h.adminUC.Unlock(c.Request.Context(), id); err != nil {
+		writeAdminError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// UnlockIP handles lifting the login lockout of a client IP
+func (h *AdminHandler) UnlockIP(c *gin.Context) {
+	if err := h.adminUC.UnlockIP(c.Request.Context(), c.Param("ip")); err != nil {
+		if errors.Is(err, domainErr.ErrInvalidInput) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		writeAdminError(c, err)
 		return
 	}
 
 	c.Status(http.StatusNoContent)
 }
 
-// LoginAdmin authenticates an admin and returns a simple response
+// ChangePassword handles changing the password of the authenticated admin
+func (h *AdminHandler) ChangePassword(c *gin.Context) {
+	var req struct {
+		CurrentPassword string `json:"current_password" binding:"required"`
+		NewPassword     string `json:"new_password" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.adminUC.ChangePassword(c.Request.Context(), req.CurrentPassword, req.NewPassword); err != nil {
+		writeAdminError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// IssuePasswordReset handles creating a one-time password reset token for
+// an admin. The token is only returned in this response.
+func (h *AdminHandler) IssuePasswordReset(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid UUID format"})
+		return
+	}
+
+	reset, err := h.adminUC.IssuePasswordReset(c.Request.Context(), id)
+	if err != nil {
+		writeAdminError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, reset)
+}
+
+// ResetPassword handles setting a new password with a reset token
+func (h *AdminHandler) ResetPassword(c *gin.Context) {
+	var req struct {
+		Token       string `json:"token" binding:"required"`
+		NewPassword string `json:"new_password" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.adminUC.ResetPassword(c.Request.Context(), req.Token, req.NewPassword); err != nil {
+		writeAdminError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// EnrollMFA handles starting TOTP enrollment for the authenticated admin.
+// The secret stays pending until confirmed with a code.
+func (h *AdminHandler) EnrollMFA(c *gin.Context) {
+	enrollment, err := h.adminUC.EnrollMFA(c.Request.Context())
+	if err != nil {
+		writeAdminError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, enrollment)
+}
+
+// ConfirmMFA handles enabling MFA with a code from the enrolled secret.
+// The recovery codes are only returned in this response.
+func (h *AdminHandler) ConfirmMFA(c *gin.Context) {
+	var req struct {
+		Code string `json:"code" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	codes, err := h.adminUC.ConfirmMFA(c.Request.Context(), req.Code)
+	if err != nil {
+		writeAdminError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"recovery_codes": codes})
+}
+
+// DisableOwnMFA handles turning off MFA for the authenticated admin.
+// Body: {"code": "<authenticator or recovery code>"}
+func (h *AdminHandler) DisableOwnMFA(c *gin.Context) {
+	adminID, ok := auth.AdminIDFromContext(c.Request.Context())
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": domainErr.ErrUnauthenticated.Error()})
+		return
+	}
+
+	var req struct {
+		Code string `json:"code"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.adminUC.DisableMFA(c.Request.Context(), adminID, req.Code, c.ClientIP()); err != nil {
+		writeAdminError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// DisableMFA handles an owner turning off MFA for another admin, e.g. after
+// a lost device
+func (h *AdminHandler) DisableMFA(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid UUID format"})
+		return
+	}
+
+	if err := h.adminUC.DisableMFA(c.Request.Context(), id, "", c.ClientIP()); err != nil {
+		writeAdminError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// LoginAdmin authenticates an admin and issues an access/refresh token pair.
+// Admins using MFA get a short-lived challenge instead, to exchange with a
+// code at LoginMFA.
 func (h *AdminHandler) LoginAdmin(c *gin.Context) {
 	var req struct {
 		Username string `json:"username"`
@@ -107,15 +364,161 @@ func (h *AdminHandler) LoginAdmin(c *gin.Context) {
 		return
 	}
 
-	admin, err := h.adminUC.Authenticate(c.Request.Context(), req.Username, req.Password)
+	admin, err := h.adminUC.Authenticate(c.Request.Context(), req.Username, req.Password, c.ClientIP())
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid credentials"})
+		writeLoginError(c, err)
+		return
+	}
+
+	if admin.MFAEnabled() {
+		challenge, err := h.tokens.IssueMFAChallenge(admin)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+		c.JSON(http.StatusOK, challenge)
+		return
+	}
+
+	tokens, err := h.sessionUC.Start(c.Request.Context(), admin, c.Request.UserAgent(), c.ClientIP())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"id":       admin.ID,
+		"username": admin.Username,
+		"tokens":   tokens,
+	})
+}
+
+// LoginMFA completes a login with the challenge from LoginAdmin and an
+// authenticator or recovery code, and issues an access/refresh token pair
+func (h *AdminHandler) LoginMFA(c *gin.Context) {
+	var req struct {
+		MFAToken string `json:"mfa_token" binding:"required"`
+		Code     string `json:"code" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	claims, err := h.tokens.ParseMFAChallenge(req.MFAToken)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		return
+	}
+	adminID, _ := claims.AdminID()
+
+	admin, err := h.adminUC.VerifyMFA(c.Request.Context(), adminID, req.Code, c.ClientIP())
+	if err != nil {
+		writeLoginError(c, err)
+		return
+	}
+
+	tokens, err := h.sessionUC.Start(c.Request.Context(), admin, c.Request.UserAgent(), c.ClientIP())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	// TODO: issue JWT token here
 	c.JSON(http.StatusOK, gin.H{
 		"id":       admin.ID,
 		"username": admin.Username,
+		"tokens":   tokens,
 	})
 }
+
+// Refresh exchanges a valid refresh token for a new token pair. The old
+// refresh token stops working; presenting it again revokes the session.
+func (h *AdminHandler) Refresh(c *gin.Context) {
+	var req struct {
+		RefreshToken string `json:"refresh_token" binding:"required"`
+	}
+
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	admin, tokens, err := h.sessionUC.Refresh(c.Request.Context(), req.RefreshToken, c.Request.UserAgent(), c.ClientIP())
+	if err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidToken), errors.Is(err, auth.ErrExpiredToken),
+			errors.Is(err, auth.ErrWrongTokenType), errors.Is(err, entities.ErrSessionRevoked),
+			errors.Is(err, entities.ErrRefreshTokenReused):
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"id":       admin.ID,
+		"username": admin.Username,
+		"tokens":   tokens,
+	})
+}
+
+// ListSessions handles listing the active sessions of an admin
+func (h *AdminHandler) ListSessions(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid UUID format"})
+		return
+	}
+
+	sessions, err := h.sessionUC.List(c.Request.Context(), id)
+	if err != nil {
+		writeAdminError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, sessions)
+}
+
+// RevokeSession handles ending one session of an admin
+func (h *AdminHandler) RevokeSession(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid UUID format"})
+		return
+	}
+	sessionID, err := uuid.Parse(c.Param("session_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid session ID"})
+		return
+	}
+
+	if err := h.sessionUC.Revoke(c.Request.Context(), id, sessionID); err != nil {
+		if errors.Is(err, domainErr.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "session not found"})
+			return
+		}
+		writeAdminError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// RevokeSessions handles ending every session of an admin
+func (h *AdminHandler) RevokeSessions(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid UUID format"})
+		return
+	}
+
+	count, err := h.sessionUC.RevokeAll(c.Request.Context(), id)
+	if err != nil {
+		writeAdminError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"revoked": count})
+}
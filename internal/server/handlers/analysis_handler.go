@@ -0,0 +1,148 @@
+package handlers
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	domainErr "Skillture_Form/internal/domain/errors"
+	"Skillture_Form/internal/usecase/interfaces"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type AnalysisHandler struct {
+	analysisUC interfaces.AnalysisUseCase
+}
+
+func NewAnalysisHandler(analysisUC interfaces.AnalysisUseCase) *AnalysisHandler {
+	return &AnalysisHandler{analysisUC: analysisUC}
+}
+
+// Themes clusters the free-text answers of a field.
+// Query: k (number of themes, default picked from the answer count),
+// top (representative answers per theme, default 3)
+func (h *AnalysisHandler) Themes(c *gin.Context) {
+	fieldID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid field_id"})
+		return
+	}
+
+	k, ok := queryInt(c, "k", 0, 1, interfaces.MaxThemes)
+	if !ok {
+		return
+	}
+	top, ok := queryInt(c, "top", interfaces.DefaultThemeRepresentatives, 1, interfaces.MaxThemeRepresentatives)
+	if !ok {
+		return
+	}
+
+	themes, err := h.analysisUC.FieldThemes(c.Request.Context(), fieldID, k, top)
+	if err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, domainErr.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "field not found"})
+		case errors.Is(err, domainErr.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, themes)
+}
+
+// Form aggregates a form's submitted responses per field.
+// Query: lang (labels, default en), buckets (number histogram buckets, default 10)
+func (h *AnalysisHandler) Form(c *gin.Context) {
+	formID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid form_id"})
+		return
+	}
+
+	buckets, ok := queryInt(c, "buckets", interfaces.DefaultHistogramBuckets, 1, interfaces.MaxHistogramBuckets)
+	if !ok {
+		return
+	}
+	lang := normalizeLanguage(c.DefaultQuery("lang", defaultLanguage))
+
+	analytics, err := h.analysisUC.FormAnalytics(c.Request.Context(), formID, lang, buckets)
+	if err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "form not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, analytics)
+}
+
+// Crosstab cross-tabulates two choice fields of a form.
+// Query: row and column (field IDs, required), lang (labels, default en)
+func (h *AnalysisHandler) Crosstab(c *gin.Context) {
+	formID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid form_id"})
+		return
+	}
+	rowFieldID, err := uuid.Parse(c.Query("row"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "row must be a field ID"})
+		return
+	}
+	columnFieldID, err := uuid.Parse(c.Query("column"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "column must be a field ID"})
+		return
+	}
+	lang := normalizeLanguage(c.DefaultQuery("lang", defaultLanguage))
+
+	crosstab, err := h.analysisUC.Crosstab(c.Request.Context(), formID, rowFieldID, columnFieldID, lang)
+	if err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			c.JSON(http.StatusNotFound, gin.H{"error": "form not found"})
+		case errors.Is(err, domainErr.ErrNotFound):
+			c.JSON(http.StatusNotFound, gin.H{"error": "field not found"})
+		case errors.Is(err, domainErr.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, crosstab)
+}
+
+// queryInt reads an optional integer query parameter within [lo, hi].
+// It writes a 400 response and returns false when the value is invalid.
+func queryInt(c *gin.Context, name string, def, lo, hi int) (int, bool) {
+	v := c.Query(name)
+	if v == "" {
+		return def, true
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < lo || n > hi {
+		c.JSON(http.StatusBadRequest, gin.H{"error": fmt.Sprintf("%s must be between %d and %d", name, lo, hi)})
+		return 0, false
+	}
+	return n, true
+}
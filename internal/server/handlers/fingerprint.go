@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/gin-gonic/gin"
+)
+
+// clientFingerprint identifies the submitting client for duplicate checks.
+// It is derived on the server only, from the client IP (see TRUSTED_PROXIES),
+// User-Agent and Accept-Language; a value the client could choose freely would
+// let it dodge the check. Only the hash is stored.
+func clientFingerprint(c *gin.Context) string {
+	source := c.ClientIP() + "|" + c.Request.UserAgent() + "|" + c.GetHeader("Accept-Language")
+
+	sum := sha256.Sum256([]byte(source))
+	return hex.EncodeToString(sum[:])
+}
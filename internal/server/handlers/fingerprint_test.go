@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func fingerprintOf(remoteAddr, userAgent string, headers ...string) string {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request = httptest.NewRequest("POST", "/api/v1/responses/", nil)
+	c.Request.RemoteAddr = remoteAddr
+	c.Request.Header.Set("User-Agent", userAgent)
+	for i := 0; i+1 < len(headers); i += 2 {
+		c.Request.Header.Set(headers[i], headers[i+1])
+	}
+	return clientFingerprint(c)
+}
+
+func TestClientFingerprint(t *testing.T) {
+	base := fingerprintOf("10.0.0.1:1234", "Firefox")
+	require.Len(t, base, 64)
+
+	// Same client, different source port
+	require.Equal(t, base, fingerprintOf("10.0.0.1:5678", "Firefox"))
+
+	// Different browser, address or language
+	require.NotEqual(t, base, fingerprintOf("10.0.0.1:1234", "Chrome"))
+	require.NotEqual(t, base, fingerprintOf("10.0.0.2:1234", "Firefox"))
+	require.NotEqual(t, base, fingerprintOf("10.0.0.1:1234", "Firefox", "Accept-Language", "ar"))
+
+	// Client-chosen headers do not change it
+	require.Equal(t, base, fingerprintOf("10.0.0.1:1234", "Firefox", "X-Client-Fingerprint", "random-1"))
+}
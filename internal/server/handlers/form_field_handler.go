@@ -7,6 +7,7 @@ import (
 
 	"Skillture_Form/internal/domain/entities"
 	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
 	"Skillture_Form/internal/usecase/interfaces"
 	"Skillture_Form/internal/validation"
 
@@ -48,17 +49,31 @@ func parseFieldType(s string) enums.FieldType {
 	}
 }
 
+// isFieldValidationError reports whether err comes from field domain validation
+func isFieldValidationError(err error) bool {
+	return errors.Is(err, validation.ErrInvalidFieldType) ||
+		errors.Is(err, validation.ErrMissingOptions) ||
+		errors.Is(err, validation.ErrInvalidFieldOrder) ||
+		errors.Is(err, validation.ErrInvalidValidationRules) ||
+		errors.Is(err, validation.ErrInvalidShowIf) ||
+		errors.Is(err, validation.ErrConditionCycle) ||
+		errors.Is(err, validation.ErrSectionNotInForm)
+}
+
 // Create handles adding a field to a form
 func (h *FormFieldHandler) Create(c *gin.Context) {
 	var req struct {
-		FormID      string            `json:"form_id" binding:"required"`
-		Label       map[string]string `json:"label" binding:"required"`
-		Type        string            `json:"type" binding:"required"`
-		FieldOrder  int               `json:"field_order" binding:"required"`
-		Required    bool              `json:"required"`
-		Placeholder map[string]string `json:"placeholder"`
-		HelpText    map[string]string `json:"help_text"`
-		Options     map[string]any    `json:"options"`
+		FormID      string               `json:"form_id" binding:"required"`
+		Label       map[string]string    `json:"label" binding:"required"`
+		Type        string               `json:"type" binding:"required"`
+		FieldOrder  int                  `json:"field_order" binding:"required"`
+		Required    bool                 `json:"required"`
+		Placeholder map[string]string    `json:"placeholder"`
+		HelpText    map[string]string    `json:"help_text"`
+		Options     map[string]any       `json:"options"`
+		Rules       *entities.FieldRules `json:"validation_rules"`
+		ShowIf      *entities.ShowIf     `json:"show_if"`
+		SectionID   *uuid.UUID           `json:"section_id"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -80,6 +95,7 @@ func (h *FormFieldHandler) Create(c *gin.Context) {
 
 	field := &entities.FormField{
 		FormID:      formID,
+		SectionID:   req.SectionID,
 		Label:       req.Label,
 		Type:        fieldType,
 		FieldOrder:  req.FieldOrder,
@@ -87,17 +103,20 @@ func (h *FormFieldHandler) Create(c *gin.Context) {
 		Placeholder: req.Placeholder,
 		HelpText:    req.HelpText,
 		Options:     req.Options,
+		Rules:       req.Rules,
+		ShowIf:      req.ShowIf,
 	}
 
 	if err := h.fieldUC.Create(c.Request.Context(), field); err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
 		if errors.Is(err, pgx.ErrNoRows) {
 			c.JSON(http.StatusNotFound, gin.H{"error": "form not found"})
 			return
 		}
 
-		if errors.Is(err, validation.ErrInvalidFieldType) ||
-			errors.Is(err, validation.ErrMissingOptions) ||
-			errors.Is(err, validation.ErrInvalidFieldOrder) {
+		if isFieldValidationError(err) {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
 			return
 		}
@@ -122,13 +141,16 @@ func (h *FormFieldHandler) Update(c *gin.Context) {
 	}
 
 	var req struct {
-		Label       map[string]string `json:"label"`
-		Type        string            `json:"type"`
-		FieldOrder  int               `json:"field_order"`
-		Required    bool              `json:"required"`
-		Placeholder map[string]string `json:"placeholder"`
-		HelpText    map[string]string `json:"help_text"`
-		Options     map[string]any    `json:"options"`
+		Label       map[string]string    `json:"label"`
+		Type        string               `json:"type"`
+		FieldOrder  int                  `json:"field_order"`
+		Required    bool                 `json:"required"`
+		Placeholder map[string]string    `json:"placeholder"`
+		HelpText    map[string]string    `json:"help_text"`
+		Options     map[string]any       `json:"options"`
+		Rules       *entities.FieldRules `json:"validation_rules"`
+		ShowIf      *entities.ShowIf     `json:"show_if"`
+		SectionID   *uuid.UUID           `json:"section_id"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -154,6 +176,7 @@ func (h *FormFieldHandler) Update(c *gin.Context) {
 
 	field := &entities.FormField{
 		ID:          id,
+		SectionID:   req.SectionID,
 		Label:       req.Label,
 		Type:        fieldType,
 		FieldOrder:  req.FieldOrder,
@@ -161,9 +184,22 @@ func (h *FormFieldHandler) Update(c *gin.Context) {
 		Placeholder: req.Placeholder,
 		HelpText:    req.HelpText,
 		Options:     req.Options,
+		Rules:       req.Rules,
+		ShowIf:      req.ShowIf,
 	}
 
 	if err := h.fieldUC.Update(c.Request.Context(), field); err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
+		if errors.Is(err, domainErr.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "field not found"})
+			return
+		}
+		if isFieldValidationError(err) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -181,6 +217,17 @@ func (h *FormFieldHandler) Delete(c *gin.Context) {
 	}
 
 	if err := h.fieldUC.Delete(c.Request.Context(), id); err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
+		if errors.Is(err, domainErr.ErrNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "field not found"})
+			return
+		}
+		if errors.Is(err, validation.ErrFieldHasDependents) {
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -199,6 +246,30 @@ func (h *FormFieldHandler) ListByFormID(c *gin.Context) {
 
 	fields, err := h.fieldUC.ListByFormID(c.Request.Context(), formID)
 	if err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, fields)
+}
+
+// ListPublic handles listing fields of a form for respondents
+func (h *FormFieldHandler) ListPublic(c *gin.Context) {
+	formID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid form_id"})
+		return
+	}
+
+	fields, err := h.fieldUC.ListPublic(c.Request.Context(), formID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, domainErr.ErrFormNotPublished) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "form not found"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
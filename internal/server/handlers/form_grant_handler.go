@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// writeGrantError maps grant use case errors to HTTP responses
+func writeGrantError(c *gin.Context, err error) {
+	if writeAccessError(c, err) {
+		return
+	}
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		c.JSON(http.StatusNotFound, gin.H{"error": "form or grant not found"})
+	case errors.Is(err, domainErr.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "admin not found"})
+	case errors.Is(err, entities.ErrInvalidRole):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// parseGrantParams reads the form and admin IDs of a grant route
+func parseGrantParams(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	formID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid form_id"})
+		return uuid.Nil, uuid.Nil, false
+	}
+	adminID, err := uuid.Parse(c.Param("admin_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid admin_id"})
+		return uuid.Nil, uuid.Nil, false
+	}
+	return formID, adminID, true
+}
+
+// ListGrants handles listing the per-form roles of a form
+func (h *FormHandler) ListGrants(c *gin.Context) {
+	formID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid form_id"})
+		return
+	}
+
+	grants, err := h.formUC.ListGrants(c.Request.Context(), formID)
+	if err != nil {
+		writeGrantError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, grants)
+}
+
+// Grant handles giving an admin a role on a form.
+// Body: {"role": "owner"|"editor"|"reviewer"|"viewer"}
+func (h *FormHandler) Grant(c *gin.Context) {
+	formID, adminID, ok := parseGrantParams(c)
+	if !ok {
+		return
+	}
+
+	var req struct {
+		Role enums.AdminRole `json:"role" binding:"required"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	grant, err := h.formUC.Grant(c.Request.Context(), formID, adminID, req.Role)
+	if err != nil {
+		writeGrantError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, grant)
+}
+
+// Revoke handles removing an admin's role on a form
+func (h *FormHandler) Revoke(c *gin.Context) {
+	formID, adminID, ok := parseGrantParams(c)
+	if !ok {
+		return
+	}
+
+	if err := h.formUC.Revoke(c.Request.Context(), formID, adminID); err != nil {
+		writeGrantError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
@@ -1,13 +1,20 @@
 package handlers
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	"Skillture_Form/internal/repository"
 	"Skillture_Form/internal/usecase/interfaces"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type FormHandler struct {
@@ -18,12 +25,32 @@ func NewFormHandler(formUC interfaces.FormUseCase) *FormHandler {
 	return &FormHandler{formUC: formUC}
 }
 
+// formRequest is the body of form create and update requests.
+// Title and description take translations or a plain (English) string.
+type formRequest struct {
+	Title       localizedText `json:"title"`
+	Description localizedText `json:"description"`
+}
+
+// writeFormError maps form use case errors to HTTP responses
+func writeFormError(c *gin.Context, err error) {
+	if writeAccessError(c, err) {
+		return
+	}
+	switch {
+	case errors.Is(err, pgx.ErrNoRows), errors.Is(err, domainErr.ErrFormNotPublished):
+		c.JSON(http.StatusNotFound, gin.H{"error": "form not found"})
+	case errors.Is(err, entities.ErrMissingFormTitle), errors.Is(err, entities.ErrInvalidDuplicatePolicy),
+		errors.Is(err, entities.ErrInvalidNotificationSettings):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
 // Create handles form creation
 func (h *FormHandler) Create(c *gin.Context) {
-	var req struct {
-		Title       string `json:"title" binding:"required"`
-		Description string `json:"description"`
-	}
+	var req formRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -31,30 +58,77 @@ func (h *FormHandler) Create(c *gin.Context) {
 	}
 
 	form := &entities.Form{
-		Title:       req.Title,
-		Description: req.Description,
+		Title:       req.Title.Values,
+		Description: req.Description.Values,
 	}
 
 	if err := h.formUC.Create(c.Request.Context(), form); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeFormError(c, err)
 		return
 	}
 
 	c.JSON(http.StatusCreated, form)
 }
 
-// List handles listing forms
+// List handles listing forms.
+// Query: status, q (title search), limit, cursor, sort (asc|desc)
 func (h *FormHandler) List(c *gin.Context) {
-	// Simple filter extraction (can be enhanced)
-	filter := interfaces.FormFilter{}
+	filter, err := parseFormFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 
-	forms, err := h.formUC.List(c.Request.Context(), filter)
+	page, err := h.formUC.List(c.Request.Context(), filter)
 	if err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid cursor"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, forms)
+	c.JSON(http.StatusOK, page)
+}
+
+// parseFormFilter reads form listing query parameters
+func parseFormFilter(c *gin.Context) (interfaces.FormFilter, error) {
+	var filter interfaces.FormFilter
+
+	if v := c.Query("status"); v != "" {
+		status, ok := enums.ParseFormStatus(v)
+		if !ok {
+			return filter, errors.New("status must be draft, published or closed")
+		}
+		s := int16(status)
+		filter.Status = &s
+	}
+
+	if q := strings.TrimSpace(c.Query("q")); q != "" {
+		filter.Title = &q
+	}
+
+	if v := c.Query("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return filter, errors.New("limit must be a positive integer")
+		}
+		filter.Limit = n
+	}
+
+	filter.Cursor = c.Query("cursor")
+
+	sort, ok := repository.ParseSortOrder(c.Query("sort"), repository.SortDesc)
+	if !ok {
+		return filter, errors.New("sort must be asc or desc")
+	}
+	filter.Sort = sort
+
+	return filter, nil
 }
 
 // GetByID handles getting a form by ID
@@ -68,6 +142,9 @@ func (h *FormHandler) GetByID(c *gin.Context) {
 
 	form, err := h.formUC.GetByID(c.Request.Context(), id)
 	if err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -75,6 +152,23 @@ func (h *FormHandler) GetByID(c *gin.Context) {
 	c.JSON(http.StatusOK, form)
 }
 
+// GetPublic handles getting a form for respondents
+func (h *FormHandler) GetPublic(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid UUID format"})
+		return
+	}
+
+	form, err := h.formUC.GetPublic(c.Request.Context(), id)
+	if err != nil {
+		writeFormError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, form)
+}
+
 // Update handles updating a form
 func (h *FormHandler) Update(c *gin.Context) {
 	idStr := c.Param("id")
@@ -84,10 +178,7 @@ func (h *FormHandler) Update(c *gin.Context) {
 		return
 	}
 
-	var req struct {
-		Title       string `json:"title" binding:"required"`
-		Description string `json:"description"`
-	}
+	var req formRequest
 
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -96,12 +187,71 @@ func (h *FormHandler) Update(c *gin.Context) {
 
 	form := &entities.Form{
 		ID:          id,
-		Title:       req.Title,
-		Description: req.Description,
+		Title:       req.Title.Values,
+		Description: req.Description.Values,
+	}
+
+	// Plain strings from older clients only replace the English text
+	if req.Title.Plain || req.Description.Plain {
+		existing, err := h.formUC.GetByID(c.Request.Context(), id)
+		if err != nil {
+			writeFormError(c, err)
+			return
+		}
+		form.Title = req.Title.mergeInto(existing.Title)
+		form.Description = req.Description.mergeInto(existing.Description)
 	}
 
 	if err := h.formUC.Update(c.Request.Context(), form); err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeFormError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, form)
+}
+
+// SetDuplicatePolicy handles replacing a form's duplicate policy.
+// A null body or a policy with every rule off allows duplicates again.
+func (h *FormHandler) SetDuplicatePolicy(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid UUID format"})
+		return
+	}
+
+	var policy *entities.DuplicatePolicy
+	if err := c.ShouldBindJSON(&policy); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	form, err := h.formUC.SetDuplicatePolicy(c.Request.Context(), id, policy)
+	if err != nil {
+		writeFormError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, form)
+}
+
+// SetNotifications handles replacing a form's submission email settings.
+// A null body or settings without recipients turns the emails off.
+func (h *FormHandler) SetNotifications(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid UUID format"})
+		return
+	}
+
+	var settings *entities.NotificationSettings
+	if err := c.ShouldBindJSON(&settings); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	form, err := h.formUC.SetNotificationSettings(c.Request.Context(), id, settings)
+	if err != nil {
+		writeFormError(c, err)
 		return
 	}
 
@@ -118,6 +268,9 @@ func (h *FormHandler) Publish(c *gin.Context) {
 	}
 
 	if err := h.formUC.Publish(c.Request.Context(), id); err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()}) // Bad Request if status transition invalid
 		return
 	}
@@ -135,6 +288,9 @@ func (h *FormHandler) Close(c *gin.Context) {
 	}
 
 	if err := h.formUC.Close(c.Request.Context(), id); err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -152,6 +308,9 @@ func (h *FormHandler) Delete(c *gin.Context) {
 	}
 
 	if err := h.formUC.Delete(c.Request.Context(), id); err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
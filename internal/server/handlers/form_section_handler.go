@@ -0,0 +1,225 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"Skillture_Form/internal/domain/entities"
+	domainErr "Skillture_Form/internal/domain/errors"
+	"Skillture_Form/internal/usecase/interfaces"
+	"Skillture_Form/internal/validation"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type FormSectionHandler struct {
+	sectionUC interfaces.FormSectionUseCase
+}
+
+func NewFormSectionHandler(sectionUC interfaces.FormSectionUseCase) *FormSectionHandler {
+	return &FormSectionHandler{
+		sectionUC: sectionUC,
+	}
+}
+
+// sectionRequest is the payload for creating or updating a section
+type sectionRequest struct {
+	Title        map[string]string `json:"title" binding:"required"`
+	Description  map[string]string `json:"description"`
+	SectionOrder int               `json:"section_order" binding:"required"`
+}
+
+// Create handles adding a section to a form
+func (h *FormSectionHandler) Create(c *gin.Context) {
+	formID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid form_id"})
+		return
+	}
+
+	var req sectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	section := &entities.FormSection{
+		FormID:       formID,
+		Title:        req.Title,
+		Description:  req.Description,
+		SectionOrder: req.SectionOrder,
+	}
+
+	if err := h.sectionUC.Create(c.Request.Context(), section); err != nil {
+		h.writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, section)
+}
+
+// List handles listing the sections of a form
+func (h *FormSectionHandler) List(c *gin.Context) {
+	formID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid form_id"})
+		return
+	}
+
+	sections, err := h.sectionUC.ListByFormID(c.Request.Context(), formID)
+	if err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, sections)
+}
+
+// Update handles updating a section
+func (h *FormSectionHandler) Update(c *gin.Context) {
+	formID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid form_id"})
+		return
+	}
+	sectionID, err := uuid.Parse(c.Param("section_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid section_id"})
+		return
+	}
+
+	var req sectionRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	section := &entities.FormSection{
+		ID:           sectionID,
+		FormID:       formID,
+		Title:        req.Title,
+		Description:  req.Description,
+		SectionOrder: req.SectionOrder,
+	}
+
+	if err := h.sectionUC.Update(c.Request.Context(), section); err != nil {
+		h.writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, section)
+}
+
+// Delete handles removing a section
+func (h *FormSectionHandler) Delete(c *gin.Context) {
+	formID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid form_id"})
+		return
+	}
+	sectionID, err := uuid.Parse(c.Param("section_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid section_id"})
+		return
+	}
+
+	if err := h.sectionUC.Delete(c.Request.Context(), formID, sectionID); err != nil {
+		h.writeError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Definition handles the public form definition with nested sections and fields
+func (h *FormSectionHandler) Definition(c *gin.Context) {
+	formID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid form_id"})
+		return
+	}
+
+	def, err := h.sectionUC.GetDefinition(c.Request.Context(), formID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, domainErr.ErrFormNotPublished) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "form not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, def)
+}
+
+// Render handles the public form rendered in a single language.
+// The language comes from ?lang= or the Accept-Language header.
+func (h *FormSectionHandler) Render(c *gin.Context) {
+	formID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid form_id"})
+		return
+	}
+
+	def, err := h.sectionUC.GetDefinition(c.Request.Context(), formID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) || errors.Is(err, domainErr.ErrFormNotPublished) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "form not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	lang := negotiateLanguage(c.Query("lang"), c.GetHeader("Accept-Language"), def.SupportedLanguages())
+
+	c.Header("Content-Language", lang)
+	c.Header("Vary", "Accept-Language")
+	c.JSON(http.StatusOK, def.Localize(lang))
+}
+
+// Translations handles the translation completeness check of a form.
+// Query: lang (required)
+func (h *FormSectionHandler) Translations(c *gin.Context) {
+	formID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid form_id"})
+		return
+	}
+
+	lang := normalizeLanguage(c.Query("lang"))
+	if lang == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "lang is required"})
+		return
+	}
+
+	report, err := h.sectionUC.TranslationReport(c.Request.Context(), formID, lang)
+	if err != nil {
+		h.writeError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// writeError maps section use case errors to HTTP responses
+func (h *FormSectionHandler) writeError(c *gin.Context, err error) {
+	if writeAccessError(c, err) {
+		return
+	}
+	switch {
+	case errors.Is(err, pgx.ErrNoRows), errors.Is(err, domainErr.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "section or form not found"})
+	case errors.Is(err, validation.ErrInvalidSectionOrder),
+		errors.Is(err, entities.ErrMissingSectionTitle),
+		errors.Is(err, entities.ErrMissingFormID):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
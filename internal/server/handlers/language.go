@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// defaultLanguage is used for translations sent as a plain string and when
+// no requested language is available
+const defaultLanguage = "en"
+
+// negotiateLanguage picks the language to render a form in.
+// An explicit ?lang= wins even if the form is not fully translated to it
+// (missing texts fall back to English); a regional tag the form does not
+// support is reduced to its primary subtag ("ar-IQ" renders as "ar").
+// Otherwise the Accept-Language preferences are matched against the fully
+// supported languages, first by full tag and then by primary subtag.
+func negotiateLanguage(query, acceptLanguage string, supported []string) string {
+	available := make(map[string]bool, len(supported))
+	for _, lang := range supported {
+		available[lang] = true
+	}
+
+	if lang := normalizeLanguage(query); lang != "" {
+		if primary, _, ok := strings.Cut(lang, "-"); ok && !available[lang] {
+			return primary
+		}
+		return lang
+	}
+
+	for _, tag := range parseAcceptLanguage(acceptLanguage) {
+		if available[tag] {
+			return tag
+		}
+		if primary, _, ok := strings.Cut(tag, "-"); ok && available[primary] {
+			return primary
+		}
+	}
+
+	if available[defaultLanguage] || len(supported) == 0 {
+		return defaultLanguage
+	}
+	return supported[0]
+}
+
+// parseAcceptLanguage returns the language tags of an Accept-Language header
+// ordered by quality; wildcards and tags with q=0 are dropped
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+
+	var prefs []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = normalizeLanguage(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		if q <= 0 {
+			continue
+		}
+		prefs = append(prefs, weighted{tag: tag, q: q})
+	}
+
+	sort.SliceStable(prefs, func(i, j int) bool { return prefs[i].q > prefs[j].q })
+
+	tags := make([]string, len(prefs))
+	for i, p := range prefs {
+		tags[i] = p.tag
+	}
+	return tags
+}
+
+// normalizeLanguage lowercases a language tag and uses "-" as separator
+func normalizeLanguage(tag string) string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimSpace(tag)), "_", "-")
+}
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNegotiateLanguage(t *testing.T) {
+	supported := []string{"ar", "en"}
+
+	tests := []struct {
+		name   string
+		query  string
+		header string
+		want   string
+	}{
+		{"query wins", "FR", "ar", "fr"},
+		{"query primary subtag", "ar-IQ", "en", "ar"},
+		{"unsupported query region", "fr_CA", "ar", "fr"},
+		{"exact tag", "", "ar,en;q=0.8", "ar"},
+		{"quality order", "", "en;q=0.5, ar;q=0.9", "ar"},
+		{"primary subtag", "", "ar-IQ", "ar"},
+		{"unsupported falls back", "", "de-DE, fr;q=0.9", "en"},
+		{"q=0 excluded", "", "ar;q=0, en;q=0.1", "en"},
+		{"no header", "", "", "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, negotiateLanguage(tt.query, tt.header, supported))
+		})
+	}
+
+	// Forms without English use their first supported language
+	require.Equal(t, "ar", negotiateLanguage("", "de", []string{"ar", "ku"}))
+}
@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+)
+
+var errInvalidLocalizedText = errors.New(`must be a string or an object of translations like {"en": "...", "ar": "..."}`)
+
+// localizedText is a multilingual request value.
+// It accepts {"en": "Survey", "ar": "استبيان"} as well as a plain string,
+// which older clients send and which is stored as the English translation.
+type localizedText struct {
+	Values map[string]string
+	Plain  bool // sent as a plain string
+}
+
+func (t *localizedText) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		*t = localizedText{}
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return errInvalidLocalizedText
+		}
+		// Earlier docs showed translations JSON-encoded inside a string
+		if strings.HasPrefix(strings.TrimSpace(s), "{") {
+			if err := t.UnmarshalJSON([]byte(s)); err == nil {
+				return nil
+			}
+		}
+		*t = localizedText{Plain: true}
+		if s = strings.TrimSpace(s); s != "" {
+			t.Values = map[string]string{defaultLanguage: s}
+		}
+		return nil
+	}
+
+	var values map[string]string
+	if err := json.Unmarshal(data, &values); err != nil {
+		return errInvalidLocalizedText
+	}
+	*t = localizedText{Values: make(map[string]string, len(values))}
+	for lang, v := range values {
+		lang = strings.ToLower(strings.TrimSpace(lang))
+		if v = strings.TrimSpace(v); lang != "" && v != "" {
+			t.Values[lang] = v
+		}
+	}
+	return nil
+}
+
+// mergeInto returns the translations to store over existing ones.
+// A plain string only replaces the default language so other translations
+// survive updates from older clients; an object replaces all translations.
+func (t localizedText) mergeInto(existing map[string]string) map[string]string {
+	if !t.Plain {
+		return t.Values
+	}
+	merged := make(map[string]string, len(existing)+1)
+	for lang, v := range existing {
+		merged[lang] = v
+	}
+	delete(merged, defaultLanguage)
+	for lang, v := range t.Values {
+		merged[lang] = v
+	}
+	return merged
+}
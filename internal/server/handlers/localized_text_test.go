@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalizedText_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		body  string
+		want  map[string]string
+		plain bool
+	}{
+		{"translations", `{"en": "Survey", "ar": "استبيان"}`, map[string]string{"en": "Survey", "ar": "استبيان"}, false},
+		{"plain string", `"Survey"`, map[string]string{"en": "Survey"}, true},
+		{"encoded translations", `"{\"ar\": \"استبيان\"}"`, map[string]string{"ar": "استبيان"}, false},
+		{"blank values dropped", `{"en": " ", "AR": "استبيان"}`, map[string]string{"ar": "استبيان"}, false},
+		{"empty string", `""`, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var text localizedText
+			require.NoError(t, json.Unmarshal([]byte(tt.body), &text))
+			require.Equal(t, tt.want, text.Values)
+			require.Equal(t, tt.plain, text.Plain)
+		})
+	}
+
+	var text localizedText
+	require.Error(t, json.Unmarshal([]byte(`42`), &text))
+}
+
+func TestLocalizedText_MergeInto(t *testing.T) {
+	existing := map[string]string{"en": "Survey", "ar": "استبيان"}
+
+	plain := localizedText{Values: map[string]string{"en": "Poll"}, Plain: true}
+	require.Equal(t, map[string]string{"en": "Poll", "ar": "استبيان"}, plain.mergeInto(existing))
+
+	full := localizedText{Values: map[string]string{"fr": "Sondage"}}
+	require.Equal(t, map[string]string{"fr": "Sondage"}, full.mergeInto(existing))
+
+	// The existing map is left untouched
+	require.Equal(t, "Survey", existing["en"])
+}
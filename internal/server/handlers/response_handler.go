@@ -2,15 +2,25 @@ package handlers
 
 import (
 	"errors"
+	"fmt"
+	"log"
 	"net/http"
+	"strconv"
+	"strings"
+	"time"
 
 	"Skillture_Form/internal/domain/entities"
 	"Skillture_Form/internal/domain/enums"
 	domainErr "Skillture_Form/internal/domain/errors"
+	"Skillture_Form/internal/export"
+	"Skillture_Form/internal/repository"
+	repo "Skillture_Form/internal/repository/interfaces"
 	"Skillture_Form/internal/usecase/interfaces"
+	"Skillture_Form/internal/validation"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type ResponseHandler struct {
@@ -23,16 +33,76 @@ func NewResponseHandler(responseUC interfaces.ResponseUseCase) *ResponseHandler
 	}
 }
 
+// answerRequest is a single answer in a submission or draft payload
+type answerRequest struct {
+	FieldID   string          `json:"field_id" binding:"required"`
+	FieldType enums.FieldType `json:"field_type" binding:"required"`
+	Value     map[string]any  `json:"value" binding:"required"`
+}
+
+// parseAnswers converts answer payloads to entities
+func parseAnswers(req []answerRequest) ([]*entities.ResponseAnswer, error) {
+	answers := make([]*entities.ResponseAnswer, 0, len(req))
+	for _, a := range req {
+		fieldID, err := uuid.Parse(a.FieldID)
+		if err != nil {
+			return nil, errors.New("invalid answer field_id")
+		}
+		answers = append(answers, &entities.ResponseAnswer{
+			FieldID:   fieldID,
+			FieldType: a.FieldType,
+			Value:     a.Value,
+		})
+	}
+	return answers, nil
+}
+
+// writeSubmitError maps submission and draft errors to HTTP responses.
+// notFound names what a missing row is: the form of a submission or the
+// draft of a resume token.
+func writeSubmitError(c *gin.Context, err error, notFound string) {
+	// Return 422 with per-field details when answers don't match the form
+	var answerErrs validation.AnswerErrors
+	if errors.As(err, &answerErrs) {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"error":  domainErr.ErrInvalidAnswers.Error(),
+			"fields": answerErrs,
+		})
+		return
+	}
+
+	// Unknown forms, or unknown or already submitted drafts
+	if errors.Is(err, pgx.ErrNoRows) {
+		c.JSON(http.StatusNotFound, gin.H{"error": notFound})
+		return
+	}
+
+	// Hard duplicate rules of the form
+	if errors.Is(err, domainErr.ErrDuplicateResponse) {
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Return 400 for known domain/business errors, 500 for unexpected errors
+	if errors.Is(err, domainErr.ErrFormNotPublished) ||
+		errors.Is(err, domainErr.ErrFormClosed) ||
+		errors.Is(err, domainErr.ErrMissingRequiredField) ||
+		errors.Is(err, domainErr.ErrInvalidInput) ||
+		errors.Is(err, domainErr.ErrNotFound) ||
+		errors.Is(err, entities.ErrMissingFormID) ||
+		errors.Is(err, entities.ErrMissingRespondent) {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+}
+
 // Submit handles form submission
 func (h *ResponseHandler) Submit(c *gin.Context) {
 	var req struct {
-		FormID     string         `json:"form_id" binding:"required"`
-		Respondent map[string]any `json:"respondent"`
-		Answers    []struct {
-			FieldID   string          `json:"field_id" binding:"required"`
-			FieldType enums.FieldType `json:"field_type" binding:"required"`
-			Value     map[string]any  `json:"value" binding:"required"`
-		} `json:"answers" binding:"required"`
+		FormID     string          `json:"form_id" binding:"required"`
+		Respondent map[string]any  `json:"respondent"`
+		Answers    []answerRequest `json:"answers" binding:"required"`
 	}
 
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -47,44 +117,102 @@ func (h *ResponseHandler) Submit(c *gin.Context) {
 	}
 
 	response := &entities.Response{
-		FormID:     formID,
-		Respondent: req.Respondent,
+		FormID:      formID,
+		Respondent:  req.Respondent,
+		Fingerprint: clientFingerprint(c),
 	}
 
-	var answers []*entities.ResponseAnswer
-	for _, a := range req.Answers {
-		fieldID, err := uuid.Parse(a.FieldID)
+	answers, err := parseAnswers(req.Answers)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Vectors are created by the embedding worker after the submission commits
+	if err := h.responseUC.Submit(c.Request.Context(), response, answers, nil); err != nil {
+		writeSubmitError(c, err, "form not found")
+		return
+	}
+
+	c.JSON(http.StatusCreated, response)
+}
+
+// draftRequest is the payload for saving a draft
+type draftRequest struct {
+	FormID     string          `json:"form_id"`
+	Respondent map[string]any  `json:"respondent"`
+	Answers    []answerRequest `json:"answers"`
+}
+
+// SaveDraft handles creating a new draft (POST) or updating one by token (PUT)
+func (h *ResponseHandler) SaveDraft(c *gin.Context) {
+	token := c.Param("token")
+
+	var req draftRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	response := &entities.Response{Respondent: req.Respondent, Fingerprint: clientFingerprint(c)}
+	if req.FormID != "" {
+		formID, err := uuid.Parse(req.FormID)
 		if err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid answer field_id"})
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid form_id"})
 			return
 		}
-		answers = append(answers, &entities.ResponseAnswer{
-			FieldID:   fieldID,
-			FieldType: a.FieldType,
-			Value:     a.Value,
-		})
+		response.FormID = formID
+	} else if token == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "form_id is required"})
+		return
 	}
 
-	// NOTE: vectors are currently not represented in the request payload as per usecase signature,
-	// but can be added if the client generates them. For now passing empty slice.
-	// If the backend should generate them, that logic should be in the UseCase.
-	vectors := []*entities.ResponseAnswerVector{}
+	answers, err := parseAnswers(req.Answers)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
 
-	if err := h.responseUC.Submit(c.Request.Context(), response, answers, vectors); err != nil {
-		// Return 400 for known domain/business errors, 500 for unexpected errors
-		if errors.Is(err, domainErr.ErrFormNotPublished) ||
-			errors.Is(err, domainErr.ErrFormClosed) ||
-			errors.Is(err, domainErr.ErrMissingRequiredField) ||
-			errors.Is(err, domainErr.ErrInvalidInput) ||
-			errors.Is(err, domainErr.ErrNotFound) {
-			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
-			return
+	resumeToken, err := h.responseUC.SaveDraft(c.Request.Context(), token, response, answers)
+	if err != nil {
+		notFound := "draft not found"
+		if token == "" {
+			notFound = "form not found"
 		}
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		writeSubmitError(c, err, notFound)
 		return
 	}
 
-	c.JSON(http.StatusCreated, response)
+	status := http.StatusOK
+	if token == "" {
+		status = http.StatusCreated
+	}
+	c.JSON(status, gin.H{
+		"resume_token": resumeToken,
+		"response":     response,
+	})
+}
+
+// GetDraft handles reloading a draft by its resume token
+func (h *ResponseHandler) GetDraft(c *gin.Context) {
+	draft, err := h.responseUC.GetDraft(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		writeSubmitError(c, err, "draft not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, draft)
+}
+
+// FinalizeDraft handles submitting a draft after full validation
+func (h *ResponseHandler) FinalizeDraft(c *gin.Context) {
+	response, err := h.responseUC.FinalizeDraft(c.Request.Context(), c.Param("token"))
+	if err != nil {
+		writeSubmitError(c, err, "draft not found")
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
 }
 
 // GetByID handles getting a response by ID
@@ -98,6 +226,9 @@ func (h *ResponseHandler) GetByID(c *gin.Context) {
 
 	response, err := h.responseUC.GetByID(c.Request.Context(), id)
 	if err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -105,7 +236,8 @@ func (h *ResponseHandler) GetByID(c *gin.Context) {
 	c.JSON(http.StatusOK, response)
 }
 
-// ListByForm handles listing responses for a form
+// ListByForm handles listing responses for a form.
+// Query: limit, offset, status, submitted_from, submitted_to, email, flagged, sort (asc|desc)
 func (h *ResponseHandler) ListByForm(c *gin.Context) {
 	formIDStr := c.Param("id")
 	formID, err := uuid.Parse(formIDStr)
@@ -114,13 +246,240 @@ func (h *ResponseHandler) ListByForm(c *gin.Context) {
 		return
 	}
 
-	responses, err := h.responseUC.ListByForm(c.Request.Context(), formID)
+	filter, err := parseResponseFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	filter.FormID = formID
+
+	page, err := h.responseUC.ListByForm(c.Request.Context(), filter)
+	if err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// parseResponseFilter reads response listing query parameters
+func parseResponseFilter(c *gin.Context) (repo.ResponseFilter, error) {
+	var filter repo.ResponseFilter
+
+	if v := c.Query("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return filter, errors.New("limit must be a positive integer")
+		}
+		filter.Pagination.Limit = n
+	}
+	if v := c.Query("offset"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return filter, errors.New("offset must be a non-negative integer")
+		}
+		filter.Pagination.Offset = n
+	}
+
+	if v := c.Query("status"); v != "" {
+		status, ok := enums.ParseResponseStatus(v)
+		if !ok {
+			return filter, errors.New("invalid status")
+		}
+		filter.Status = &status
+	}
+
+	if v := c.Query("submitted_from"); v != "" {
+		t, _, ok := parseDateParam(v)
+		if !ok {
+			return filter, errors.New("submitted_from must be a date (YYYY-MM-DD) or RFC3339 time")
+		}
+		filter.SubmittedFrom = &t
+	}
+	if v := c.Query("submitted_to"); v != "" {
+		t, dateOnly, ok := parseDateParam(v)
+		if !ok {
+			return filter, errors.New("submitted_to must be a date (YYYY-MM-DD) or RFC3339 time")
+		}
+		// A plain date includes the whole day
+		if dateOnly {
+			t = t.AddDate(0, 0, 1)
+		}
+		filter.SubmittedTo = &t
+	}
+
+	if v := strings.TrimSpace(c.Query("email")); v != "" {
+		filter.Email = &v
+	}
+
+	if v := c.Query("flagged"); v != "" {
+		flagged, err := strconv.ParseBool(v)
+		if err != nil {
+			return filter, errors.New("flagged must be true or false")
+		}
+		filter.Flagged = &flagged
+	}
+
+	sort, ok := repository.ParseSortOrder(c.Query("sort"), repository.SortDesc)
+	if !ok {
+		return filter, errors.New("sort must be asc or desc")
+	}
+	filter.Sort = sort
+
+	return filter, nil
+}
+
+// parseDateParam accepts YYYY-MM-DD or RFC3339 and reports whether only a date was given
+func parseDateParam(v string) (time.Time, bool, bool) {
+	if t, err := time.Parse("2006-01-02", v); err == nil {
+		return t, true, true
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, false, true
+	}
+	return time.Time{}, false, false
+}
+
+// Export handles downloading a form's responses as CSV or XLSX
+func (h *ResponseHandler) Export(c *gin.Context) {
+	formID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid form_id"})
+		return
+	}
+
+	format, err := export.ParseFormat(c.Query("format"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "format must be csv or xlsx"})
+		return
+	}
+
+	lang := c.DefaultQuery("lang", "en")
+	filename := fmt.Sprintf("responses-%s.%s", formID, format.Extension())
+
+	// Headers are only sent once the first bytes are written, so errors
+	// raised before streaming starts can still be returned as JSON
+	out := &attachmentWriter{c: c, contentType: format.ContentType(), filename: filename}
+	rw, err := export.NewRowWriter(format, out)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	if err := h.responseUC.Export(c.Request.Context(), formID, lang, rw); err != nil {
+		if out.started {
+			// The status line is already sent; the download ends truncated
+			log.Printf("response export for form %s failed mid-stream: %v", formID, err)
+			_ = c.Error(err)
+			return
+		}
+		if writeAccessError(c, err) {
+			return
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "form not found"})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	// Empty exports still need headers
+	out.start()
+}
+
+// attachmentWriter sets download headers on the first write
+type attachmentWriter struct {
+	c           *gin.Context
+	contentType string
+	filename    string
+	started     bool
+}
+
+func (w *attachmentWriter) start() {
+	if w.started {
+		return
+	}
+	w.started = true
+	w.c.Header("Content-Type", w.contentType)
+	w.c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, w.filename))
+	w.c.Status(http.StatusOK)
+}
+
+func (w *attachmentWriter) Write(p []byte) (int, error) {
+	w.start()
+	return w.c.Writer.Write(p)
+}
+
+// Review handles moving a response through the review workflow
+func (h *ResponseHandler) Review(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid UUID format"})
+		return
+	}
+
+	var req struct {
+		Status string `json:"status" binding:"required"`
+		Notes  string `json:"notes"`
+	}
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	status, ok := enums.ParseResponseStatus(req.Status)
+	if !ok {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "status must be reviewed, accepted or rejected"})
+		return
+	}
+
+	response, err := h.responseUC.Review(c.Request.Context(), id, status, req.Notes)
+	if err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
+		switch {
+		case errors.Is(err, pgx.ErrNoRows):
+			c.JSON(http.StatusNotFound, gin.H{"error": "response not found"})
+		case errors.Is(err, entities.ErrInvalidStatusTransition):
+			c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+		case errors.Is(err, entities.ErrMissingReviewer):
+			c.JSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// StatusHistory handles listing the status changes of a response
+func (h *ResponseHandler) StatusHistory(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid UUID format"})
+		return
+	}
+
+	history, err := h.responseUC.StatusHistory(c.Request.Context(), id)
 	if err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "response not found"})
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
 
-	c.JSON(http.StatusOK, responses)
+	c.JSON(http.StatusOK, history)
 }
 
 // Delete handles deleting a response
@@ -133,6 +492,9 @@ func (h *ResponseHandler) Delete(c *gin.Context) {
 	}
 
 	if err := h.responseUC.Delete(c.Request.Context(), id); err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
 		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 		return
 	}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	domainErr "Skillture_Form/internal/domain/errors"
+	"Skillture_Form/internal/embedding"
+	"Skillture_Form/internal/usecase/interfaces"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type SearchHandler struct {
+	embeddingUC interfaces.EmbeddingUseCase
+}
+
+func NewSearchHandler(embeddingUC interfaces.EmbeddingUseCase) *SearchHandler {
+	return &SearchHandler{embeddingUC: embeddingUC}
+}
+
+// Search handles semantic search over a form's answers.
+// Query: q (required), limit (top-k, default 10, max 100), field_id
+func (h *SearchHandler) Search(c *gin.Context) {
+	formID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid form_id"})
+		return
+	}
+
+	limit := interfaces.DefaultSearchLimit
+	if v := c.Query("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 || n > interfaces.MaxSearchLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be between 1 and 100"})
+			return
+		}
+		limit = n
+	}
+
+	var fieldID *uuid.UUID
+	if v := c.Query("field_id"); v != "" {
+		id, err := uuid.Parse(v)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid field_id"})
+			return
+		}
+		fieldID = &id
+	}
+
+	result, err := h.embeddingUC.Search(c.Request.Context(), formID, c.Query("q"), limit, fieldID)
+	if err != nil {
+		if writeAccessError(c, err) {
+			return
+		}
+		var apiErr *embedding.APIError
+		switch {
+		case errors.Is(err, domainErr.ErrInvalidInput):
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		case errors.Is(err, pgx.ErrNoRows):
+			c.JSON(http.StatusNotFound, gin.H{"error": "form not found"})
+		case errors.As(err, &apiErr):
+			c.JSON(http.StatusBadGateway, gin.H{"error": "embedding service unavailable"})
+		default:
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
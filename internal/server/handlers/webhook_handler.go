@@ -0,0 +1,222 @@
+package handlers
+
+import (
+	"errors"
+	"math"
+	"net/http"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	"Skillture_Form/internal/repository"
+	repo "Skillture_Form/internal/repository/interfaces"
+	"Skillture_Form/internal/usecase/interfaces"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type WebhookHandler struct {
+	webhookUC interfaces.WebhookUseCase
+}
+
+func NewWebhookHandler(webhookUC interfaces.WebhookUseCase) *WebhookHandler {
+	return &WebhookHandler{webhookUC: webhookUC}
+}
+
+// webhookRequest is the body of subscription create and update requests.
+// On update, omitted fields are left unchanged.
+type webhookRequest struct {
+	URL    *string              `json:"url"`
+	Secret *string              `json:"secret"`
+	Events []enums.WebhookEvent `json:"events"`
+	Active *bool                `json:"active"`
+}
+
+// writeWebhookError maps webhook use case errors to HTTP responses;
+// notFound names the resource looked up by ID
+func writeWebhookError(c *gin.Context, err error, notFound string) {
+	if writeAccessError(c, err) {
+		return
+	}
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		c.JSON(http.StatusNotFound, gin.H{"error": notFound + " not found"})
+	case errors.Is(err, domainErr.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "delivery not found"})
+	case errors.Is(err, entities.ErrInvalidWebhook), errors.Is(err, domainErr.ErrInvalidInput):
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	case errors.Is(err, entities.ErrDeliveryPending):
+		c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+	}
+}
+
+// Create subscribes a URL to events of a form. The response is the only
+// place the signing secret is returned.
+func (h *WebhookHandler) Create(c *gin.Context) {
+	formID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid form_id"})
+		return
+	}
+
+	var req webhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	sub := &entities.WebhookSubscription{FormID: formID, Events: req.Events}
+	if req.URL != nil {
+		sub.URL = *req.URL
+	}
+	if req.Secret != nil {
+		sub.Secret = *req.Secret
+	}
+
+	if err := h.webhookUC.CreateSubscription(c.Request.Context(), sub); err != nil {
+		writeWebhookError(c, err, "form")
+		return
+	}
+
+	c.JSON(http.StatusCreated, sub)
+}
+
+// ListByForm lists the subscriptions of a form
+func (h *WebhookHandler) ListByForm(c *gin.Context) {
+	formID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid form_id"})
+		return
+	}
+
+	subs, err := h.webhookUC.ListSubscriptions(c.Request.Context(), formID)
+	if err != nil {
+		writeWebhookError(c, err, "form")
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"data": subs})
+}
+
+// GetByID returns a subscription
+func (h *WebhookHandler) GetByID(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid webhook id"})
+		return
+	}
+
+	sub, err := h.webhookUC.GetSubscription(c.Request.Context(), id)
+	if err != nil {
+		writeWebhookError(c, err, "webhook")
+		return
+	}
+
+	c.JSON(http.StatusOK, sub)
+}
+
+// Update changes the URL, secret, events or active flag of a subscription
+func (h *WebhookHandler) Update(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid webhook id"})
+		return
+	}
+
+	var req webhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	sub, err := h.webhookUC.UpdateSubscription(c.Request.Context(), id, interfaces.WebhookSubscriptionUpdate{
+		URL:    req.URL,
+		Secret: req.Secret,
+		Events: req.Events,
+		Active: req.Active,
+	})
+	if err != nil {
+		writeWebhookError(c, err, "webhook")
+		return
+	}
+
+	c.JSON(http.StatusOK, sub)
+}
+
+// Delete removes a subscription and its delivery log
+func (h *WebhookHandler) Delete(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid webhook id"})
+		return
+	}
+
+	if err := h.webhookUC.DeleteSubscription(c.Request.Context(), id); err != nil {
+		writeWebhookError(c, err, "webhook")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Deliveries lists the delivery log of a subscription, newest first.
+// Query: status (pending|delivered|dead), limit, offset
+func (h *WebhookHandler) Deliveries(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid webhook id"})
+		return
+	}
+
+	filter := repo.WebhookDeliveryFilter{SubscriptionID: id}
+	if v := c.Query("status"); v != "" {
+		status, ok := enums.ParseWebhookDeliveryStatus(v)
+		if !ok {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "status must be pending, delivered or dead"})
+			return
+		}
+		filter.Status = &status
+	}
+
+	var ok bool
+	if filter.Pagination.Limit, ok = queryInt(c, "limit", repository.DefaultPageLimit, 1, repository.MaxPageLimit); !ok {
+		return
+	}
+	if filter.Pagination.Offset, ok = queryInt(c, "offset", 0, 0, math.MaxInt32); !ok {
+		return
+	}
+
+	page, err := h.webhookUC.ListDeliveries(c.Request.Context(), filter)
+	if err != nil {
+		writeWebhookError(c, err, "webhook")
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// Redeliver queues a delivered or dead-lettered delivery again
+func (h *WebhookHandler) Redeliver(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid webhook id"})
+		return
+	}
+	deliveryID, err := uuid.Parse(c.Param("delivery_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid delivery id"})
+		return
+	}
+
+	delivery, err := h.webhookUC.Redeliver(c.Request.Context(), id, deliveryID)
+	if err != nil {
+		writeWebhookError(c, err, "delivery")
+		return
+	}
+
+	c.JSON(http.StatusAccepted, delivery)
+}
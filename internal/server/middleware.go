@@ -1,7 +1,13 @@
 package server
 
 import (
+	"errors"
 	"net/http"
+	"strings"
+
+	"Skillture_Form/internal/auth"
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/usecase/interfaces"
 
 	"github.com/gin-gonic/gin"
 )
@@ -21,6 +27,44 @@ func setupMiddleware(r *gin.Engine) {
 
 		c.Next()
 	})
+}
+
+// authMiddleware requires a valid access token of an active session in the
+// Authorization header. The authenticated admin ID is stored in both the gin
+// context and the request context so use cases can read it via
+// auth.AdminIDFromContext; the session ID via auth.SessionIDFromContext.
+func authMiddleware(tokens *auth.TokenManager, sessions interfaces.SessionUseCase) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		header := c.GetHeader("Authorization")
+		scheme, token, found := strings.Cut(header, " ")
+		if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing bearer token"})
+			return
+		}
+
+		claims, err := tokens.ParseAccess(strings.TrimSpace(token))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+			return
+		}
+
+		adminID, _ := claims.AdminID()
+		sessionID, _ := claims.SessionID()
 
-	// TODO: Add JWT authentication middleware here
+		// Revoked sessions end before their access tokens expire
+		if err := sessions.Validate(c.Request.Context(), adminID, sessionID); err != nil {
+			if errors.Is(err, entities.ErrSessionRevoked) {
+				c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": err.Error()})
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Set("admin_id", adminID)
+		ctx := auth.WithAdminID(c.Request.Context(), adminID)
+		c.Request = c.Request.WithContext(auth.WithSessionID(ctx, sessionID))
+
+		c.Next()
+	}
 }
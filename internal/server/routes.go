@@ -27,10 +27,15 @@ import (
 // SetupRoutes configures all route groups and endpoints
 func SetupRoutes(
 	r *gin.Engine,
+	requireAuth gin.HandlerFunc,
 	adminHandler *handlers.AdminHandler,
 	formHandler *handlers.FormHandler,
 	fieldHandler *handlers.FormFieldHandler,
+	sectionHandler *handlers.FormSectionHandler,
 	responseHandler *handlers.ResponseHandler,
+	searchHandler *handlers.SearchHandler,
+	analysisHandler *handlers.AnalysisHandler,
+	webhookHandler *handlers.WebhookHandler,
 ) {
 	// API v1 group
 	v1 := r.Group("/api/v1")
@@ -38,15 +43,34 @@ func SetupRoutes(
 	// Admin routes
 	admin := v1.Group("/admins")
 	{
-		admin.POST("/", adminHandler.Create)
-		admin.POST("/login", adminHandler.LoginAdmin) // Added based on AdminHandler
-		admin.GET("/", adminHandler.List)
-		admin.GET("/:id", adminHandler.GetByID)
-		admin.DELETE("/:id", adminHandler.Delete)
+		// Public: token issuance and password reset with a reset token
+		admin.POST("/login", adminHandler.LoginAdmin)
+		admin.POST("/login/mfa", adminHandler.LoginMFA)
+		admin.POST("/refresh", adminHandler.Refresh)
+		admin.POST("/password-reset", adminHandler.ResetPassword)
+
+		// Protected: admin management
+		protected := admin.Group("", requireAuth)
+		protected.POST("/", adminHandler.Create)
+		protected.GET("/", adminHandler.List)
+		protected.GET("/:id", adminHandler.GetByID)
+		protected.DELETE("/:id", adminHandler.Delete)
+		protected.PUT("/:id/role", adminHandler.SetRole)
+		protected.POST("/:id/unlock", adminHandler.Unlock)
+		protected.DELETE("/lockouts/ip/:ip", adminHandler.UnlockIP)
+		protected.POST("/:id/password-reset", adminHandler.IssuePasswordReset)
+		protected.PUT("/me/password", adminHandler.ChangePassword)
+		protected.POST("/me/mfa", adminHandler.EnrollMFA)
+		protected.POST("/me/mfa/confirm", adminHandler.ConfirmMFA)
+		protected.DELETE("/me/mfa", adminHandler.DisableOwnMFA)
+		protected.DELETE("/:id/mfa", adminHandler.DisableMFA)
+		protected.GET("/:id/sessions", adminHandler.ListSessions)
+		protected.DELETE("/:id/sessions", adminHandler.RevokeSessions)
+		protected.DELETE("/:id/sessions/:session_id", adminHandler.RevokeSession)
 	}
 
 	// Form routes
-	forms := v1.Group("/forms")
+	forms := v1.Group("/forms", requireAuth)
 	{
 		forms.POST("/", formHandler.Create)
 		forms.GET("/", formHandler.List)
@@ -57,25 +81,91 @@ func SetupRoutes(
 		// Form actions
 		forms.POST("/:id/publish", formHandler.Publish)
 		forms.POST("/:id/close", formHandler.Close)
+		forms.PUT("/:id/duplicate-policy", formHandler.SetDuplicatePolicy)
+		forms.PUT("/:id/notifications", formHandler.SetNotifications)
+
+		// Per-form roles, managed by the form's owners
+		forms.GET("/:id/grants", formHandler.ListGrants)
+		forms.PUT("/:id/grants/:admin_id", formHandler.Grant)
+		forms.DELETE("/:id/grants/:admin_id", formHandler.Revoke)
 
 		// Nested fields routes
 		forms.GET("/:id/fields", fieldHandler.ListByFormID)
 		forms.GET("/:id/responses", responseHandler.ListByForm)
+		forms.GET("/:id/responses/export", responseHandler.Export)
+
+		// Semantic search over answers
+		forms.GET("/:id/search", searchHandler.Search)
+
+		// Aggregate analytics
+		forms.GET("/:id/analytics", analysisHandler.Form)
+		forms.GET("/:id/analytics/crosstab", analysisHandler.Crosstab)
+
+		// Webhook subscriptions
+		forms.POST("/:id/webhooks", webhookHandler.Create)
+		forms.GET("/:id/webhooks", webhookHandler.ListByForm)
+
+		// Nested sections routes
+		forms.POST("/:id/sections", sectionHandler.Create)
+		forms.GET("/:id/sections", sectionHandler.List)
+		forms.PUT("/:id/sections/:section_id", sectionHandler.Update)
+		forms.DELETE("/:id/sections/:section_id", sectionHandler.Delete)
+
+		// Translation completeness check
+		forms.GET("/:id/translations", sectionHandler.Translations)
 	}
 
 	// Field routes (independent management)
-	fields := v1.Group("/fields")
+	fields := v1.Group("/fields", requireAuth)
 	{
 		fields.POST("/", fieldHandler.Create) // Payload contains form_id
 		fields.PUT("/:id", fieldHandler.Update)
 		fields.DELETE("/:id", fieldHandler.Delete)
+
+		// Themes of free-text answers
+		fields.GET("/:id/themes", analysisHandler.Themes)
+	}
+
+	// Webhook routes
+	webhooks := v1.Group("/webhooks", requireAuth)
+	{
+		webhooks.GET("/:id", webhookHandler.GetByID)
+		webhooks.PUT("/:id", webhookHandler.Update)
+		webhooks.DELETE("/:id", webhookHandler.Delete)
+
+		// Delivery log
+		webhooks.GET("/:id/deliveries", webhookHandler.Deliveries)
+		webhooks.POST("/:id/deliveries/:delivery_id/redeliver", webhookHandler.Redeliver)
 	}
 
 	// Response routes
 	responses := v1.Group("/responses")
 	{
+		// Public: form submission
 		responses.POST("/", responseHandler.Submit)
-		responses.GET("/:id", responseHandler.GetByID)
-		responses.DELETE("/:id", responseHandler.Delete)
+
+		// Public: save-and-resume drafts, addressed by their resume token
+		responses.POST("/drafts", responseHandler.SaveDraft)
+		responses.GET("/drafts/:token", responseHandler.GetDraft)
+		responses.PUT("/drafts/:token", responseHandler.SaveDraft)
+		responses.POST("/drafts/:token/submit", responseHandler.FinalizeDraft)
+
+		// Protected: reading and deleting responses
+		protected := responses.Group("", requireAuth)
+		protected.GET("/:id", responseHandler.GetByID)
+		protected.DELETE("/:id", responseHandler.Delete)
+
+		// Protected: review workflow
+		protected.POST("/:id/status", responseHandler.Review)
+		protected.GET("/:id/history", responseHandler.StatusHistory)
+	}
+
+	// Public routes used by respondents to render a form
+	public := v1.Group("/public")
+	{
+		public.GET("/forms/:id", formHandler.GetPublic)
+		public.GET("/forms/:id/fields", fieldHandler.ListPublic)
+		public.GET("/forms/:id/definition", sectionHandler.Definition)
+		public.GET("/forms/:id/render", sectionHandler.Render)
 	}
 }
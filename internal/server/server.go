@@ -8,7 +8,9 @@ import (
 	"path/filepath"
 	"strings"
 
+	"Skillture_Form/internal/auth"
 	"Skillture_Form/internal/server/handlers"
+	"Skillture_Form/internal/usecase/interfaces"
 
 	"github.com/gin-gonic/gin"
 )
@@ -20,19 +22,31 @@ type Server struct {
 
 // NewServer creates a new server instance with wired handlers
 func NewServer(
+	trustedProxies []string,
+	tokens *auth.TokenManager,
+	sessions interfaces.SessionUseCase,
 	adminHandler *handlers.AdminHandler,
 	formHandler *handlers.FormHandler,
 	fieldHandler *handlers.FormFieldHandler,
+	sectionHandler *handlers.FormSectionHandler,
 	responseHandler *handlers.ResponseHandler,
+	searchHandler *handlers.SearchHandler,
+	analysisHandler *handlers.AnalysisHandler,
+	webhookHandler *handlers.WebhookHandler,
 ) *Server {
 
 	r := gin.Default()
-	r.SetTrustedProxies(nil)
+
+	// ClientIP() reads X-Forwarded-For only from these addresses, so login
+	// lockouts and fingerprints see the real client behind the reverse proxy
+	if err := r.SetTrustedProxies(trustedProxies); err != nil {
+		log.Fatalf("invalid trusted proxies: %v", err)
+	}
 
 	// Apply Middleware
 	setupMiddleware(r)
 
-	SetupRoutes(r, adminHandler, formHandler, fieldHandler, responseHandler)
+	SetupRoutes(r, authMiddleware(tokens, sessions), adminHandler, formHandler, fieldHandler, sectionHandler, responseHandler, searchHandler, analysisHandler, webhookHandler)
 
 	// Serve frontend static files in production
 	serveStaticFiles(r)
@@ -0,0 +1,138 @@
+// Package stats provides statistical tests for survey analysis.
+package stats
+
+import "math"
+
+// Settings of the incomplete gamma evaluation
+const (
+	gammaMaxIterations = 500
+	gammaEpsilon       = 1e-14
+	gammaTiny          = 1e-300
+)
+
+// ChiSquareSurvival returns P(X >= x) for a chi-square distribution with df
+// degrees of freedom, i.e. the p-value of a chi-square statistic x
+func ChiSquareSurvival(x float64, df int) float64 {
+	if df < 1 || math.IsNaN(x) {
+		return math.NaN()
+	}
+	if x <= 0 {
+		return 1
+	}
+	return upperGammaQ(float64(df)/2, x/2)
+}
+
+// upperGammaQ is the regularized upper incomplete gamma function Q(a, x).
+// It uses the series of P(a, x) for x < a+1 and a continued fraction otherwise,
+// which converge quickly in their respective ranges.
+func upperGammaQ(a, x float64) float64 {
+	lga, _ := math.Lgamma(a)
+	prefix := a*math.Log(x) - x - lga
+
+	if x < a+1 {
+		// P(a, x) = e^-x x^a / Γ(a) * Σ x^n / (a (a+1) ... (a+n))
+		term := 1 / a
+		sum := term
+		for n := 1; n < gammaMaxIterations; n++ {
+			term *= x / (a + float64(n))
+			sum += term
+			if math.Abs(term) < math.Abs(sum)*gammaEpsilon {
+				break
+			}
+		}
+		return math.Max(0, 1-sum*math.Exp(prefix))
+	}
+
+	// Modified Lentz evaluation of the continued fraction for Q(a, x)
+	b := x + 1 - a
+	c := 1 / gammaTiny
+	d := 1 / b
+	h := d
+	for n := 1; n < gammaMaxIterations; n++ {
+		an := -float64(n) * (float64(n) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < gammaTiny {
+			d = gammaTiny
+		}
+		c = b + an/c
+		if math.Abs(c) < gammaTiny {
+			c = gammaTiny
+		}
+		d = 1 / d
+		delta := d * c
+		h *= delta
+		if math.Abs(delta-1) < gammaEpsilon {
+			break
+		}
+	}
+	return math.Exp(prefix) * h
+}
+
+// ChiSquareResult is the outcome of Pearson's chi-square test of independence
+type ChiSquareResult struct {
+	Statistic   float64     `json:"statistic"`
+	DF          int         `json:"df"`
+	PValue      float64     `json:"p_value"`
+	CramersV    float64     `json:"cramers_v"`    // Effect size from 0 (independent) to 1
+	LowExpected int         `json:"low_expected"` // Cells with an expected count below 5, where the test is unreliable
+	Expected    [][]float64 `json:"-"`            // Expected counts of the observed table
+}
+
+// ChiSquareTest runs Pearson's chi-square test of independence on a
+// contingency table. Rows and columns without observations are left out of
+// the test. It returns false when fewer than two rows or columns remain.
+func ChiSquareTest(observed [][]int) (*ChiSquareResult, bool) {
+	rowTotals := make([]int, len(observed))
+	var colTotals []int
+	total := 0
+	for i, row := range observed {
+		if colTotals == nil {
+			colTotals = make([]int, len(row))
+		}
+		for j, n := range row {
+			rowTotals[i] += n
+			colTotals[j] += n
+			total += n
+		}
+	}
+
+	rows, cols := nonZero(rowTotals), nonZero(colTotals)
+	if rows < 2 || cols < 2 {
+		return nil, false
+	}
+
+	res := &ChiSquareResult{
+		DF:       (rows - 1) * (cols - 1),
+		Expected: make([][]float64, len(observed)),
+	}
+	for i, row := range observed {
+		res.Expected[i] = make([]float64, len(row))
+		for j, n := range row {
+			expected := float64(rowTotals[i]) * float64(colTotals[j]) / float64(total)
+			res.Expected[i][j] = expected
+			if expected == 0 {
+				continue
+			}
+			diff := float64(n) - expected
+			res.Statistic += diff * diff / expected
+			if expected < 5 {
+				res.LowExpected++
+			}
+		}
+	}
+
+	res.PValue = ChiSquareSurvival(res.Statistic, res.DF)
+	res.CramersV = math.Sqrt(res.Statistic / (float64(total) * float64(min(rows, cols)-1)))
+	return res, true
+}
+
+func nonZero(totals []int) int {
+	n := 0
+	for _, t := range totals {
+		if t > 0 {
+			n++
+		}
+	}
+	return n
+}
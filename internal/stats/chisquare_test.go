@@ -0,0 +1,61 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChiSquareSurvival_CriticalValues(t *testing.T) {
+	// Critical values of the chi-square distribution
+	tests := []struct {
+		x    float64
+		df   int
+		want float64
+	}{
+		{3.841459, 1, 0.05},
+		{6.634897, 1, 0.01},
+		{5.991465, 2, 0.05},
+		{11.070498, 5, 0.05},
+		{18.307038, 10, 0.05},
+		{0.454936, 1, 0.5},
+		{29.587744, 10, 0.001},
+	}
+
+	for _, tt := range tests {
+		require.InDelta(t, tt.want, ChiSquareSurvival(tt.x, tt.df), 1e-6, "x=%v df=%d", tt.x, tt.df)
+	}
+
+	require.Equal(t, 1.0, ChiSquareSurvival(0, 3))
+	require.True(t, math.IsNaN(ChiSquareSurvival(1, 0)))
+}
+
+func TestChiSquareTest(t *testing.T) {
+	// Textbook 2x2 example: statistic = 100 * (30*30 - 20*20)^2 / (50^4) = 4
+	res, ok := ChiSquareTest([][]int{
+		{30, 20},
+		{20, 30},
+	})
+	require.True(t, ok)
+	require.InDelta(t, 4.0, res.Statistic, 1e-9)
+	require.Equal(t, 1, res.DF)
+	require.InDelta(t, 0.0455, res.PValue, 1e-4)
+	require.InDelta(t, 0.2, res.CramersV, 1e-9)
+	require.Equal(t, 0, res.LowExpected)
+	require.InDelta(t, 25.0, res.Expected[0][0], 1e-9)
+}
+
+func TestChiSquareTest_SkipsEmptyCategories(t *testing.T) {
+	res, ok := ChiSquareTest([][]int{
+		{10, 0, 5},
+		{0, 0, 0},
+		{5, 0, 10},
+	})
+	require.True(t, ok)
+	require.Equal(t, 1, res.DF)
+	require.Equal(t, 0.0, res.Expected[1][1])
+
+	_, ok = ChiSquareTest([][]int{{3, 4}, {0, 0}})
+	require.False(t, ok)
+}
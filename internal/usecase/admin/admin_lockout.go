@@ -0,0 +1,163 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+
+	"github.com/google/uuid"
+)
+
+// loginKey is a username or client IP that failed logins are counted against
+type loginKey struct {
+	scope enums.LoginScope
+	key   string
+	limit int // Failures that lock the key
+}
+
+// loginKeys returns the counters a login attempt is checked against.
+// Client IPs get their own, higher limit: many admins may share an address
+// (an office, a proxy) and one of them mistyping must not lock out the rest.
+func (u *adminUseCase) loginKeys(username, clientIP string) []loginKey {
+	keys := []loginKey{{scope: enums.LoginScopeUsername, key: username, limit: u.settings.MaxLoginAttempts}}
+	if clientIP != "" {
+		keys = append(keys, loginKey{scope: enums.LoginScopeIP, key: clientIP, limit: u.settings.MaxLoginAttemptsPerIP})
+	}
+	return keys
+}
+
+// checkLockout returns a LockoutError ending with the latest running lockout
+func (u *adminUseCase) checkLockout(ctx context.Context, keys []loginKey, now time.Time) error {
+	var until time.Time
+	for _, k := range keys {
+		attempt, err := u.attemptRepo.Get(ctx, k.scope, k.key)
+		if err != nil {
+			return err
+		}
+		if attempt != nil && attempt.LockedUntil != nil && attempt.LockedUntil.After(until) {
+			until = *attempt.LockedUntil
+		}
+	}
+
+	if until.After(now) {
+		return &entities.LockoutError{Until: until}
+	}
+	return nil
+}
+
+// loginFailed counts a failed login against every key and locks the keys
+// that reached the threshold. It returns the error to report to the client:
+// a LockoutError when this failure caused a lockout, otherwise
+// ErrInvalidCredentials. admin is nil for unknown usernames.
+func (u *adminUseCase) loginFailed(
+	ctx context.Context,
+	admin *entities.Admin,
+	keys []loginKey,
+	clientIP string,
+	now time.Time,
+) error {
+	var lockout *entities.LockoutError
+	for _, k := range keys {
+		attempt, err := u.attemptRepo.RecordFailure(ctx, k.scope, k.key, now, u.settings.Lockout)
+		if err != nil {
+			return err
+		}
+		if attempt.Failures < k.limit {
+			continue
+		}
+
+		until := now.Add(u.settings.Lockout)
+		locked, err := u.attemptRepo.Lock(ctx, k.scope, k.key, now, until)
+		if err != nil {
+			return err
+		}
+		lockout = &entities.LockoutError{Until: until}
+
+		// Concurrent failures may reach the threshold together; only the
+		// one that set the lock records it
+		if !locked {
+			continue
+		}
+		event := &entities.AuditEvent{
+			Event:    enums.AuditLoginLockout,
+			Subject:  k.key,
+			ClientIP: clientIP,
+			Details: map[string]any{
+				"scope":        k.scope,
+				"failures":     attempt.Failures,
+				"locked_until": until,
+			},
+			CreatedAt: now,
+		}
+		if admin != nil && k.scope == enums.LoginScopeUsername {
+			event.AdminID = &admin.ID
+		}
+		if err := u.auditRepo.Record(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	if lockout != nil {
+		return lockout
+	}
+	return entities.ErrInvalidCredentials
+}
+
+// Unlock lifts the lockout and clears the failed logins of an admin's
+// username. Lockouts of client IPs are lifted with UnlockIP.
+func (u *adminUseCase) Unlock(ctx context.Context, id uuid.UUID) error {
+	actor, err := u.authz.RequireRole(ctx, enums.RoleOwner)
+	if err != nil {
+		return err
+	}
+
+	admin, err := u.adminRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if admin == nil {
+		return domainErr.ErrNotFound
+	}
+
+	if err := u.attemptRepo.Reset(ctx, enums.LoginScopeUsername, admin.Username); err != nil {
+		return err
+	}
+
+	return u.auditRepo.Record(ctx, &entities.AuditEvent{
+		Event:   enums.AuditLoginUnlock,
+		AdminID: &admin.ID,
+		ActorID: &actor.ID,
+		Subject: admin.Username,
+	})
+}
+
+// UnlockIP lifts the lockout and clears the failed logins of a client IP
+func (u *adminUseCase) UnlockIP(ctx context.Context, clientIP string) error {
+	actor, err := u.authz.RequireRole(ctx, enums.RoleOwner)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(clientIP)
+	if ip == nil {
+		return fmt.Errorf("%w: invalid IP address", domainErr.ErrInvalidInput)
+	}
+	// Counters are keyed by the canonical form gin reports
+	clientIP = ip.String()
+
+	if err := u.attemptRepo.Reset(ctx, enums.LoginScopeIP, clientIP); err != nil {
+		return err
+	}
+
+	return u.auditRepo.Record(ctx, &entities.AuditEvent{
+		Event:   enums.AuditLoginUnlock,
+		ActorID: &actor.ID,
+		Subject: clientIP,
+		Details: map[string]any{"scope": enums.LoginScopeIP},
+	})
+}
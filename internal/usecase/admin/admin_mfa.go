@@ -0,0 +1,343 @@
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"Skillture_Form/internal/auth"
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	uc "Skillture_Form/internal/usecase/interfaces"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// mfaSkew is how many 30 second steps a code may be off, for clock drift
+	mfaSkew = 1
+
+	// recoveryCodeCount is how many recovery codes enrollment hands out
+	recoveryCodeCount = 10
+
+	// recoveryCodeBytes is the entropy of a recovery code (80 bits)
+	recoveryCodeBytes = 10
+)
+
+// recoveryEncoding spells recovery codes without padding
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// EnrollMFA starts TOTP enrollment for the admin in ctx. The returned secret
+// is pending until ConfirmMFA; enrolling again replaces it.
+func (u *adminUseCase) EnrollMFA(ctx context.Context) (*uc.MFAEnrollment, error) {
+	admin, err := u.currentAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if admin.MFAEnabled() {
+		return nil, entities.ErrMFAEnabled
+	}
+
+	secret, err := auth.NewTOTPSecret()
+	if err != nil {
+		return nil, err
+	}
+	totp, err := auth.NewTOTP(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	admin.MFASecret = secret
+	admin.MFAEnabledAt = nil
+	admin.MFALastCounter = nil
+	if err := u.adminRepo.UpdateMFA(ctx, admin); err != nil {
+		return nil, err
+	}
+
+	return &uc.MFAEnrollment{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(u.settings.MFAIssuer, admin.Username),
+	}, nil
+}
+
+// ConfirmMFA enables MFA for the admin in ctx once they entered a code from
+// the enrolled secret, and returns their recovery codes. The codes are only
+// returned here; the server keeps their hashes.
+func (u *adminUseCase) ConfirmMFA(ctx context.Context, code string) ([]string, error) {
+	admin, err := u.currentAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if admin.MFAEnabled() {
+		return nil, entities.ErrMFAEnabled
+	}
+	if admin.MFASecret == "" {
+		return nil, entities.ErrMFANotEnrolled
+	}
+
+	// -------------------
+	// 1️⃣ Check the code against the pending secret
+	// -------------------
+	totp, err := auth.NewTOTP(admin.MFASecret)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	counter, ok := totp.Verify(strings.TrimSpace(code), now, mfaSkew)
+	if !ok {
+		return nil, entities.ErrInvalidMFACode
+	}
+
+	// -------------------
+	// 2️⃣ Store recovery codes before enabling, so an enabled admin always has them
+	// -------------------
+	codes, hashed, err := newRecoveryCodes(now)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.recoveryRepo.Replace(ctx, admin.ID, hashed); err != nil {
+		return nil, err
+	}
+
+	// -------------------
+	// 3️⃣ Enable; the confirming code cannot be used to log in
+	// -------------------
+	admin.MFAEnabledAt = &now
+	admin.MFALastCounter = &counter
+	if err := u.adminRepo.UpdateMFA(ctx, admin); err != nil {
+		return nil, err
+	}
+
+	if err := u.auditRepo.Record(ctx, &entities.AuditEvent{
+		Event:     enums.AuditMFAEnabled,
+		AdminID:   &admin.ID,
+		ActorID:   &admin.ID,
+		Subject:   admin.Username,
+		CreatedAt: now,
+	}); err != nil {
+		return nil, err
+	}
+
+	return codes, nil
+}
+
+// VerifyMFA completes the login of an admin whose password was already
+// verified, with an authenticator or recovery code. Wrong codes count as
+// failed logins of the username and client IP, so they lock out like wrong
+// passwords.
+func (u *adminUseCase) VerifyMFA(ctx context.Context, adminID uuid.UUID, code, clientIP string) (*entities.Admin, error) {
+	admin, err := u.adminRepo.GetByID(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if admin == nil || !admin.CanLogin() || !admin.MFAEnabled() {
+		return nil, entities.ErrInvalidMFACode
+	}
+
+	if err := u.checkSecondFactor(ctx, admin, code, clientIP); err != nil {
+		return nil, err
+	}
+
+	// The login is complete; clear the username's failures
+	if err := u.attemptRepo.Reset(ctx, enums.LoginScopeUsername, admin.Username); err != nil {
+		return nil, err
+	}
+	return admin, nil
+}
+
+// DisableMFA turns off MFA and deletes the recovery codes of an admin.
+// Admins disabling their own MFA confirm it with a code; owners may disable
+// it for others, e.g. after a lost device.
+func (u *adminUseCase) DisableMFA(ctx context.Context, id uuid.UUID, code, clientIP string) error {
+	actorID, ok := auth.AdminIDFromContext(ctx)
+	if !ok {
+		return domainErr.ErrUnauthenticated
+	}
+	if actorID != id {
+		if _, err := u.authz.RequireRole(ctx, enums.RoleOwner); err != nil {
+			return err
+		}
+	}
+
+	admin, err := u.adminRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if admin == nil {
+		return domainErr.ErrNotFound
+	}
+	if !admin.MFAEnabled() && admin.MFASecret == "" {
+		return entities.ErrMFANotEnabled
+	}
+
+	// A pending enrollment can be dropped without a code
+	if actorID == id && admin.MFAEnabled() {
+		if err := u.checkSecondFactor(ctx, admin, code, clientIP); err != nil {
+			return err
+		}
+	}
+
+	admin.MFASecret = ""
+	admin.MFAEnabledAt = nil
+	admin.MFALastCounter = nil
+	if err := u.adminRepo.UpdateMFA(ctx, admin); err != nil {
+		return err
+	}
+	if err := u.recoveryRepo.DeleteAll(ctx, admin.ID); err != nil {
+		return err
+	}
+
+	return u.auditRepo.Record(ctx, &entities.AuditEvent{
+		Event:   enums.AuditMFADisabled,
+		AdminID: &admin.ID,
+		ActorID: &actorID,
+		Subject: admin.Username,
+	})
+}
+
+// checkSecondFactor accepts a TOTP code of a time step not used before, or
+// an unused recovery code. Failures are counted like failed logins.
+func (u *adminUseCase) checkSecondFactor(ctx context.Context, admin *entities.Admin, code, clientIP string) error {
+	now := time.Now()
+	keys := u.loginKeys(admin.Username, clientIP)
+
+	if err := u.checkLockout(ctx, keys, now); err != nil {
+		return err
+	}
+
+	ok, err := u.matchSecondFactor(ctx, admin, code, clientIP, now)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return nil
+	}
+
+	err = u.loginFailed(ctx, admin, keys, clientIP, now)
+	if errors.Is(err, entities.ErrInvalidCredentials) {
+		return entities.ErrInvalidMFACode
+	}
+	return err
+}
+
+// matchSecondFactor reports whether code is a valid TOTP or recovery code
+// of the admin, and uses it up
+func (u *adminUseCase) matchSecondFactor(
+	ctx context.Context,
+	admin *entities.Admin,
+	code string,
+	clientIP string,
+	now time.Time,
+) (bool, error) {
+	code = strings.TrimSpace(code)
+
+	if isTOTPCode(code) {
+		totp, err := auth.NewTOTP(admin.MFASecret)
+		if err != nil {
+			return false, err
+		}
+		counter, ok := totp.Verify(code, now, mfaSkew)
+		if !ok {
+			return false, nil
+		}
+		// Refuses a code whose time step was already used
+		return u.adminRepo.UseMFACounter(ctx, admin.ID, counter)
+	}
+
+	normalized := normalizeRecoveryCode(code)
+	if normalized == "" {
+		return false, nil
+	}
+	used, err := u.recoveryRepo.Consume(ctx, admin.ID, hashResetToken(normalized), now)
+	if err != nil || !used {
+		return false, err
+	}
+
+	remaining, err := u.recoveryRepo.CountUnused(ctx, admin.ID)
+	if err != nil {
+		return false, err
+	}
+	if err := u.auditRepo.Record(ctx, &entities.AuditEvent{
+		Event:     enums.AuditMFARecoveryCodeUsed,
+		AdminID:   &admin.ID,
+		Subject:   admin.Username,
+		ClientIP:  clientIP,
+		Details:   map[string]any{"remaining": remaining},
+		CreatedAt: now,
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// currentAdmin loads the admin in ctx
+func (u *adminUseCase) currentAdmin(ctx context.Context) (*entities.Admin, error) {
+	adminID, ok := auth.AdminIDFromContext(ctx)
+	if !ok {
+		return nil, domainErr.ErrUnauthenticated
+	}
+	admin, err := u.adminRepo.GetByID(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if admin == nil {
+		return nil, domainErr.ErrUnauthenticated
+	}
+	return admin, nil
+}
+
+// isTOTPCode reports whether code has the shape of an authenticator code
+func isTOTPCode(code string) bool {
+	if len(code) != auth.TOTPDigits {
+		return false
+	}
+	for _, r := range code {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// newRecoveryCodes generates recovery codes formatted as XXXX-XXXX-XXXX-XXXX
+// and the hashed records stored for them
+func newRecoveryCodes(now time.Time) ([]string, []*entities.MFARecoveryCode, error) {
+	codes := make([]string, 0, recoveryCodeCount)
+	hashed := make([]*entities.MFARecoveryCode, 0, recoveryCodeCount)
+
+	buf := make([]byte, recoveryCodeBytes)
+	for range recoveryCodeCount {
+		if _, err := rand.Read(buf); err != nil {
+			return nil, nil, err
+		}
+		raw := recoveryEncoding.EncodeToString(buf)
+
+		groups := make([]string, 0, len(raw)/4)
+		for i := 0; i < len(raw); i += 4 {
+			groups = append(groups, raw[i:i+4])
+		}
+		codes = append(codes, strings.Join(groups, "-"))
+		hashed = append(hashed, &entities.MFARecoveryCode{
+			ID:        uuid.New(),
+			CodeHash:  hashResetToken(raw),
+			CreatedAt: now,
+		})
+	}
+	return codes, hashed, nil
+}
+
+// normalizeRecoveryCode drops separators and case, so codes can be typed
+// as handed out or without dashes
+func normalizeRecoveryCode(code string) string {
+	code = strings.ToUpper(code)
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, code)
+}
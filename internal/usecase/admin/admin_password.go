@@ -0,0 +1,246 @@
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"Skillture_Form/internal/auth"
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	uc "Skillture_Form/internal/usecase/interfaces"
+	val "Skillture_Form/internal/validation"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// resetTokenBytes is the entropy of a password reset token
+const resetTokenBytes = 32
+
+// hashPassword hashes a password with the configured bcrypt cost
+func (u *adminUseCase) hashPassword(password string) (string, error) {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), u.settings.BcryptCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hashed), nil
+}
+
+// rehashIfNeeded upgrades the stored hash of a just verified password when
+// the configured bcrypt cost changed. Failures are ignored so they never block
+// a login; the next login tries again.
+func (u *adminUseCase) rehashIfNeeded(ctx context.Context, admin *entities.Admin, password string) {
+	cost, err := bcrypt.Cost([]byte(admin.HashedPassword))
+	if err != nil || cost == u.settings.BcryptCost {
+		return
+	}
+
+	hashed, err := u.hashPassword(password)
+	if err != nil {
+		return
+	}
+	// Only replaces the hash that was verified, never a newer password
+	if replaced, err := u.adminRepo.ReplacePasswordHash(ctx, admin.ID, admin.HashedPassword, hashed); err == nil && replaced {
+		admin.HashedPassword = hashed
+	}
+}
+
+// ChangePassword sets a new password for the admin in ctx after checking
+// their current one. Pending reset tokens and the admin's other sessions are
+// revoked; the session of the request stays logged in.
+func (u *adminUseCase) ChangePassword(ctx context.Context, current, next string) error {
+	adminID, ok := auth.AdminIDFromContext(ctx)
+	if !ok {
+		return domainErr.ErrUnauthenticated
+	}
+	admin, err := u.adminRepo.GetByID(ctx, adminID)
+	if err != nil {
+		return err
+	}
+	if admin == nil {
+		return domainErr.ErrUnauthenticated
+	}
+
+	if bcrypt.CompareHashAndPassword([]byte(admin.HashedPassword), []byte(current)) != nil {
+		return entities.ErrWrongPassword
+	}
+	if current == next {
+		return fmt.Errorf("%w: new password must differ from the current one", entities.ErrWeakPassword)
+	}
+	if err := val.ValidatePassword(u.settings.PasswordPolicy, admin.Username, next); err != nil {
+		return err
+	}
+	if err := u.setPassword(ctx, admin, next); err != nil {
+		return err
+	}
+
+	session, _ := auth.SessionIDFromContext(ctx)
+	if _, err := u.sessionRepo.RevokeAll(ctx, admin.ID, session, enums.SessionRevokedPasswordChanged, time.Now()); err != nil {
+		return err
+	}
+
+	return u.auditRepo.Record(ctx, &entities.AuditEvent{
+		Event:   enums.AuditPasswordChanged,
+		AdminID: &admin.ID,
+		ActorID: &admin.ID,
+		Subject: admin.Username,
+	})
+}
+
+// IssuePasswordReset creates a one-time reset token for an admin, replacing
+// any pending one. Only owners reset passwords; the token is returned once
+// and only its hash is stored.
+func (u *adminUseCase) IssuePasswordReset(ctx context.Context, id uuid.UUID) (*uc.IssuedPasswordReset, error) {
+	actor, err := u.authz.RequireRole(ctx, enums.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	admin, err := u.adminRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if admin == nil {
+		return nil, domainErr.ErrNotFound
+	}
+
+	token, hash, err := newResetToken()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	reset := &entities.PasswordReset{
+		ID:        uuid.New(),
+		AdminID:   admin.ID,
+		TokenHash: hash,
+		CreatedBy: &actor.ID,
+		ExpiresAt: now.Add(u.settings.ResetTTL),
+		CreatedAt: now,
+	}
+
+	if err := u.resetRepo.DeleteUnused(ctx, admin.ID); err != nil {
+		return nil, err
+	}
+	if err := u.resetRepo.Create(ctx, reset); err != nil {
+		return nil, err
+	}
+
+	if err := u.auditRepo.Record(ctx, &entities.AuditEvent{
+		Event:     enums.AuditPasswordResetIssued,
+		AdminID:   &admin.ID,
+		ActorID:   &actor.ID,
+		Subject:   admin.Username,
+		Details:   map[string]any{"expires_at": reset.ExpiresAt},
+		CreatedAt: now,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &uc.IssuedPasswordReset{
+		AdminID:   admin.ID,
+		Token:     token,
+		ExpiresAt: reset.ExpiresAt,
+	}, nil
+}
+
+// ResetPassword sets a new password with a reset token, lifts the account's
+// lockout and revokes all its sessions. A password rejected by the policy leaves the token
+// usable; otherwise the token is used up, even if storing the password fails.
+func (u *adminUseCase) ResetPassword(ctx context.Context, token, password string) error {
+	if token == "" {
+		return entities.ErrInvalidResetToken
+	}
+	hash := hashResetToken(token)
+	now := time.Now()
+
+	// -------------------
+	// 1️⃣ Check the token and the new password
+	// -------------------
+	reset, err := u.resetRepo.Get(ctx, hash, now)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entities.ErrInvalidResetToken
+	}
+	if err != nil {
+		return err
+	}
+
+	admin, err := u.adminRepo.GetByID(ctx, reset.AdminID)
+	if err != nil {
+		return err
+	}
+	if admin == nil {
+		return entities.ErrInvalidResetToken
+	}
+	if err := val.ValidatePassword(u.settings.PasswordPolicy, admin.Username, password); err != nil {
+		return err
+	}
+
+	// -------------------
+	// 2️⃣ Use the token up; only one concurrent request gets it
+	// -------------------
+	if _, err := u.resetRepo.Consume(ctx, hash, now); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return entities.ErrInvalidResetToken
+		}
+		return err
+	}
+
+	// -------------------
+	// 3️⃣ Store the password
+	// -------------------
+	if err := u.setPassword(ctx, admin, password); err != nil {
+		return err
+	}
+	if err := u.attemptRepo.Reset(ctx, enums.LoginScopeUsername, admin.Username); err != nil {
+		return err
+	}
+	if _, err := u.sessionRepo.RevokeAll(ctx, admin.ID, uuid.Nil, enums.SessionRevokedPasswordReset, now); err != nil {
+		return err
+	}
+
+	return u.auditRepo.Record(ctx, &entities.AuditEvent{
+		Event:   enums.AuditPasswordReset,
+		AdminID: &admin.ID,
+		ActorID: reset.CreatedBy,
+		Subject: admin.Username,
+	})
+}
+
+// setPassword stores the hash of an already validated password and
+// discards pending reset tokens
+func (u *adminUseCase) setPassword(ctx context.Context, admin *entities.Admin, password string) error {
+	hashed, err := u.hashPassword(password)
+	if err != nil {
+		return err
+	}
+	if err := u.adminRepo.UpdatePassword(ctx, admin.ID, hashed); err != nil {
+		return err
+	}
+	admin.HashedPassword = hashed
+
+	return u.resetRepo.DeleteUnused(ctx, admin.ID)
+}
+
+// newResetToken generates an opaque reset token and the hash stored for it
+func newResetToken() (token string, hash string, err error) {
+	buf := make([]byte, resetTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(buf)
+	return token, hashResetToken(token), nil
+}
+
+// hashResetToken returns the hex SHA-256 of a reset token
+func hashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
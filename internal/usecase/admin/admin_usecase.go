@@ -5,27 +5,80 @@ import (
 	"errors"
 	"time"
 
+	"Skillture_Form/internal/auth"
 	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
 	repo "Skillture_Form/internal/repository/interfaces"
 	uc "Skillture_Form/internal/usecase/interfaces"
+	val "Skillture_Form/internal/validation"
 
 	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
+// Settings are the login and password rules of the admin use case
+type Settings struct {
+	// A username is locked out for Lockout after MaxLoginAttempts failed
+	// logins within the same period, a client IP after MaxLoginAttemptsPerIP
+	MaxLoginAttempts      int
+	MaxLoginAttemptsPerIP int
+	Lockout               time.Duration
+
+	BcryptCost     int
+	PasswordPolicy val.PasswordPolicy
+	ResetTTL       time.Duration // Lifetime of a password reset token
+
+	MFAIssuer string // Account issuer shown by authenticator apps
+}
+
 type adminUseCase struct {
-	adminRepo repo.AdminRepository
+	adminRepo    repo.AdminRepository
+	attemptRepo  repo.LoginAttemptRepository
+	auditRepo    repo.AuditRepository
+	resetRepo    repo.PasswordResetRepository
+	recoveryRepo repo.MFARecoveryCodeRepository
+	sessionRepo  repo.AdminSessionRepository
+	authz        uc.Authorizer
+	settings     Settings
 }
 
 // NewAdminUseCase creates a new AdminUseCase
-func NewAdminUseCase(adminRepo repo.AdminRepository) uc.AdminUseCase {
+func NewAdminUseCase(
+	adminRepo repo.AdminRepository,
+	attemptRepo repo.LoginAttemptRepository,
+	auditRepo repo.AuditRepository,
+	resetRepo repo.PasswordResetRepository,
+	recoveryRepo repo.MFARecoveryCodeRepository,
+	sessionRepo repo.AdminSessionRepository,
+	authz uc.Authorizer,
+	settings Settings,
+) uc.AdminUseCase {
 	return &adminUseCase{
-		adminRepo: adminRepo,
+		adminRepo:    adminRepo,
+		attemptRepo:  attemptRepo,
+		auditRepo:    auditRepo,
+		resetRepo:    resetRepo,
+		recoveryRepo: recoveryRepo,
+		sessionRepo:  sessionRepo,
+		authz:        authz,
+		settings:     settings,
 	}
 }
 
-// Create creates a new admin with hashed password
+// Create creates a new admin with hashed password.
+// Only owners manage admins; an empty role limits the admin to granted forms.
 func (u *adminUseCase) Create(ctx context.Context, admin *entities.Admin) error {
+	if _, err := u.authz.RequireRole(ctx, enums.RoleOwner); err != nil {
+		return err
+	}
+	if admin.Role != "" && !admin.Role.IsValid() {
+		return entities.ErrInvalidRole
+	}
+	if err := val.ValidatePassword(u.settings.PasswordPolicy, admin.Username, admin.HashedPassword); err != nil {
+		return err
+	}
+
 	// Check if username already exists
 	existing, _ := u.adminRepo.GetByUsername(ctx, admin.Username)
 	if existing != nil {
@@ -38,18 +91,24 @@ func (u *adminUseCase) Create(ctx context.Context, admin *entities.Admin) error
 	}
 
 	// Hash password
-	hashed, err := bcrypt.GenerateFromPassword([]byte(admin.HashedPassword), bcrypt.DefaultCost)
+	hashed, err := u.hashPassword(admin.HashedPassword)
 	if err != nil {
 		return err
 	}
-	admin.HashedPassword = string(hashed)
+	admin.HashedPassword = hashed
 
 	admin.CreatedAt = time.Now()
 
 	return u.adminRepo.Create(ctx, admin)
 }
 
+// GetByID returns an admin to itself or to an owner
 func (u *adminUseCase) GetByID(ctx context.Context, id uuid.UUID) (*entities.Admin, error) {
+	if actorID, ok := auth.AdminIDFromContext(ctx); !ok || actorID != id {
+		if _, err := u.authz.RequireRole(ctx, enums.RoleOwner); err != nil {
+			return nil, err
+		}
+	}
 	return u.adminRepo.GetByID(ctx, id)
 }
 
@@ -58,23 +117,96 @@ func (u *adminUseCase) GetByUsername(ctx context.Context, username string) (*ent
 }
 
 func (u *adminUseCase) List(ctx context.Context) ([]*entities.Admin, error) {
+	if _, err := u.authz.RequireRole(ctx, enums.RoleOwner); err != nil {
+		return nil, err
+	}
 	return u.adminRepo.List(ctx)
 }
 
+// Delete removes another admin after revoking their sessions, so their
+// tokens stop working at once; owners cannot delete themselves
 func (u *adminUseCase) Delete(ctx context.Context, id uuid.UUID) error {
+	actor, err := u.authz.RequireRole(ctx, enums.RoleOwner)
+	if err != nil {
+		return err
+	}
+	if actor.ID == id {
+		return domainErr.ErrForbidden
+	}
+
+	if _, err := u.sessionRepo.RevokeAll(ctx, id, uuid.Nil, enums.SessionRevokedAdminDeleted, time.Now()); err != nil {
+		return err
+	}
 	return u.adminRepo.Delete(ctx, id)
 }
 
-// Authenticate validates an admin login attempt
-func (u *adminUseCase) Authenticate(ctx context.Context, username, password string) (*entities.Admin, error) {
+// SetRole changes the global role of an admin.
+// The empty role removes global access, leaving only per-form grants.
+func (u *adminUseCase) SetRole(ctx context.Context, id uuid.UUID, role enums.AdminRole) (*entities.Admin, error) {
+	actor, err := u.authz.RequireRole(ctx, enums.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+	if role != "" && !role.IsValid() {
+		return nil, entities.ErrInvalidRole
+	}
+
+	// An owner cannot demote itself and leave no one to manage admins
+	if actor.ID == id && role != enums.RoleOwner {
+		return nil, domainErr.ErrForbidden
+	}
+
+	admin, err := u.adminRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if admin == nil {
+		return nil, domainErr.ErrNotFound
+	}
+
+	if err := u.adminRepo.UpdateRole(ctx, admin.ID, role); err != nil {
+		return nil, err
+	}
+	admin.Role = role
+	return admin, nil
+}
+
+// Authenticate validates an admin login attempt from clientIP.
+// Locked out usernames and IPs are refused before the password is checked.
+// For admins using MFA this is the first step; VerifyMFA completes the login.
+func (u *adminUseCase) Authenticate(ctx context.Context, username, password, clientIP string) (*entities.Admin, error) {
+	now := time.Now()
+	keys := u.loginKeys(username, clientIP)
+
+	// -------------------
+	// 1️⃣ Refuse while locked out
+	// -------------------
+	if err := u.checkLockout(ctx, keys, now); err != nil {
+		return nil, err
+	}
+
+	// -------------------
+	// 2️⃣ Check the credentials
+	// -------------------
 	admin, err := u.adminRepo.GetByUsername(ctx, username)
 	if err != nil {
-		return nil, errors.New("invalid username or password")
+		return nil, err
+	}
+	if admin == nil || !admin.CanLogin() ||
+		bcrypt.CompareHashAndPassword([]byte(admin.HashedPassword), []byte(password)) != nil {
+		return nil, u.loginFailed(ctx, admin, keys, clientIP, now)
 	}
 
-	if err := bcrypt.CompareHashAndPassword([]byte(admin.HashedPassword), []byte(password)); err != nil {
-		return nil, errors.New("invalid username or password")
+	// -------------------
+	// 3️⃣ A successful login clears the username's failures; with MFA the
+	// login only succeeds with the second factor, so wrong codes keep counting
+	// -------------------
+	if !admin.MFAEnabled() {
+		if err := u.attemptRepo.Reset(ctx, enums.LoginScopeUsername, username); err != nil {
+			return nil, err
+		}
 	}
 
+	u.rehashIfNeeded(ctx, admin, password)
 	return admin, nil
 }
@@ -0,0 +1,186 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"Skillture_Form/internal/clustering"
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	repo "Skillture_Form/internal/repository/interfaces"
+	uc "Skillture_Form/internal/usecase/interfaces"
+	val "Skillture_Form/internal/validation"
+
+	"github.com/google/uuid"
+)
+
+// analysisUseCase implements the AnalysisUseCase interface
+type analysisUseCase struct {
+	formRepo      repo.FormRepository
+	fieldRepo     repo.FormFieldRepository
+	answerRepo    repo.ResponseAnswerRepository
+	vectorRepo    repo.ResponseAnswerVectorRepository
+	analyticsRepo repo.AnalyticsRepository
+	model         enums.ModelName
+	authz         uc.Authorizer
+}
+
+// NewAnalysisUseCase creates an AnalysisUseCase clustering vectors of the given embedding model
+func NewAnalysisUseCase(
+	formRepo repo.FormRepository,
+	fieldRepo repo.FormFieldRepository,
+	answerRepo repo.ResponseAnswerRepository,
+	vectorRepo repo.ResponseAnswerVectorRepository,
+	analyticsRepo repo.AnalyticsRepository,
+	model enums.ModelName,
+	authz uc.Authorizer,
+) uc.AnalysisUseCase {
+	return &analysisUseCase{
+		formRepo:      formRepo,
+		fieldRepo:     fieldRepo,
+		answerRepo:    answerRepo,
+		vectorRepo:    vectorRepo,
+		analyticsRepo: analyticsRepo,
+		model:         model,
+		authz:         authz,
+	}
+}
+
+// FieldThemes groups a field's answers with k-means on their embeddings
+func (u *analysisUseCase) FieldThemes(
+	ctx context.Context,
+	fieldID uuid.UUID,
+	k, representatives int,
+) (*entities.FieldThemes, error) {
+
+	// -------------------
+	// 1️⃣ Validate input
+	// -------------------
+	if k > uc.MaxThemes {
+		return nil, fmt.Errorf("%w: at most %d themes can be extracted", domainErr.ErrInvalidInput, uc.MaxThemes)
+	}
+	if representatives < 1 {
+		representatives = uc.DefaultThemeRepresentatives
+	}
+	representatives = min(representatives, uc.MaxThemeRepresentatives)
+
+	field, err := u.fieldRepo.GetByID(ctx, fieldID)
+	if err != nil {
+		return nil, err
+	}
+	if field == nil {
+		return nil, domainErr.ErrNotFound
+	}
+	if _, err := u.authz.RequireForm(ctx, field.FormID, enums.RoleViewer); err != nil {
+		return nil, err
+	}
+	if field.Type != enums.FieldTypeText && field.Type != enums.FieldTypeTextarea {
+		return nil, fmt.Errorf("%w: themes are only extracted from text and textarea fields", domainErr.ErrInvalidInput)
+	}
+
+	// -------------------
+	// 2️⃣ Load the embedded answers
+	// -------------------
+	answers, err := u.vectorRepo.ListFieldEmbeddings(ctx, fieldID, u.model, uc.MaxThemeAnswers)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &entities.FieldThemes{
+		FieldID:     fieldID,
+		Model:       u.model,
+		Total:       len(answers),
+		Themes:      []*entities.Theme{},
+		Assignments: []entities.ThemeAssignment{},
+	}
+	if len(answers) == 0 {
+		return result, nil
+	}
+
+	// -------------------
+	// 3️⃣ Cluster
+	// -------------------
+	if k <= 0 {
+		k = suggestThemeCount(len(answers))
+	}
+
+	vectors := make([][]float32, len(answers))
+	for i, a := range answers {
+		vectors[i] = a.Embedding
+	}
+
+	clusters, err := clustering.KMeans(vectors, k, clustering.Options{Seed: clustering.DefaultSeed})
+	if err != nil {
+		return nil, err
+	}
+
+	// -------------------
+	// 4️⃣ Build themes, largest first
+	// -------------------
+	members := make([][]int, clusters.K)
+	for i, c := range clusters.Assignments {
+		members[c] = append(members[c], i)
+	}
+
+	order := make([]int, clusters.K)
+	for c := range order {
+		order[c] = c
+	}
+	sort.SliceStable(order, func(i, j int) bool { return len(members[order[i]]) > len(members[order[j]]) })
+
+	themeIDs := make([]int, clusters.K)
+	for rank, c := range order {
+		themeIDs[c] = rank + 1
+
+		// Closest answers to the centroid first
+		idx := members[c]
+		sort.SliceStable(idx, func(i, j int) bool {
+			return clusters.Similarities[idx[i]] > clusters.Similarities[idx[j]]
+		})
+
+		theme := &entities.Theme{
+			ID:    rank + 1,
+			Size:  len(idx),
+			Share: round(float64(len(idx)) / float64(len(answers))),
+		}
+		cohesion := 0.0
+		for n, i := range idx {
+			cohesion += clusters.Similarities[i]
+			if n < representatives {
+				theme.Representatives = append(theme.Representatives, entities.ThemeAnswer{
+					ResponseID: answers[i].Answer.ResponseID,
+					AnswerID:   answers[i].Answer.ID,
+					Text:       val.AnswerText(answers[i].Answer),
+					Similarity: round(clusters.Similarities[i]),
+				})
+			}
+		}
+		theme.Cohesion = round(cohesion / float64(len(idx)))
+		result.Themes = append(result.Themes, theme)
+	}
+
+	for i, a := range answers {
+		result.Assignments = append(result.Assignments, entities.ThemeAssignment{
+			ResponseID: a.Answer.ResponseID,
+			AnswerID:   a.Answer.ID,
+			ThemeID:    themeIDs[clusters.Assignments[i]],
+			Similarity: round(clusters.Similarities[i]),
+		})
+	}
+
+	return result, nil
+}
+
+// suggestThemeCount applies the sqrt(n/2) rule of thumb, capped at 10 themes
+func suggestThemeCount(n int) int {
+	k := int(math.Round(math.Sqrt(float64(n) / 2)))
+	return max(1, min(k, 10))
+}
+
+// round keeps 4 decimals of a ratio or similarity
+func round(v float64) float64 {
+	return math.Round(v*10000) / 10000
+}
@@ -0,0 +1,152 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	repo "Skillture_Form/internal/repository/interfaces"
+	uc "Skillture_Form/internal/usecase/interfaces"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeVectorRepo returns canned embeddings and records how they were requested
+type fakeVectorRepo struct {
+	repo.ResponseAnswerVectorRepository
+	answers []*entities.EmbeddedAnswer
+
+	calls int
+	model enums.ModelName
+	limit int
+}
+
+func (f *fakeVectorRepo) ListFieldEmbeddings(_ context.Context, _ uuid.UUID, model enums.ModelName, limit int) ([]*entities.EmbeddedAnswer, error) {
+	f.calls++
+	f.model, f.limit = model, limit
+	return f.answers, nil
+}
+
+func newThemesUseCase(fields []*entities.FormField, vectors *fakeVectorRepo, authz *fakeAuthorizer) uc.AnalysisUseCase {
+	return NewAnalysisUseCase(nil, &fakeFieldRepo{fields: fields}, nil, vectors, nil, enums.ModelLocalHashing, authz)
+}
+
+func embeddedAnswer(text string, embedding ...float32) *entities.EmbeddedAnswer {
+	return &entities.EmbeddedAnswer{
+		Answer: &entities.ResponseAnswer{
+			ID:         uuid.New(),
+			ResponseID: uuid.New(),
+			FieldType:  enums.FieldTypeText,
+			Value:      map[string]any{"text": text},
+		},
+		Embedding: embedding,
+	}
+}
+
+func TestFieldThemes_Rejects(t *testing.T) {
+	text := &entities.FormField{ID: uuid.New(), FormID: uuid.New(), Type: enums.FieldTypeTextarea}
+	number := &entities.FormField{ID: uuid.New(), FormID: uuid.New(), Type: enums.FieldTypeNumber}
+
+	tests := []struct {
+		name    string
+		fieldID uuid.UUID
+		k       int
+		authz   *fakeAuthorizer
+		want    error
+	}{
+		{"too many themes", text.ID, uc.MaxThemes + 1, &fakeAuthorizer{}, domainErr.ErrInvalidInput},
+		{"unknown field", uuid.New(), 0, &fakeAuthorizer{}, domainErr.ErrNotFound},
+		{"form not readable", text.ID, 0, &fakeAuthorizer{err: domainErr.ErrForbidden}, domainErr.ErrForbidden},
+		{"not a text field", number.ID, 0, &fakeAuthorizer{}, domainErr.ErrInvalidInput},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vectors := &fakeVectorRepo{answers: []*entities.EmbeddedAnswer{embeddedAnswer("a", 1, 0)}}
+			u := newThemesUseCase([]*entities.FormField{text, number}, vectors, tt.authz)
+
+			_, err := u.FieldThemes(context.Background(), tt.fieldID, tt.k, 0)
+			require.ErrorIs(t, err, tt.want)
+			require.Zero(t, vectors.calls)
+		})
+	}
+}
+
+func TestFieldThemes_NoAnswers(t *testing.T) {
+	field := &entities.FormField{ID: uuid.New(), FormID: uuid.New(), Type: enums.FieldTypeText}
+	vectors := &fakeVectorRepo{}
+
+	result, err := newThemesUseCase([]*entities.FormField{field}, vectors, &fakeAuthorizer{}).
+		FieldThemes(context.Background(), field.ID, 3, 0)
+	require.NoError(t, err)
+	require.Equal(t, enums.ModelLocalHashing, vectors.model)
+	require.Equal(t, uc.MaxThemeAnswers, vectors.limit)
+
+	require.Zero(t, result.Total)
+	require.NotNil(t, result.Themes)
+	require.Empty(t, result.Themes)
+	require.NotNil(t, result.Assignments)
+	require.Empty(t, result.Assignments)
+}
+
+func TestFieldThemes_FewerAnswersThanThemes(t *testing.T) {
+	field := &entities.FormField{ID: uuid.New(), FormID: uuid.New(), Type: enums.FieldTypeText}
+	vectors := &fakeVectorRepo{answers: []*entities.EmbeddedAnswer{
+		embeddedAnswer("late delivery", 1, 0),
+		embeddedAnswer("great support", 0, 1),
+	}}
+
+	result, err := newThemesUseCase([]*entities.FormField{field}, vectors, &fakeAuthorizer{}).
+		FieldThemes(context.Background(), field.ID, 5, 0)
+	require.NoError(t, err)
+	require.Equal(t, 2, result.Total)
+	require.Len(t, result.Themes, 2, "k is lowered to the number of answers")
+	require.Len(t, result.Assignments, 2)
+	for _, theme := range result.Themes {
+		require.Equal(t, 1, theme.Size)
+		require.Equal(t, 0.5, theme.Share)
+	}
+}
+
+func TestFieldThemes_Clusters(t *testing.T) {
+	field := &entities.FormField{ID: uuid.New(), FormID: uuid.New(), Type: enums.FieldTypeText}
+	answers := []*entities.EmbeddedAnswer{
+		embeddedAnswer("late delivery", 1, 0.05),
+		embeddedAnswer("great support", 0, 1),
+		embeddedAnswer("delivery was late", 1, 0),
+		embeddedAnswer("slow shipping", 1, 0.1),
+		embeddedAnswer("helpful support", 0.05, 1),
+		embeddedAnswer("late again", 1, 0.02),
+	}
+	vectors := &fakeVectorRepo{answers: answers}
+
+	// k=0 suggests sqrt(6/2) ≈ 2 themes
+	result, err := newThemesUseCase([]*entities.FormField{field}, vectors, &fakeAuthorizer{}).
+		FieldThemes(context.Background(), field.ID, 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, 6, result.Total)
+	require.Len(t, result.Themes, 2)
+
+	// Largest theme first, one representative each
+	delivery, support := result.Themes[0], result.Themes[1]
+	require.Equal(t, 1, delivery.ID)
+	require.Equal(t, 4, delivery.Size)
+	require.Equal(t, 0.6667, delivery.Share)
+	require.Equal(t, 2, support.Size)
+	require.Len(t, delivery.Representatives, 1)
+	require.Len(t, support.Representatives, 1)
+	require.Contains(t, []string{"late delivery", "delivery was late", "slow shipping", "late again"}, delivery.Representatives[0].Text)
+	require.Contains(t, []string{"great support", "helpful support"}, support.Representatives[0].Text)
+
+	// Assignments follow the answer order
+	require.Len(t, result.Assignments, len(answers))
+	for i, a := range result.Assignments {
+		require.Equal(t, answers[i].Answer.ID, a.AnswerID)
+	}
+	wantThemes := []int{1, 2, 1, 1, 2, 1}
+	for i, a := range result.Assignments {
+		require.Equal(t, wantThemes[i], a.ThemeID, answers[i].Answer.Value)
+	}
+}
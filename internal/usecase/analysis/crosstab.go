@@ -0,0 +1,171 @@
+package analysis
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	"Skillture_Form/internal/stats"
+
+	"github.com/google/uuid"
+)
+
+// chiSquareCheckboxNote explains the missing test for checkbox fields
+const chiSquareCheckboxNote = "not computed for checkbox fields: one response can fall in several cells"
+
+// Crosstab cross-tabulates two choice fields of a form and tests them for
+// independence. The test assumes every response falls in exactly one cell,
+// so it is skipped when either field is a checkbox.
+func (u *analysisUseCase) Crosstab(
+	ctx context.Context,
+	formID, rowFieldID, columnFieldID uuid.UUID,
+	lang string,
+) (*entities.Crosstab, error) {
+
+	// -------------------
+	// 1️⃣ Validate input
+	// -------------------
+	if lang == "" {
+		lang = "en"
+	}
+	if rowFieldID == columnFieldID {
+		return nil, fmt.Errorf("%w: row and column must be different fields", domainErr.ErrInvalidInput)
+	}
+
+	if _, err := u.authz.RequireForm(ctx, formID, enums.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	form, err := u.formRepo.GetByID(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	rowField, err := u.crosstabField(ctx, form.ID, rowFieldID)
+	if err != nil {
+		return nil, err
+	}
+	columnField, err := u.crosstabField(ctx, form.ID, columnFieldID)
+	if err != nil {
+		return nil, err
+	}
+
+	// -------------------
+	// 2️⃣ Count option pairs in the database
+	// -------------------
+	pairs, respondents, err := u.answerRepo.CountSelectionPairs(ctx, form.ID, rowField.ID, columnField.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rowCounts := make(map[string]int)
+	columnCounts := make(map[string]int)
+	for _, p := range pairs {
+		rowCounts[p.RowKey] += p.Count
+		columnCounts[p.ColumnKey] += p.Count
+	}
+	rowKeys := answerKeys(rowField, rowCounts)
+	columnKeys := answerKeys(columnField, columnCounts)
+
+	rowIndex := indexOf(rowKeys)
+	columnIndex := indexOf(columnKeys)
+	observed := make([][]int, len(rowKeys))
+	for i := range observed {
+		observed[i] = make([]int, len(columnKeys))
+	}
+	for _, p := range pairs {
+		observed[rowIndex[p.RowKey]][columnIndex[p.ColumnKey]] += p.Count
+	}
+
+	// -------------------
+	// 3️⃣ Build the table
+	// -------------------
+	result := &entities.Crosstab{
+		FormID:        form.ID,
+		RowFieldID:    rowField.ID,
+		ColumnFieldID: columnField.ID,
+		Language:      lang,
+		Respondents:   respondents,
+		Rows:          make([]entities.CrosstabCategory, len(rowKeys)),
+		Columns:       make([]entities.CrosstabCategory, len(columnKeys)),
+		Cells:         make([][]entities.CrosstabCell, len(rowKeys)),
+	}
+	for i, key := range rowKeys {
+		result.Rows[i] = entities.CrosstabCategory{Key: key, Label: rowField.GetOptionLabel(key, lang), Total: rowCounts[key]}
+		result.Total += rowCounts[key]
+	}
+	for j, key := range columnKeys {
+		result.Columns[j] = entities.CrosstabCategory{Key: key, Label: columnField.GetOptionLabel(key, lang), Total: columnCounts[key]}
+	}
+
+	var (
+		chi *stats.ChiSquareResult
+		ok  bool
+	)
+	if rowField.Type == enums.FieldTypeCheckbox || columnField.Type == enums.FieldTypeCheckbox {
+		result.ChiSquareNote = chiSquareCheckboxNote
+	} else {
+		chi, ok = stats.ChiSquareTest(observed)
+	}
+	if ok {
+		chi.Statistic = round(chi.Statistic)
+		chi.PValue = round(chi.PValue)
+		chi.CramersV = round(chi.CramersV)
+		result.ChiSquare = chi
+	}
+
+	for i, row := range observed {
+		result.Cells[i] = make([]entities.CrosstabCell, len(row))
+		for j, n := range row {
+			cell := entities.CrosstabCell{
+				Count:         n,
+				RowPercent:    percent(n, result.Rows[i].Total),
+				ColumnPercent: percent(n, result.Columns[j].Total),
+				TotalPercent:  percent(n, result.Total),
+			}
+			if ok {
+				cell.Expected = round(chi.Expected[i][j])
+			}
+			result.Cells[i][j] = cell
+		}
+	}
+
+	return result, nil
+}
+
+// crosstabField loads a field of the form that can be cross-tabulated
+func (u *analysisUseCase) crosstabField(ctx context.Context, formID, fieldID uuid.UUID) (*entities.FormField, error) {
+	field, err := u.fieldRepo.GetByID(ctx, fieldID)
+	if err != nil {
+		return nil, err
+	}
+	if field == nil || field.FormID != formID {
+		return nil, domainErr.ErrNotFound
+	}
+
+	switch field.Type {
+	case enums.FieldTypeSelect, enums.FieldTypeRadio, enums.FieldTypeCheckbox:
+		return field, nil
+	default:
+		return nil, fmt.Errorf("%w: only select, radio and checkbox fields can be cross-tabulated", domainErr.ErrInvalidInput)
+	}
+}
+
+func indexOf(keys []string) map[string]int {
+	index := make(map[string]int, len(keys))
+	for i, key := range keys {
+		index[key] = i
+	}
+	return index
+}
+
+// percent returns part / total as a percentage with 2 decimals, 0 without a total
+func percent(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(part)/float64(total)*10000) / 100
+}
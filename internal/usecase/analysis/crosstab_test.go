@@ -0,0 +1,106 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	repo "Skillture_Form/internal/repository/interfaces"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAnswerRepo struct {
+	repo.ResponseAnswerRepository
+	pairs       []entities.SelectionPair
+	respondents int
+}
+
+func (f *fakeAnswerRepo) CountSelectionPairs(context.Context, uuid.UUID, uuid.UUID, uuid.UUID) ([]entities.SelectionPair, int, error) {
+	return f.pairs, f.respondents, nil
+}
+
+func newCrosstabUseCase(form *entities.Form, fields []*entities.FormField, answers *fakeAnswerRepo) *analysisUseCase {
+	return NewAnalysisUseCase(
+		&fakeFormRepo{form: form},
+		&fakeFieldRepo{fields: fields},
+		answers, nil, nil,
+		enums.ModelLocalHashing,
+		&fakeAuthorizer{},
+	).(*analysisUseCase)
+}
+
+func choiceField(formID uuid.UUID, fieldType enums.FieldType, keys ...string) *entities.FormField {
+	options := make(map[string]any, len(keys))
+	for _, key := range keys {
+		options[key] = key
+	}
+	return &entities.FormField{ID: uuid.New(), FormID: formID, Type: fieldType, Options: options}
+}
+
+func TestCrosstab_ChiSquare(t *testing.T) {
+	form := &entities.Form{ID: uuid.New()}
+	pairs := []entities.SelectionPair{
+		{RowKey: "a", ColumnKey: "x", Count: 30},
+		{RowKey: "a", ColumnKey: "y", Count: 10},
+		{RowKey: "b", ColumnKey: "x", Count: 10},
+		{RowKey: "b", ColumnKey: "y", Count: 30},
+	}
+
+	tests := []struct {
+		name        string
+		rowType     enums.FieldType
+		columnType  enums.FieldType
+		respondents int
+		wantTest    bool
+	}{
+		{"radio by select", enums.FieldTypeRadio, enums.FieldTypeSelect, 80, true},
+		{"checkbox rows", enums.FieldTypeCheckbox, enums.FieldTypeRadio, 55, false},
+		{"checkbox columns", enums.FieldTypeSelect, enums.FieldTypeCheckbox, 55, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			row := choiceField(form.ID, tt.rowType, "a", "b")
+			column := choiceField(form.ID, tt.columnType, "x", "y")
+			u := newCrosstabUseCase(form, []*entities.FormField{row, column},
+				&fakeAnswerRepo{pairs: pairs, respondents: tt.respondents})
+
+			result, err := u.Crosstab(context.Background(), form.ID, row.ID, column.ID, "")
+			require.NoError(t, err)
+			require.Equal(t, 80, result.Total)
+			require.Equal(t, tt.respondents, result.Respondents)
+			require.Equal(t, 30, result.Cells[0][0].Count)
+			require.Equal(t, 75.0, result.Cells[0][0].RowPercent)
+
+			if tt.wantTest {
+				require.NotNil(t, result.ChiSquare)
+				require.Empty(t, result.ChiSquareNote)
+				require.Equal(t, 20.0, result.Cells[0][0].Expected)
+			} else {
+				require.Nil(t, result.ChiSquare)
+				require.Equal(t, chiSquareCheckboxNote, result.ChiSquareNote)
+				require.Zero(t, result.Cells[0][0].Expected)
+			}
+		})
+	}
+}
+
+func TestCrosstab_Fields(t *testing.T) {
+	form := &entities.Form{ID: uuid.New()}
+	row := choiceField(form.ID, enums.FieldTypeRadio, "a")
+	text := &entities.FormField{ID: uuid.New(), FormID: form.ID, Type: enums.FieldTypeText}
+	foreign := choiceField(uuid.New(), enums.FieldTypeRadio, "a")
+	u := newCrosstabUseCase(form, []*entities.FormField{row, text, foreign}, &fakeAnswerRepo{})
+
+	_, err := u.Crosstab(context.Background(), form.ID, row.ID, row.ID, "en")
+	require.ErrorIs(t, err, domainErr.ErrInvalidInput, "same field twice")
+
+	_, err = u.Crosstab(context.Background(), form.ID, row.ID, text.ID, "en")
+	require.ErrorIs(t, err, domainErr.ErrInvalidInput, "not a choice field")
+
+	_, err = u.Crosstab(context.Background(), form.ID, row.ID, foreign.ID, "en")
+	require.ErrorIs(t, err, domainErr.ErrNotFound, "field of another form")
+}
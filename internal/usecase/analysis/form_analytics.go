@@ -0,0 +1,153 @@
+package analysis
+
+import (
+	"context"
+	"sort"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	repo "Skillture_Form/internal/repository/interfaces"
+	uc "Skillture_Form/internal/usecase/interfaces"
+
+	"github.com/google/uuid"
+)
+
+// FormAnalytics combines the database aggregates with the form's field definitions
+func (u *analysisUseCase) FormAnalytics(
+	ctx context.Context,
+	formID uuid.UUID,
+	lang string,
+	buckets int,
+) (*entities.FormAnalytics, error) {
+
+	// -------------------
+	// 1️⃣ Validate input
+	// -------------------
+	if lang == "" {
+		lang = "en"
+	}
+	if buckets < 1 {
+		buckets = uc.DefaultHistogramBuckets
+	}
+	buckets = min(buckets, uc.MaxHistogramBuckets)
+
+	if _, err := u.authz.RequireForm(ctx, formID, enums.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	form, err := u.formRepo.GetByID(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := u.fieldRepo.List(ctx, repo.FormFieldFilter{FormID: &form.ID})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(fields, func(i, j int) bool { return fields[i].FieldOrder < fields[j].FieldOrder })
+
+	// -------------------
+	// 2️⃣ Aggregate in the database
+	// -------------------
+	submissions, err := u.analyticsRepo.SubmissionsPerDay(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+	answered, err := u.analyticsRepo.AnswerCounts(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+	options, err := u.analyticsRepo.OptionCounts(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+	numbers, err := u.analyticsRepo.NumberStats(ctx, formID, buckets)
+	if err != nil {
+		return nil, err
+	}
+	dates, err := u.analyticsRepo.DateCounts(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	// -------------------
+	// 3️⃣ Build per-field summaries
+	// -------------------
+	result := &entities.FormAnalytics{
+		FormID:      formID,
+		Language:    lang,
+		Submissions: submissions,
+		Fields:      make([]*entities.FieldAnalytics, 0, len(fields)),
+	}
+	for _, day := range submissions {
+		result.Responses += day.Count
+	}
+
+	for _, f := range fields {
+		fa := &entities.FieldAnalytics{
+			FieldID:  f.ID,
+			Type:     f.Type,
+			Label:    f.GetLabel(lang),
+			Answered: answered[f.ID],
+		}
+		// Counts come from separate queries; a concurrent submission must not make skips negative
+		fa.Skipped = max(result.Responses-fa.Answered, 0)
+		fa.SkipRate = ratio(fa.Skipped, result.Responses)
+
+		switch f.Type {
+		case enums.FieldTypeSelect, enums.FieldTypeRadio, enums.FieldTypeCheckbox:
+			fa.Options = optionCounts(f, options[f.ID], fa.Answered, lang)
+		case enums.FieldTypeNumber:
+			fa.Number = numbers[f.ID]
+		case enums.FieldTypeDate:
+			fa.Dates = dates[f.ID]
+			if fa.Dates == nil {
+				fa.Dates = []entities.DailyCount{}
+			}
+		}
+
+		result.Fields = append(result.Fields, fa)
+	}
+
+	return result, nil
+}
+
+// optionCounts lists every option of the field, including unselected ones, followed
+// by keys that are no longer options of the field (e.g. removed after answers came in)
+func optionCounts(f *entities.FormField, counts map[string]int, answered int, lang string) []entities.OptionCount {
+	keys := answerKeys(f, counts)
+	out := make([]entities.OptionCount, 0, len(keys))
+	for _, key := range keys {
+		out = append(out, entities.OptionCount{
+			Key:   key,
+			Label: f.GetOptionLabel(key, lang), // Removed options are labeled with their key
+			Count: counts[key],
+			Share: ratio(counts[key], answered),
+		})
+	}
+	return out
+}
+
+// answerKeys lists the field's options followed by the answered keys that are
+// no longer options, both sorted
+func answerKeys(f *entities.FormField, counts map[string]int) []string {
+	keys := f.OptionKeys()
+
+	var removed []string
+	for key := range counts {
+		if !f.HasOption(key) {
+			removed = append(removed, key)
+		}
+	}
+	sort.Strings(removed)
+
+	return append(keys, removed...)
+}
+
+// ratio returns part / total rounded like other analysis figures, 0 without a total
+func ratio(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return round(float64(part) / float64(total))
+}
@@ -0,0 +1,269 @@
+package analysis
+
+import (
+	"context"
+	"testing"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	repo "Skillture_Form/internal/repository/interfaces"
+	uc "Skillture_Form/internal/usecase/interfaces"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAuthorizer struct {
+	uc.Authorizer
+	err error
+}
+
+func (f *fakeAuthorizer) RequireForm(context.Context, uuid.UUID, enums.AdminRole) (*entities.Admin, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &entities.Admin{ID: uuid.New()}, nil
+}
+
+type fakeFormRepo struct {
+	repo.FormRepository
+	form *entities.Form
+}
+
+func (f *fakeFormRepo) GetByID(context.Context, uuid.UUID) (*entities.Form, error) {
+	return f.form, nil
+}
+
+type fakeFieldRepo struct {
+	repo.FormFieldRepository
+	fields []*entities.FormField
+}
+
+func (f *fakeFieldRepo) List(context.Context, repo.FormFieldFilter) ([]*entities.FormField, error) {
+	return f.fields, nil
+}
+
+func (f *fakeFieldRepo) GetByID(_ context.Context, id uuid.UUID) (*entities.FormField, error) {
+	for _, field := range f.fields {
+		if field.ID == id {
+			return field, nil
+		}
+	}
+	return nil, nil
+}
+
+// fakeAnalyticsRepo returns canned aggregates and records the requested buckets
+type fakeAnalyticsRepo struct {
+	submissions []entities.DailyCount
+	answered    map[uuid.UUID]int
+	options     map[uuid.UUID]map[string]int
+	numbers     map[uuid.UUID]*entities.NumberStats
+	dates       map[uuid.UUID][]entities.DailyCount
+
+	buckets int
+}
+
+func (f *fakeAnalyticsRepo) SubmissionsPerDay(context.Context, uuid.UUID) ([]entities.DailyCount, error) {
+	return f.submissions, nil
+}
+
+func (f *fakeAnalyticsRepo) AnswerCounts(context.Context, uuid.UUID) (map[uuid.UUID]int, error) {
+	return f.answered, nil
+}
+
+func (f *fakeAnalyticsRepo) OptionCounts(context.Context, uuid.UUID) (map[uuid.UUID]map[string]int, error) {
+	return f.options, nil
+}
+
+func (f *fakeAnalyticsRepo) NumberStats(_ context.Context, _ uuid.UUID, buckets int) (map[uuid.UUID]*entities.NumberStats, error) {
+	f.buckets = buckets
+	return f.numbers, nil
+}
+
+func (f *fakeAnalyticsRepo) DateCounts(context.Context, uuid.UUID) (map[uuid.UUID][]entities.DailyCount, error) {
+	return f.dates, nil
+}
+
+func newAnalyticsUseCase(form *entities.Form, fields []*entities.FormField, analytics *fakeAnalyticsRepo) uc.AnalysisUseCase {
+	return NewAnalysisUseCase(
+		&fakeFormRepo{form: form},
+		&fakeFieldRepo{fields: fields},
+		nil, nil,
+		analytics,
+		enums.ModelLocalHashing,
+		&fakeAuthorizer{},
+	)
+}
+
+func TestOptionCounts(t *testing.T) {
+	field := &entities.FormField{
+		Type: enums.FieldTypeRadio,
+		Options: map[string]any{
+			"yes": map[string]any{"en": "Yes", "ar": "نعم"},
+			"no":  "No",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		counts   map[string]int
+		answered int
+		lang     string
+		want     []entities.OptionCount
+	}{
+		{
+			name:     "unselected options are listed",
+			counts:   map[string]int{"yes": 3},
+			answered: 4,
+			lang:     "en",
+			want: []entities.OptionCount{
+				{Key: "no", Label: "No", Count: 0, Share: 0},
+				{Key: "yes", Label: "Yes", Count: 3, Share: 0.75},
+			},
+		},
+		{
+			name:     "removed option keys follow, sorted and labeled with the key",
+			counts:   map[string]int{"yes": 1, "zebra": 1, "maybe": 1},
+			answered: 3,
+			lang:     "ar",
+			want: []entities.OptionCount{
+				{Key: "no", Label: "No", Count: 0, Share: 0},
+				{Key: "yes", Label: "نعم", Count: 1, Share: 0.3333},
+				{Key: "maybe", Label: "maybe", Count: 1, Share: 0.3333},
+				{Key: "zebra", Label: "zebra", Count: 1, Share: 0.3333},
+			},
+		},
+		{
+			name:     "no answers",
+			counts:   nil,
+			answered: 0,
+			lang:     "en",
+			want: []entities.OptionCount{
+				{Key: "no", Label: "No"},
+				{Key: "yes", Label: "Yes"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, optionCounts(field, tt.counts, tt.answered, tt.lang))
+		})
+	}
+}
+
+func TestAnswerKeys(t *testing.T) {
+	field := &entities.FormField{Options: map[string]any{"b": "B", "a": "A"}}
+
+	require.Equal(t, []string{"a", "b"}, answerKeys(field, nil))
+	require.Equal(t, []string{"a", "b", "c", "d"}, answerKeys(field, map[string]int{"d": 1, "a": 2, "c": 1}))
+}
+
+func TestFormAnalytics_Skipped(t *testing.T) {
+	form := &entities.Form{ID: uuid.New()}
+	field := &entities.FormField{ID: uuid.New(), Type: enums.FieldTypeText, Label: map[string]string{"en": "Name"}}
+
+	tests := []struct {
+		name        string
+		responses   int
+		answered    int
+		wantSkipped int
+		wantRate    float64
+	}{
+		{"some skipped", 3, 1, 2, 0.6667},
+		{"all answered", 3, 3, 0, 0},
+		{"no responses", 0, 0, 0, 0},
+		// A submission committed between the two queries
+		{"more answers than responses is clamped", 3, 4, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analytics := &fakeAnalyticsRepo{answered: map[uuid.UUID]int{field.ID: tt.answered}}
+			if tt.responses > 0 {
+				analytics.submissions = []entities.DailyCount{{Date: "2026-01-01", Count: tt.responses}}
+			}
+
+			result, err := newAnalyticsUseCase(form, []*entities.FormField{field}, analytics).
+				FormAnalytics(context.Background(), form.ID, "", 0)
+			require.NoError(t, err)
+			require.Equal(t, tt.responses, result.Responses)
+			require.Len(t, result.Fields, 1)
+
+			fa := result.Fields[0]
+			require.Equal(t, tt.answered, fa.Answered)
+			require.Equal(t, tt.wantSkipped, fa.Skipped)
+			require.Equal(t, tt.wantRate, fa.SkipRate)
+			require.GreaterOrEqual(t, fa.SkipRate, 0.0)
+			require.LessOrEqual(t, fa.SkipRate, 1.0)
+		})
+	}
+}
+
+func TestFormAnalytics_Buckets(t *testing.T) {
+	form := &entities.Form{ID: uuid.New()}
+
+	tests := []struct {
+		name    string
+		buckets int
+		want    int
+	}{
+		{"zero uses the default", 0, uc.DefaultHistogramBuckets},
+		{"negative uses the default", -4, uc.DefaultHistogramBuckets},
+		{"within range", 7, 7},
+		{"maximum", uc.MaxHistogramBuckets, uc.MaxHistogramBuckets},
+		{"above the maximum", uc.MaxHistogramBuckets + 1, uc.MaxHistogramBuckets},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			analytics := &fakeAnalyticsRepo{}
+			_, err := newAnalyticsUseCase(form, nil, analytics).
+				FormAnalytics(context.Background(), form.ID, "en", tt.buckets)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, analytics.buckets)
+		})
+	}
+}
+
+func TestFormAnalytics_Fields(t *testing.T) {
+	form := &entities.Form{ID: uuid.New()}
+	choice := &entities.FormField{
+		ID: uuid.New(), FieldOrder: 3, Type: enums.FieldTypeCheckbox,
+		Label:   map[string]string{"en": "Topics", "ar": "المواضيع"},
+		Options: map[string]any{"go": "Go", "sql": "SQL"},
+	}
+	number := &entities.FormField{ID: uuid.New(), FieldOrder: 1, Type: enums.FieldTypeNumber, Label: map[string]string{"en": "Age"}}
+	date := &entities.FormField{ID: uuid.New(), FieldOrder: 2, Type: enums.FieldTypeDate, Label: map[string]string{"en": "Start"}}
+	stats := &entities.NumberStats{Count: 2, Min: 20, Max: 40, Mean: 30, Median: 30}
+
+	analytics := &fakeAnalyticsRepo{
+		submissions: []entities.DailyCount{{Date: "2026-01-01", Count: 2}, {Date: "2026-01-02", Count: 2}},
+		answered:    map[uuid.UUID]int{choice.ID: 2, number.ID: 2},
+		options:     map[uuid.UUID]map[string]int{choice.ID: {"go": 2, "rust": 1}},
+		numbers:     map[uuid.UUID]*entities.NumberStats{number.ID: stats},
+	}
+
+	result, err := newAnalyticsUseCase(form, []*entities.FormField{choice, number, date}, analytics).
+		FormAnalytics(context.Background(), form.ID, "ar", 5)
+	require.NoError(t, err)
+	require.Equal(t, "ar", result.Language)
+	require.Equal(t, 4, result.Responses)
+
+	// Ordered by FieldOrder
+	require.Len(t, result.Fields, 3)
+	require.Equal(t, []uuid.UUID{number.ID, date.ID, choice.ID},
+		[]uuid.UUID{result.Fields[0].FieldID, result.Fields[1].FieldID, result.Fields[2].FieldID})
+
+	require.Equal(t, stats, result.Fields[0].Number)
+	require.Nil(t, result.Fields[0].Options)
+
+	require.NotNil(t, result.Fields[1].Dates, "date fields without answers have an empty list")
+	require.Empty(t, result.Fields[1].Dates)
+	require.Equal(t, 4, result.Fields[1].Skipped)
+	require.Equal(t, 1.0, result.Fields[1].SkipRate)
+
+	require.Equal(t, "المواضيع", result.Fields[2].Label)
+	require.Equal(t, []entities.OptionCount{
+		{Key: "go", Label: "Go", Count: 2, Share: 1},
+		{Key: "sql", Label: "SQL", Count: 0, Share: 0},
+		{Key: "rust", Label: "rust", Count: 1, Share: 0.5},
+	}, result.Fields[2].Options)
+}
@@ -0,0 +1,103 @@
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"Skillture_Form/internal/auth"
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	repo "Skillture_Form/internal/repository/interfaces"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAdminRepo struct {
+	repo.AdminRepository
+	admins map[uuid.UUID]*entities.Admin
+}
+
+func (f *fakeAdminRepo) GetByID(_ context.Context, id uuid.UUID) (*entities.Admin, error) {
+	return f.admins[id], nil
+}
+
+type fakeGrantRepo struct {
+	repo.FormGrantRepository
+	grants map[[2]uuid.UUID]enums.AdminRole
+}
+
+func (f *fakeGrantRepo) Get(_ context.Context, formID, adminID uuid.UUID) (*entities.FormGrant, error) {
+	role, ok := f.grants[[2]uuid.UUID{formID, adminID}]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	return &entities.FormGrant{FormID: formID, AdminID: adminID, Role: role}, nil
+}
+
+func TestAuthorizer(t *testing.T) {
+	editor := &entities.Admin{ID: uuid.New(), Role: enums.RoleEditor}
+	reviewer := &entities.Admin{ID: uuid.New()}
+	formID, otherForm := uuid.New(), uuid.New()
+
+	authz := NewAuthorizer(
+		&fakeAdminRepo{admins: map[uuid.UUID]*entities.Admin{editor.ID: editor, reviewer.ID: reviewer}},
+		&fakeGrantRepo{grants: map[[2]uuid.UUID]enums.AdminRole{
+			{formID, reviewer.ID}: enums.RoleReviewer,
+			{formID, editor.ID}:   enums.RoleOwner,
+		}},
+	)
+	as := func(a *entities.Admin) context.Context {
+		return auth.WithAdminID(context.Background(), a.ID)
+	}
+
+	t.Run("no admin in context", func(t *testing.T) {
+		_, err := authz.RequireRole(context.Background(), enums.RoleViewer)
+		require.ErrorIs(t, err, domainErr.ErrUnauthenticated)
+	})
+
+	t.Run("deleted admin", func(t *testing.T) {
+		ctx := auth.WithAdminID(context.Background(), uuid.New())
+		_, err := authz.RequireForm(ctx, formID, enums.RoleViewer)
+		require.ErrorIs(t, err, domainErr.ErrUnauthenticated)
+	})
+
+	t.Run("global role", func(t *testing.T) {
+		_, err := authz.RequireRole(as(editor), enums.RoleEditor)
+		require.NoError(t, err)
+		_, err = authz.RequireRole(as(editor), enums.RoleOwner)
+		require.ErrorIs(t, err, domainErr.ErrForbidden)
+	})
+
+	t.Run("grant raises the global role", func(t *testing.T) {
+		_, err := authz.RequireForm(as(editor), formID, enums.RoleOwner)
+		require.NoError(t, err)
+		_, err = authz.RequireForm(as(editor), otherForm, enums.RoleOwner)
+		require.ErrorIs(t, err, domainErr.ErrForbidden)
+	})
+
+	t.Run("reviewer reads and reviews but cannot delete", func(t *testing.T) {
+		_, err := authz.RequireForm(as(reviewer), formID, enums.RoleViewer)
+		require.NoError(t, err)
+		_, err = authz.RequireForm(as(reviewer), formID, enums.RoleReviewer)
+		require.NoError(t, err)
+		_, err = authz.RequireForm(as(reviewer), formID, enums.RoleEditor)
+		require.ErrorIs(t, err, domainErr.ErrForbidden)
+		_, err = authz.RequireForm(as(reviewer), otherForm, enums.RoleViewer)
+		require.ErrorIs(t, err, domainErr.ErrForbidden)
+		_, err = authz.RequireRole(as(reviewer), enums.RoleOwner)
+		require.ErrorIs(t, err, domainErr.ErrForbidden)
+	})
+
+	t.Run("form scope", func(t *testing.T) {
+		scope, err := authz.FormScope(as(editor))
+		require.NoError(t, err)
+		require.Nil(t, scope)
+
+		scope, err = authz.FormScope(as(reviewer))
+		require.NoError(t, err)
+		require.Equal(t, reviewer.ID, *scope)
+	})
+}
@@ -0,0 +1,294 @@
+package embedding
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	"Skillture_Form/internal/embedding"
+	repo "Skillture_Form/internal/repository/interfaces"
+	uc "Skillture_Form/internal/usecase/interfaces"
+	val "Skillture_Form/internal/validation"
+
+	"github.com/google/uuid"
+)
+
+// embeddedFieldTypes are the answer types worth embedding
+var embeddedFieldTypes = []enums.FieldType{enums.FieldTypeText, enums.FieldTypeTextarea}
+
+// embeddingUseCase stores vectors of free-text answers
+type embeddingUseCase struct {
+	formRepo     repo.FormRepository
+	responseRepo repo.ResponseRepository
+	vectorRepo   repo.ResponseAnswerVectorRepository
+	flagRepo     repo.ResponseFlagRepository
+	embedder     embedding.Embedder
+	authz        uc.Authorizer
+}
+
+// NewEmbeddingUseCase creates an EmbeddingUseCase storing and searching vectors of embedder's model.
+// Only Search is authorized; embedding runs in background workers without an admin.
+func NewEmbeddingUseCase(
+	formRepo repo.FormRepository,
+	responseRepo repo.ResponseRepository,
+	vectorRepo repo.ResponseAnswerVectorRepository,
+	flagRepo repo.ResponseFlagRepository,
+	embedder embedding.Embedder,
+	authz uc.Authorizer,
+) uc.EmbeddingUseCase {
+	return &embeddingUseCase{
+		formRepo:     formRepo,
+		responseRepo: responseRepo,
+		vectorRepo:   vectorRepo,
+		flagRepo:     flagRepo,
+		embedder:     embedder,
+		authz:        authz,
+	}
+}
+
+// EmbedResponse embeds the text answers of one response and flags answers
+// that nearly repeat another response's answer when the form asks for it.
+// Answers already embedded with the model are skipped, so retries are safe;
+// flags are stored before the vectors so a failed run is redone completely.
+func (u *embeddingUseCase) EmbedResponse(ctx context.Context, responseID uuid.UUID) (int, error) {
+	answers, err := u.vectorRepo.ListUnembeddedAnswers(ctx, repo.UnembeddedAnswerFilter{
+		ModelName:  u.embedder.Model(),
+		FieldTypes: embeddedFieldTypes,
+		ResponseID: &responseID,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	vectors, embedded, err := u.buildVectors(ctx, answers)
+	if err != nil || len(vectors) == 0 {
+		return 0, err
+	}
+
+	if err := u.flagNearDuplicates(ctx, responseID, embedded, vectors); err != nil {
+		return 0, err
+	}
+
+	if err := u.vectorRepo.CreateBulk(ctx, vectors); err != nil {
+		return 0, err
+	}
+	return len(vectors), nil
+}
+
+// flagNearDuplicates compares each answer with the closest answer to the same
+// field in other responses of the form and flags the response when they are at
+// least as similar as the form's near-duplicate threshold
+func (u *embeddingUseCase) flagNearDuplicates(
+	ctx context.Context,
+	responseID uuid.UUID,
+	answers []*entities.ResponseAnswer,
+	vectors []*entities.ResponseAnswerVector,
+) error {
+	response, err := u.responseRepo.GetByID(ctx, responseID)
+	if err != nil {
+		return err
+	}
+	form, err := u.formRepo.GetByID(ctx, response.FormID)
+	if err != nil {
+		return err
+	}
+	policy := form.DuplicatePolicy
+	if !policy.FlagsNearDuplicates() {
+		return nil
+	}
+
+	for i, ans := range answers {
+		// Exact: the approximate index filters after its scan, so with k=1
+		// it regularly finds no answer of this field at all
+		matches, err := u.vectorRepo.SimilaritySearch(ctx, form.ID, vectors[i].Embedding, 1, repo.SimilarityFilter{
+			ModelName:         u.embedder.Model(),
+			FieldID:           &ans.FieldID,
+			ExcludeResponseID: &responseID,
+			Exact:             true,
+		})
+		if err != nil {
+			return err
+		}
+		// Written so that a NaN similarity is never flagged
+		if len(matches) == 0 || !(matches[0].Similarity >= policy.NearDuplicateThreshold) {
+			continue
+		}
+
+		match := matches[0]
+		if err := u.flagRepo.Create(ctx, &entities.ResponseFlag{
+			ID:                uuid.New(),
+			ResponseID:        responseID,
+			Reason:            entities.FlagReasonNearDuplicate,
+			FieldID:           &ans.FieldID,
+			MatchedResponseID: &match.Answer.ResponseID,
+			Similarity:        &match.Similarity,
+			CreatedAt:         time.Now(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Backfill walks all unembedded answers in ID order
+func (u *embeddingUseCase) Backfill(ctx context.Context, batchSize int) (int, error) {
+	if batchSize < 1 {
+		batchSize = 100
+	}
+
+	total := 0
+	after := uuid.Nil
+	for {
+		answers, err := u.vectorRepo.ListUnembeddedAnswers(ctx, repo.UnembeddedAnswerFilter{
+			ModelName:  u.embedder.Model(),
+			FieldTypes: embeddedFieldTypes,
+			AfterID:    after,
+			Limit:      batchSize,
+		})
+		if err != nil {
+			return total, err
+		}
+		if len(answers) == 0 {
+			return total, nil
+		}
+
+		n, err := u.embedAnswers(ctx, answers)
+		total += n
+		if err != nil {
+			return total, err
+		}
+
+		after = answers[len(answers)-1].ID
+	}
+}
+
+// Search finds answers similar in meaning to query
+func (u *embeddingUseCase) Search(
+	ctx context.Context,
+	formID uuid.UUID,
+	query string,
+	k int,
+	fieldID *uuid.UUID,
+) (*uc.SearchResult, error) {
+
+	// -------------------
+	// 1️⃣ Validate input
+	// -------------------
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("%w: search query is required", domainErr.ErrInvalidInput)
+	}
+	if k < 1 {
+		k = uc.DefaultSearchLimit
+	}
+	if k > uc.MaxSearchLimit {
+		k = uc.MaxSearchLimit
+	}
+
+	// Ensure the form exists and is readable
+	if _, err := u.authz.RequireForm(ctx, formID, enums.RoleViewer); err != nil {
+		return nil, err
+	}
+	if _, err := u.formRepo.GetByID(ctx, formID); err != nil {
+		return nil, err
+	}
+
+	// -------------------
+	// 2️⃣ Embed the query with the same model as the answers
+	// -------------------
+	embeddings, err := u.embedder.Embed(ctx, []string{query})
+	if err != nil {
+		return nil, err
+	}
+	if len(embeddings) != 1 {
+		return nil, fmt.Errorf("embedder returned %d vectors for the query", len(embeddings))
+	}
+	if embedding.IsZero(embeddings[0]) {
+		return nil, fmt.Errorf("%w: search query has no searchable words", domainErr.ErrInvalidInput)
+	}
+
+	// -------------------
+	// 3️⃣ Rank answers by cosine similarity
+	// -------------------
+	matches, err := u.vectorRepo.SimilaritySearch(ctx, formID, embeddings[0], k, repo.SimilarityFilter{
+		ModelName: u.embedder.Model(),
+		FieldID:   fieldID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if matches == nil {
+		matches = []*entities.AnswerMatch{}
+	}
+
+	return &uc.SearchResult{Query: query, Model: u.embedder.Model(), Data: matches}, nil
+}
+
+// embedAnswers embeds the answer texts in one call and stores the vectors
+func (u *embeddingUseCase) embedAnswers(ctx context.Context, answers []*entities.ResponseAnswer) (int, error) {
+	vectors, _, err := u.buildVectors(ctx, answers)
+	if err != nil || len(vectors) == 0 {
+		return 0, err
+	}
+
+	if err := u.vectorRepo.CreateBulk(ctx, vectors); err != nil {
+		return 0, err
+	}
+	return len(vectors), nil
+}
+
+// buildVectors embeds the non-empty answer texts in one call. It returns the
+// vectors and the answers they belong to, in the same order. Answers whose
+// vector is zero (nothing to embed, e.g. only punctuation) are left out.
+func (u *embeddingUseCase) buildVectors(
+	ctx context.Context,
+	answers []*entities.ResponseAnswer,
+) ([]*entities.ResponseAnswerVector, []*entities.ResponseAnswer, error) {
+	texts := make([]string, 0, len(answers))
+	targets := make([]*entities.ResponseAnswer, 0, len(answers))
+	for _, ans := range answers {
+		if text := val.AnswerText(ans); text != "" {
+			texts = append(texts, text)
+			targets = append(targets, ans)
+		}
+	}
+	if len(texts) == 0 {
+		return nil, nil, nil
+	}
+
+	embeddings, err := u.embedder.Embed(ctx, texts)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(embeddings) != len(texts) {
+		return nil, nil, fmt.Errorf("embedder returned %d vectors for %d answers", len(embeddings), len(texts))
+	}
+
+	now := time.Now()
+	vectors := make([]*entities.ResponseAnswerVector, 0, len(targets))
+	embedded := make([]*entities.ResponseAnswer, 0, len(targets))
+	for i, ans := range targets {
+		if embedding.IsZero(embeddings[i]) {
+			continue
+		}
+		vector := &entities.ResponseAnswerVector{
+			ID:               uuid.New(),
+			ResponseAnswerID: ans.ID,
+			Embedding:        embeddings[i],
+			ModelName:        u.embedder.Model(),
+			CreatedAt:        now,
+		}
+		if err := val.ValidateResponseVectorDomain(vector); err != nil {
+			return nil, nil, err
+		}
+		vectors = append(vectors, vector)
+		embedded = append(embedded, ans)
+	}
+
+	return vectors, embedded, nil
+}
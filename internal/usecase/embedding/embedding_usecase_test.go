@@ -0,0 +1,172 @@
+package embedding
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	"Skillture_Form/internal/embedding"
+	repo "Skillture_Form/internal/repository/interfaces"
+	uc "Skillture_Form/internal/usecase/interfaces"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAuthorizer struct {
+	uc.Authorizer
+	err error
+}
+
+func (f *fakeAuthorizer) RequireForm(context.Context, uuid.UUID, enums.AdminRole) (*entities.Admin, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &entities.Admin{ID: uuid.New()}, nil
+}
+
+type fakeFormRepo struct {
+	repo.FormRepository
+	forms map[uuid.UUID]*entities.Form
+}
+
+func (f *fakeFormRepo) GetByID(_ context.Context, id uuid.UUID) (*entities.Form, error) {
+	form, ok := f.forms[id]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	return form, nil
+}
+
+// fakeVectorRepo records the arguments of the last similarity search
+type fakeVectorRepo struct {
+	repo.ResponseAnswerVectorRepository
+	matches []*entities.AnswerMatch
+
+	calls  int
+	formID uuid.UUID
+	query  []float32
+	k      int
+	filter repo.SimilarityFilter
+}
+
+func (f *fakeVectorRepo) SimilaritySearch(
+	_ context.Context,
+	formID uuid.UUID,
+	queryEmbedding []float32,
+	k int,
+	filter repo.SimilarityFilter,
+) ([]*entities.AnswerMatch, error) {
+	f.calls++
+	f.formID, f.query, f.k, f.filter = formID, queryEmbedding, k, filter
+	return f.matches, nil
+}
+
+func newSearchUseCase(vectors *fakeVectorRepo, authz uc.Authorizer, forms ...*entities.Form) uc.EmbeddingUseCase {
+	formRepo := &fakeFormRepo{forms: map[uuid.UUID]*entities.Form{}}
+	for _, form := range forms {
+		formRepo.forms[form.ID] = form
+	}
+	return NewEmbeddingUseCase(formRepo, nil, vectors, nil, embedding.NewHashingEmbedder(), authz)
+}
+
+func TestSearch_RejectsEmptyQueries(t *testing.T) {
+	form := &entities.Form{ID: uuid.New()}
+	vectors := &fakeVectorRepo{}
+	u := newSearchUseCase(vectors, &fakeAuthorizer{}, form)
+
+	for _, query := range []string{"", "   \n\t", "?!... :)"} {
+		_, err := u.Search(context.Background(), form.ID, query, 10, nil)
+		require.ErrorIs(t, err, domainErr.ErrInvalidInput, "%q", query)
+	}
+	require.Zero(t, vectors.calls)
+}
+
+func TestSearch_ClampsLimit(t *testing.T) {
+	form := &entities.Form{ID: uuid.New()}
+
+	tests := []struct {
+		name string
+		k    int
+		want int
+	}{
+		{"zero uses the default", 0, uc.DefaultSearchLimit},
+		{"negative uses the default", -5, uc.DefaultSearchLimit},
+		{"within range", 25, 25},
+		{"maximum", uc.MaxSearchLimit, uc.MaxSearchLimit},
+		{"above the maximum", uc.MaxSearchLimit + 1, uc.MaxSearchLimit},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vectors := &fakeVectorRepo{}
+			u := newSearchUseCase(vectors, &fakeAuthorizer{}, form)
+
+			_, err := u.Search(context.Background(), form.ID, "late delivery", tt.k, nil)
+			require.NoError(t, err)
+			require.Equal(t, tt.want, vectors.k)
+		})
+	}
+}
+
+func TestSearch_PassesFilter(t *testing.T) {
+	form := &entities.Form{ID: uuid.New()}
+	fieldID := uuid.New()
+	vectors := &fakeVectorRepo{}
+	u := newSearchUseCase(vectors, &fakeAuthorizer{}, form)
+
+	result, err := u.Search(context.Background(), form.ID, "  late delivery  ", 5, &fieldID)
+	require.NoError(t, err)
+	require.Equal(t, "late delivery", result.Query)
+	require.Equal(t, enums.ModelLocalHashing, result.Model)
+
+	require.Equal(t, form.ID, vectors.formID)
+	require.Equal(t, enums.ModelLocalHashing, vectors.filter.ModelName)
+	require.Equal(t, &fieldID, vectors.filter.FieldID)
+	require.Nil(t, vectors.filter.ExcludeResponseID)
+	require.False(t, vectors.filter.Exact, "search uses the index")
+
+	// The query is embedded with the same model as the answers
+	want, err := embedding.NewHashingEmbedder().Embed(context.Background(), []string{"late delivery"})
+	require.NoError(t, err)
+	require.Equal(t, want[0], vectors.query)
+}
+
+func TestSearch_NoMatchesIsEmptyArray(t *testing.T) {
+	form := &entities.Form{ID: uuid.New()}
+	u := newSearchUseCase(&fakeVectorRepo{}, &fakeAuthorizer{}, form)
+
+	result, err := u.Search(context.Background(), form.ID, "late delivery", 10, nil)
+	require.NoError(t, err)
+	require.NotNil(t, result.Data)
+	require.Empty(t, result.Data)
+
+	body, err := json.Marshal(result)
+	require.NoError(t, err)
+	require.Contains(t, string(body), `"data":[]`)
+}
+
+func TestSearch_FormAccess(t *testing.T) {
+	form := &entities.Form{ID: uuid.New()}
+
+	t.Run("forbidden", func(t *testing.T) {
+		vectors := &fakeVectorRepo{}
+		u := newSearchUseCase(vectors, &fakeAuthorizer{err: domainErr.ErrForbidden}, form)
+
+		_, err := u.Search(context.Background(), form.ID, "late delivery", 10, nil)
+		require.ErrorIs(t, err, domainErr.ErrForbidden)
+		require.Zero(t, vectors.calls)
+	})
+
+	t.Run("unknown form", func(t *testing.T) {
+		vectors := &fakeVectorRepo{}
+		u := newSearchUseCase(vectors, &fakeAuthorizer{}, form)
+
+		_, err := u.Search(context.Background(), uuid.New(), "late delivery", 10, nil)
+		require.ErrorIs(t, err, pgx.ErrNoRows)
+		require.Zero(t, vectors.calls)
+	})
+}
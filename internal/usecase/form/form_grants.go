@@ -0,0 +1,74 @@
+package form
+
+import (
+	"context"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+
+	"github.com/google/uuid"
+)
+
+// -------------------
+// Per-form access, managed by the form's owners
+// -------------------
+
+// ListGrants returns who has a role on a form
+func (u *formUseCase) ListGrants(ctx context.Context, formID uuid.UUID) ([]*entities.FormGrant, error) {
+	if _, err := u.authz.RequireForm(ctx, formID, enums.RoleOwner); err != nil {
+		return nil, err
+	}
+
+	return u.grantRepo.ListByForm(ctx, formID)
+}
+
+// Grant gives an admin a role on a form, replacing any role they had there
+func (u *formUseCase) Grant(
+	ctx context.Context,
+	formID, adminID uuid.UUID,
+	role enums.AdminRole,
+) (*entities.FormGrant, error) {
+
+	actor, err := u.authz.RequireForm(ctx, formID, enums.RoleOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	if !role.IsValid() {
+		return nil, entities.ErrInvalidRole
+	}
+
+	// Both the form and the admin must exist
+	if _, err := u.formRepo.GetByID(ctx, formID); err != nil {
+		return nil, err
+	}
+	admin, err := u.adminRepo.GetByID(ctx, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if admin == nil {
+		return nil, domainErr.ErrNotFound
+	}
+
+	grant := &entities.FormGrant{
+		FormID:    formID,
+		AdminID:   adminID,
+		Username:  admin.Username,
+		Role:      role,
+		GrantedBy: &actor.ID,
+	}
+	if err := u.grantRepo.Upsert(ctx, grant); err != nil {
+		return nil, err
+	}
+	return grant, nil
+}
+
+// Revoke removes an admin's grant on a form; their global role still applies
+func (u *formUseCase) Revoke(ctx context.Context, formID, adminID uuid.UUID) error {
+	if _, err := u.authz.RequireForm(ctx, formID, enums.RoleOwner); err != nil {
+		return err
+	}
+
+	return u.grantRepo.Delete(ctx, formID, adminID)
+}
@@ -7,32 +7,53 @@ import (
 
 	"Skillture_Form/internal/domain/entities"
 	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	"Skillture_Form/internal/notification"
 	repo "Skillture_Form/internal/repository/interfaces"
 	formUC "Skillture_Form/internal/usecase/interfaces"
+	val "Skillture_Form/internal/validation"
 
 	"github.com/google/uuid"
 )
 
 // formUseCase is the concrete implementation of FormUseCase.
 type formUseCase struct {
-	formRepo repo.FormRepository
+	formRepo  repo.FormRepository
+	adminRepo repo.AdminRepository
+	grantRepo repo.FormGrantRepository
+	authz     formUC.Authorizer
 }
 
 // NewFormUseCase creates a new FormUseCase instance.
 // Dependencies are injected to keep the use case clean and testable.
 func NewFormUseCase(
 	formRepo repo.FormRepository,
+	adminRepo repo.AdminRepository,
+	grantRepo repo.FormGrantRepository,
+	authz formUC.Authorizer,
 ) formUC.FormUseCase {
-	return &formUseCase{formRepo: formRepo}
+	return &formUseCase{
+		formRepo:  formRepo,
+		adminRepo: adminRepo,
+		grantRepo: grantRepo,
+		authz:     authz,
+	}
 }
 
 // Create creates a new form.
 // This use case only handles form metadata, not fields.
+// The creating admin becomes the form's owner.
 func (u *formUseCase) Create(ctx context.Context, form *entities.Form) error {
 
-	// Validate form title
-	if len(form.Title) == 0 {
-		return errors.New("form title is required")
+	// Only global editors and owners create forms
+	admin, err := u.authz.RequireRole(ctx, enums.RoleEditor)
+	if err != nil {
+		return err
+	}
+
+	// Validate form title (in at least one language)
+	if !form.HasTitle() {
+		return entities.ErrMissingFormTitle
 	}
 
 	// Generate a new UUID if not provided
@@ -46,13 +67,31 @@ func (u *formUseCase) Create(ctx context.Context, form *entities.Form) error {
 	// Set creation time
 	form.CreatedAt = time.Now()
 
-	// Persist the form
-	return u.formRepo.Create(ctx, form)
+	// Persist the form with its owner grant
+	return u.formRepo.WithTx(ctx, func(txFormRepo repo.FormRepository,
+		_ repo.WebhookRepository,
+		txGrantRepo repo.FormGrantRepository) error {
+
+		if err := txFormRepo.Create(ctx, form); err != nil {
+			return err
+		}
+		return txGrantRepo.Upsert(ctx, &entities.FormGrant{
+			FormID:    form.ID,
+			AdminID:   admin.ID,
+			Role:      enums.RoleOwner,
+			GrantedBy: &admin.ID,
+			CreatedAt: form.CreatedAt,
+		})
+	})
 }
 
 // Update updates an existing form.
 func (u *formUseCase) Update(ctx context.Context, form *entities.Form) error {
 
+	if _, err := u.authz.RequireForm(ctx, form.ID, enums.RoleEditor); err != nil {
+		return err
+	}
+
 	// Ensure the form exists
 	existing, err := u.formRepo.GetByID(ctx, form.ID)
 	if err != nil {
@@ -65,8 +104,8 @@ func (u *formUseCase) Update(ctx context.Context, form *entities.Form) error {
 	}
 
 	// Validate updated data
-	if len(form.Title) == 0 {
-		return errors.New("form title is required")
+	if !form.HasTitle() {
+		return entities.ErrMissingFormTitle
 	}
 
 	// Preserve immutable fields
@@ -81,6 +120,10 @@ func (u *formUseCase) Update(ctx context.Context, form *entities.Form) error {
 // Allows activation from both Draft and Closed states.
 func (u *formUseCase) Publish(ctx context.Context, formID uuid.UUID) error {
 
+	if _, err := u.authz.RequireForm(ctx, formID, enums.RoleEditor); err != nil {
+		return err
+	}
+
 	// Retrieve the form
 	form, err := u.formRepo.GetByID(ctx, formID)
 	if err != nil {
@@ -95,13 +138,17 @@ func (u *formUseCase) Publish(ctx context.Context, formID uuid.UUID) error {
 	// Change status to Published
 	form.Status = enums.FormStatusPublished
 
-	// Persist status change
-	return u.formRepo.Update(ctx, form)
+	// Persist status change and notify webhook subscribers atomically
+	return u.updateStatus(ctx, form, enums.WebhookFormPublished)
 }
 
 // Close closes a form and prevents new responses.
 func (u *formUseCase) Close(ctx context.Context, formID uuid.UUID) error {
 
+	if _, err := u.authz.RequireForm(ctx, formID, enums.RoleEditor); err != nil {
+		return err
+	}
+
 	// Retrieve the form
 	form, err := u.formRepo.GetByID(ctx, formID)
 	if err != nil {
@@ -116,14 +163,105 @@ func (u *formUseCase) Close(ctx context.Context, formID uuid.UUID) error {
 	// Change status to Closed
 	form.Status = enums.FormStatusClosed
 
-	// Persist status change
-	return u.formRepo.Update(ctx, form)
+	// Persist status change and notify webhook subscribers atomically
+	return u.updateStatus(ctx, form, enums.WebhookFormClosed)
+}
+
+// updateStatus stores a status change together with its webhook event
+func (u *formUseCase) updateStatus(ctx context.Context, form *entities.Form, event enums.WebhookEvent) error {
+	return u.formRepo.WithTx(ctx, func(txFormRepo repo.FormRepository,
+		txWebhookRepo repo.WebhookRepository,
+		_ repo.FormGrantRepository) error {
+
+		if err := txFormRepo.Update(ctx, form); err != nil {
+			return err
+		}
+		_, err := txWebhookRepo.Enqueue(ctx, entities.NewWebhookEvent(event, form.ID, form))
+		return err
+	})
+}
+
+// SetDuplicatePolicy configures how the form treats repeated submissions.
+// A policy without any enabled rule is stored as nil.
+func (u *formUseCase) SetDuplicatePolicy(
+	ctx context.Context,
+	formID uuid.UUID,
+	policy *entities.DuplicatePolicy,
+) (*entities.Form, error) {
+
+	if _, err := u.authz.RequireForm(ctx, formID, enums.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	// Validate ranges
+	if err := val.ValidateDuplicatePolicy(policy); err != nil {
+		return nil, err
+	}
+	if policy != nil && *policy == (entities.DuplicatePolicy{}) {
+		policy = nil
+	}
+
+	// Ensure the form exists
+	form, err := u.formRepo.GetByID(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Persist the policy
+	if err := u.formRepo.UpdateDuplicatePolicy(ctx, formID, policy); err != nil {
+		return nil, err
+	}
+
+	form.DuplicatePolicy = policy
+	return form, nil
+}
+
+// SetNotificationSettings configures the emails sent on submission.
+// Settings that send no email are stored as nil.
+func (u *formUseCase) SetNotificationSettings(
+	ctx context.Context,
+	formID uuid.UUID,
+	settings *entities.NotificationSettings,
+) (*entities.Form, error) {
+
+	if _, err := u.authz.RequireForm(ctx, formID, enums.RoleEditor); err != nil {
+		return nil, err
+	}
+
+	// Validate addresses and templates
+	if err := val.ValidateNotificationSettings(settings); err != nil {
+		return nil, err
+	}
+	if settings.IsEmpty() {
+		settings = nil
+	} else if err := notification.ValidateTemplates(settings); err != nil {
+		return nil, err
+	}
+
+	// Ensure the form exists
+	form, err := u.formRepo.GetByID(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Persist the settings
+	if err := u.formRepo.UpdateNotificationSettings(ctx, formID, settings); err != nil {
+		return nil, err
+	}
+
+	form.Notifications = settings
+	return form, nil
 }
 
 // Delete deletes a form.
 // Deletion is allowed even if the form has responses.
 func (u *formUseCase) Delete(ctx context.Context, formID uuid.UUID) error {
 
+	// Only owners delete forms
+	if _, err := u.authz.RequireForm(ctx, formID, enums.RoleOwner); err != nil {
+		return err
+	}
+
 	// Ensure the form exists
 	_, err := u.formRepo.GetByID(ctx, formID)
 	if err != nil {
@@ -137,13 +275,45 @@ func (u *formUseCase) Delete(ctx context.Context, formID uuid.UUID) error {
 // GetByID retrieves a form by its ID.
 func (u *formUseCase) GetByID(ctx context.Context, formID uuid.UUID) (*entities.Form, error) {
 
+	if _, err := u.authz.RequireForm(ctx, formID, enums.RoleViewer); err != nil {
+		return nil, err
+	}
+
 	return u.formRepo.GetByID(ctx, formID)
 }
 
-// List retrieves all forms.
-func (u *formUseCase) List(ctx context.Context, filter formUC.FormFilter) ([]*entities.Form, error) {
-	return u.formRepo.List(ctx, repo.FormFilter{
-		Status: filter.Status,
-		Title:  filter.Title,
+// GetPublic retrieves a form for respondents, without the admin
+// addresses and templates of its notification settings.
+// Draft forms are not exposed to respondents.
+func (u *formUseCase) GetPublic(ctx context.Context, formID uuid.UUID) (*entities.Form, error) {
+	form, err := u.formRepo.GetByID(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+	if form.Status == enums.FormStatusDraft {
+		return nil, domainErr.ErrFormNotPublished
+	}
+
+	form.Notifications = nil
+	return form, nil
+}
+
+// List retrieves a page of forms.
+// Admins without a global role only see the forms granted to them.
+func (u *formUseCase) List(ctx context.Context, filter formUC.FormFilter) (*formUC.FormPage, error) {
+	grantedTo, err := u.authz.FormScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	forms, page, err := u.formRepo.List(ctx, repo.FormFilter{
+		Status:      filter.Status,
+		Title:       filter.Title,
+		GrantedTo:   grantedTo,
+		ListOptions: filter.ListOptions,
 	})
+	if err != nil {
+		return nil, err
+	}
+	return &formUC.FormPage{Data: forms, Pagination: page}, nil
 }
@@ -7,6 +7,7 @@ import (
 
 	"Skillture_Form/internal/domain/entities"
 	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
 	repo "Skillture_Form/internal/repository/interfaces"
 	uc "Skillture_Form/internal/usecase/interfaces"
 	val "Skillture_Form/internal/validation"
@@ -18,22 +19,35 @@ import (
 type formFieldUseCase struct {
 	formRepo      repo.FormRepository
 	formFieldRepo repo.FormFieldRepository
+	sectionRepo   repo.FormSectionRepository
+	authz         uc.Authorizer
 }
 
 // NewFormFieldUseCase creates a new instance of formFieldUseCase
 func NewFormFieldUseCase(
 	formRepo repo.FormRepository,
 	formFieldRepo repo.FormFieldRepository,
+	sectionRepo repo.FormSectionRepository,
+	authz uc.Authorizer,
 ) uc.FormFieldUseCase {
 	return &formFieldUseCase{
 		formRepo:      formRepo,
 		formFieldRepo: formFieldRepo,
+		sectionRepo:   sectionRepo,
+		authz:         authz,
 	}
 }
 
 // Create adds a new field to a form with domain validation
 func (u *formFieldUseCase) Create(ctx context.Context, field *entities.FormField) error {
 
+	// -------------------
+	//  Access
+	// -------------------
+	if _, err := u.authz.RequireForm(ctx, field.FormID, enums.RoleEditor); err != nil {
+		return err
+	}
+
 	// -------------------
 	//  Domain validation
 	// -------------------
@@ -51,6 +65,9 @@ func (u *formFieldUseCase) Create(ctx context.Context, field *entities.FormField
 	if form.Status == enums.FormStatusClosed {
 		return errors.New("cannot add field to a closed form")
 	}
+	if err := u.checkSection(ctx, field); err != nil {
+		return err
+	}
 
 	// -------------------
 	// Defaults & timestamps
@@ -61,6 +78,17 @@ func (u *formFieldUseCase) Create(ctx context.Context, field *entities.FormField
 	field.CreatedAt = time.Now()
 	field.UpdatedAt = time.Now()
 
+	// -------------------
+	//  Show-if conditions
+	// -------------------
+	siblings, err := u.siblings(ctx, field)
+	if err != nil {
+		return err
+	}
+	if err := val.ValidateFieldConditions(field, siblings); err != nil {
+		return err
+	}
+
 	// -------------------
 	//  Persist
 	// -------------------
@@ -77,6 +105,16 @@ func (u *formFieldUseCase) Update(ctx context.Context, field *entities.FormField
 	if err != nil {
 		return err
 	}
+	if existing == nil {
+		return domainErr.ErrNotFound
+	}
+
+	// -------------------
+	//  Access
+	// -------------------
+	if _, err := u.authz.RequireForm(ctx, existing.FormID, enums.RoleEditor); err != nil {
+		return err
+	}
 
 	// -------------------
 	//  Domain validation
@@ -101,6 +139,21 @@ func (u *formFieldUseCase) Update(ctx context.Context, field *entities.FormField
 	field.CreatedAt = existing.CreatedAt
 	field.UpdatedAt = time.Now()
 
+	if err := u.checkSection(ctx, field); err != nil {
+		return err
+	}
+
+	// -------------------
+	//  Show-if conditions
+	// -------------------
+	siblings, err := u.siblings(ctx, field)
+	if err != nil {
+		return err
+	}
+	if err := val.ValidateFieldConditions(field, siblings); err != nil {
+		return err
+	}
+
 	// -------------------
 	//  Persist
 	// -------------------
@@ -111,10 +164,29 @@ func (u *formFieldUseCase) Update(ctx context.Context, field *entities.FormField
 func (u *formFieldUseCase) Delete(ctx context.Context, fieldID uuid.UUID) error {
 
 	// Ensure field exists
-	_, err := u.formFieldRepo.GetByID(ctx, fieldID)
+	field, err := u.formFieldRepo.GetByID(ctx, fieldID)
 	if err != nil {
 		return err
 	}
+	if field == nil {
+		return domainErr.ErrNotFound
+	}
+
+	// Editors of the field's form only
+	if _, err := u.authz.RequireForm(ctx, field.FormID, enums.RoleEditor); err != nil {
+		return err
+	}
+
+	// Fields used in other fields' show-if conditions cannot be removed
+	siblings, err := u.siblings(ctx, field)
+	if err != nil {
+		return err
+	}
+	for _, s := range siblings {
+		if s.DependsOn(fieldID) {
+			return val.ErrFieldHasDependents
+		}
+	}
 
 	// Delete
 	return u.formFieldRepo.Delete(ctx, fieldID)
@@ -122,5 +194,54 @@ func (u *formFieldUseCase) Delete(ctx context.Context, fieldID uuid.UUID) error
 
 // ListByFormID returns all fields of a form
 func (u *formFieldUseCase) ListByFormID(ctx context.Context, formID uuid.UUID) ([]*entities.FormField, error) {
+	if _, err := u.authz.RequireForm(ctx, formID, enums.RoleViewer); err != nil {
+		return nil, err
+	}
 	return u.formFieldRepo.List(ctx, repo.FormFieldFilter{FormID: &formID})
 }
+
+// ListPublic returns all fields of a form for respondents.
+// Draft forms are not exposed to respondents.
+func (u *formFieldUseCase) ListPublic(ctx context.Context, formID uuid.UUID) ([]*entities.FormField, error) {
+	form, err := u.formRepo.GetByID(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+	if form.Status == enums.FormStatusDraft {
+		return nil, domainErr.ErrFormNotPublished
+	}
+
+	return u.formFieldRepo.List(ctx, repo.FormFieldFilter{FormID: &formID})
+}
+
+// siblings returns the other fields of the field's form
+func (u *formFieldUseCase) siblings(ctx context.Context, field *entities.FormField) ([]*entities.FormField, error) {
+	all, err := u.formFieldRepo.List(ctx, repo.FormFieldFilter{FormID: &field.FormID})
+	if err != nil {
+		return nil, err
+	}
+
+	siblings := make([]*entities.FormField, 0, len(all))
+	for _, f := range all {
+		if f.ID != field.ID {
+			siblings = append(siblings, f)
+		}
+	}
+	return siblings, nil
+}
+
+// checkSection ensures the field's section, if any, belongs to the field's form
+func (u *formFieldUseCase) checkSection(ctx context.Context, field *entities.FormField) error {
+	if field.SectionID == nil {
+		return nil
+	}
+
+	section, err := u.sectionRepo.GetByID(ctx, *field.SectionID)
+	if err != nil {
+		return err
+	}
+	if section == nil || section.FormID != field.FormID {
+		return val.ErrSectionNotInForm
+	}
+	return nil
+}
@@ -0,0 +1,237 @@
+package form_section
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	repo "Skillture_Form/internal/repository/interfaces"
+	uc "Skillture_Form/internal/usecase/interfaces"
+	val "Skillture_Form/internal/validation"
+
+	"github.com/google/uuid"
+)
+
+// formSectionUseCase implements the FormSectionUseCase interface
+type formSectionUseCase struct {
+	formRepo      repo.FormRepository
+	sectionRepo   repo.FormSectionRepository
+	formFieldRepo repo.FormFieldRepository
+	authz         uc.Authorizer
+}
+
+// NewFormSectionUseCase creates a new instance of formSectionUseCase
+func NewFormSectionUseCase(
+	formRepo repo.FormRepository,
+	sectionRepo repo.FormSectionRepository,
+	formFieldRepo repo.FormFieldRepository,
+	authz uc.Authorizer,
+) uc.FormSectionUseCase {
+	return &formSectionUseCase{
+		formRepo:      formRepo,
+		sectionRepo:   sectionRepo,
+		formFieldRepo: formFieldRepo,
+		authz:         authz,
+	}
+}
+
+// Create adds a new section to a form
+func (u *formSectionUseCase) Create(ctx context.Context, section *entities.FormSection) error {
+
+	// -------------------
+	//  Access
+	// -------------------
+	if _, err := u.authz.RequireForm(ctx, section.FormID, enums.RoleEditor); err != nil {
+		return err
+	}
+
+	// -------------------
+	//  Domain validation
+	// -------------------
+	if err := val.ValidateFormSectionDomain(section); err != nil {
+		return err
+	}
+
+	// -------------------
+	// Business rules
+	// -------------------
+	form, err := u.formRepo.GetByID(ctx, section.FormID)
+	if err != nil {
+		return err
+	}
+	if form.Status == enums.FormStatusClosed {
+		return errors.New("cannot add section to a closed form")
+	}
+
+	// -------------------
+	// Defaults & timestamps
+	// -------------------
+	if section.ID == uuid.Nil {
+		section.ID = uuid.New()
+	}
+	section.CreatedAt = time.Now()
+	section.UpdatedAt = time.Now()
+
+	// -------------------
+	//  Persist
+	// -------------------
+	return u.sectionRepo.Create(ctx, section)
+}
+
+// Update updates an existing section
+func (u *formSectionUseCase) Update(ctx context.Context, section *entities.FormSection) error {
+
+	// -------------------
+	//  Access
+	// -------------------
+	if _, err := u.authz.RequireForm(ctx, section.FormID, enums.RoleEditor); err != nil {
+		return err
+	}
+
+	// -------------------
+	//  Load existing
+	// -------------------
+	existing, err := u.sectionRepo.GetByID(ctx, section.ID)
+	if err != nil {
+		return err
+	}
+	if existing == nil || existing.FormID != section.FormID {
+		return domainErr.ErrNotFound
+	}
+
+	// -------------------
+	//  Domain validation
+	// -------------------
+	if err := val.ValidateFormSectionDomain(section); err != nil {
+		return err
+	}
+
+	// -------------------
+	//  Business rules
+	// -------------------
+	form, err := u.formRepo.GetByID(ctx, existing.FormID)
+	if err != nil {
+		return err
+	}
+	if form.Status == enums.FormStatusClosed {
+		return errors.New("cannot update section of a closed form")
+	}
+
+	// Preserve immutable fields
+	section.CreatedAt = existing.CreatedAt
+	section.UpdatedAt = time.Now()
+
+	// -------------------
+	//  Persist
+	// -------------------
+	return u.sectionRepo.Update(ctx, section)
+}
+
+// Delete removes a section; fields in it are kept without a section
+func (u *formSectionUseCase) Delete(ctx context.Context, formID, sectionID uuid.UUID) error {
+
+	if _, err := u.authz.RequireForm(ctx, formID, enums.RoleEditor); err != nil {
+		return err
+	}
+
+	// Ensure section exists in this form
+	existing, err := u.sectionRepo.GetByID(ctx, sectionID)
+	if err != nil {
+		return err
+	}
+	if existing == nil || existing.FormID != formID {
+		return domainErr.ErrNotFound
+	}
+
+	// Delete
+	return u.sectionRepo.Delete(ctx, sectionID)
+}
+
+// ListByFormID returns all sections of a form
+func (u *formSectionUseCase) ListByFormID(ctx context.Context, formID uuid.UUID) ([]*entities.FormSection, error) {
+	if _, err := u.authz.RequireForm(ctx, formID, enums.RoleViewer); err != nil {
+		return nil, err
+	}
+	return u.sectionRepo.ListByFormID(ctx, formID)
+}
+
+// GetDefinition builds the renderable structure of a form.
+// Draft forms are not exposed to respondents.
+func (u *formSectionUseCase) GetDefinition(ctx context.Context, formID uuid.UUID) (*entities.FormDefinition, error) {
+	form, err := u.formRepo.GetByID(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+	if form.Status == enums.FormStatusDraft {
+		return nil, domainErr.ErrFormNotPublished
+	}
+
+	// Admin addresses and email templates are not for respondents
+	form.Notifications = nil
+
+	return u.buildDefinition(ctx, form)
+}
+
+// TranslationReport checks which texts of a form (including drafts) are
+// missing in the given language
+func (u *formSectionUseCase) TranslationReport(ctx context.Context, formID uuid.UUID, lang string) (*entities.TranslationReport, error) {
+	if _, err := u.authz.RequireForm(ctx, formID, enums.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	form, err := u.formRepo.GetByID(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	def, err := u.buildDefinition(ctx, form)
+	if err != nil {
+		return nil, err
+	}
+
+	return def.TranslationReport(lang), nil
+}
+
+// buildDefinition loads the sections and fields of a form and nests them
+func (u *formSectionUseCase) buildDefinition(ctx context.Context, form *entities.Form) (*entities.FormDefinition, error) {
+	formID := form.ID
+
+	sections, err := u.sectionRepo.ListByFormID(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fields come ordered by FieldOrder
+	fields, err := u.formFieldRepo.List(ctx, repo.FormFieldFilter{FormID: &formID})
+	if err != nil {
+		return nil, err
+	}
+
+	def := &entities.FormDefinition{
+		Form:     form,
+		Sections: make([]*entities.SectionDefinition, 0, len(sections)),
+		Fields:   []*entities.FormField{},
+	}
+
+	bySection := make(map[uuid.UUID]*entities.SectionDefinition, len(sections))
+	for _, s := range sections {
+		sd := &entities.SectionDefinition{FormSection: s, Fields: []*entities.FormField{}}
+		bySection[s.ID] = sd
+		def.Sections = append(def.Sections, sd)
+	}
+
+	for _, f := range fields {
+		if f.SectionID != nil {
+			if sd, ok := bySection[*f.SectionID]; ok {
+				sd.Fields = append(sd.Fields, f)
+				continue
+			}
+		}
+		def.Fields = append(def.Fields, f)
+	}
+
+	return def, nil
+}
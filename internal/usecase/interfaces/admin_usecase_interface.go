@@ -2,12 +2,28 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
 
 	"github.com/google/uuid"
 )
 
+// IssuedPasswordReset is a new password reset token. The token is only
+// returned here; the server keeps its hash.
+type IssuedPasswordReset struct {
+	AdminID   uuid.UUID `json:"admin_id"`
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// MFAEnrollment is a pending TOTP secret for an authenticator app
+type MFAEnrollment struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"` // otpauth:// URI, usually shown as a QR code
+}
+
 // AdminUseCase defines business logic for admin operations
 type AdminUseCase interface {
 	Create(ctx context.Context, admin *entities.Admin) error
@@ -15,5 +31,26 @@ type AdminUseCase interface {
 	GetByUsername(ctx context.Context, username string) (*entities.Admin, error)
 	List(ctx context.Context) ([]*entities.Admin, error)
 	Delete(ctx context.Context, id uuid.UUID) error
-	Authenticate(ctx context.Context, username, password string) (*entities.Admin, error)
+	SetRole(ctx context.Context, id uuid.UUID, role enums.AdminRole) (*entities.Admin, error)
+	Authenticate(ctx context.Context, username, password, clientIP string) (*entities.Admin, error)
+	Unlock(ctx context.Context, id uuid.UUID) error
+	// UnlockIP lifts the login lockout of a client IP
+	UnlockIP(ctx context.Context, clientIP string) error
+
+	// ChangePassword changes the password of the admin in ctx
+	ChangePassword(ctx context.Context, current, next string) error
+	// IssuePasswordReset creates a one-time reset token for an admin
+	IssuePasswordReset(ctx context.Context, id uuid.UUID) (*IssuedPasswordReset, error)
+	// ResetPassword sets a new password with a reset token
+	ResetPassword(ctx context.Context, token, password string) error
+
+	// EnrollMFA creates a pending TOTP secret for the admin in ctx
+	EnrollMFA(ctx context.Context) (*MFAEnrollment, error)
+	// ConfirmMFA enables MFA with a code from the pending secret and returns
+	// the recovery codes
+	ConfirmMFA(ctx context.Context, code string) ([]string, error)
+	// VerifyMFA completes a login with an authenticator or recovery code
+	VerifyMFA(ctx context.Context, adminID uuid.UUID, code, clientIP string) (*entities.Admin, error)
+	// DisableMFA turns off MFA for an admin
+	DisableMFA(ctx context.Context, id uuid.UUID, code, clientIP string) error
 }
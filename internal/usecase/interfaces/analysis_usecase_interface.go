@@ -0,0 +1,40 @@
+package interfaces
+
+import (
+	"context"
+
+	"Skillture_Form/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// Theme extraction limits
+const (
+	MaxThemes                   = 20   // Largest number of themes a field can be split into
+	DefaultThemeRepresentatives = 3    // Representative answers per theme
+	MaxThemeRepresentatives     = 10   // Largest number of representatives per theme
+	MaxThemeAnswers             = 5000 // Most recent answers clustered per request
+)
+
+// Analytics histogram limits
+const (
+	DefaultHistogramBuckets = 10
+	MaxHistogramBuckets     = 50
+)
+
+// AnalysisUseCase summarizes the responses of a form
+type AnalysisUseCase interface {
+
+	// FieldThemes clusters the embedded free-text answers of a field into k themes
+	// (k <= 0 picks a number based on the answer count) and returns up to
+	// representatives answers closest to each theme's centroid
+	FieldThemes(ctx context.Context, fieldID uuid.UUID, k, representatives int) (*entities.FieldThemes, error)
+
+	// FormAnalytics aggregates the submitted responses of a form per field, with
+	// labels in lang and number histograms of the given bucket count
+	FormAnalytics(ctx context.Context, formID uuid.UUID, lang string, buckets int) (*entities.FormAnalytics, error)
+
+	// Crosstab counts how often the options of two choice fields of a form were
+	// selected together and runs a chi-square test of independence
+	Crosstab(ctx context.Context, formID, rowFieldID, columnFieldID uuid.UUID, lang string) (*entities.Crosstab, error)
+}
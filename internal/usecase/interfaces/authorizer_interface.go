@@ -0,0 +1,26 @@
+package interfaces
+
+import (
+	"context"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/google/uuid"
+)
+
+// Authorizer checks the permissions of the admin acting in ctx (see
+// auth.WithAdminID). Checks fail with ErrUnauthenticated when ctx carries
+// no existing admin and with ErrForbidden when the role is insufficient.
+type Authorizer interface {
+	// RequireRole returns the acting admin if their global role includes role
+	RequireRole(ctx context.Context, role enums.AdminRole) (*entities.Admin, error)
+
+	// RequireForm returns the acting admin if their global role or their grant
+	// on the form, whichever is higher, includes role
+	RequireForm(ctx context.Context, formID uuid.UUID, role enums.AdminRole) (*entities.Admin, error)
+
+	// FormScope returns nil when the acting admin may read every form, or
+	// their ID when they only see forms granted to them
+	FormScope(ctx context.Context) (*uuid.UUID, error)
+}
@@ -0,0 +1,45 @@
+package interfaces
+
+import (
+	"context"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/google/uuid"
+)
+
+// Semantic search result limits
+const (
+	DefaultSearchLimit = 10
+	MaxSearchLimit     = 100
+)
+
+// SearchResult is the outcome of a semantic search over a form's answers
+type SearchResult struct {
+	Query string                  `json:"query"`
+	Model enums.ModelName         `json:"model"`
+	Data  []*entities.AnswerMatch `json:"data"`
+}
+
+// EmbeddingQueue schedules embedding of a committed response's text answers
+type EmbeddingQueue interface {
+	// Enqueue must not block the caller
+	Enqueue(responseID uuid.UUID)
+}
+
+// EmbeddingUseCase generates vectors for text and textarea answers
+type EmbeddingUseCase interface {
+
+	// EmbedResponse embeds the answers of a submitted response that have no vector yet
+	// and returns how many vectors were stored
+	EmbedResponse(ctx context.Context, responseID uuid.UUID) (int, error)
+
+	// Backfill embeds every existing answer without a vector, batchSize answers at a time,
+	// and returns how many vectors were stored
+	Backfill(ctx context.Context, batchSize int) (int, error)
+
+	// Search embeds query and returns the k answers of a form closest in meaning,
+	// optionally only answers to fieldID
+	Search(ctx context.Context, formID uuid.UUID, query string, k int, fieldID *uuid.UUID) (*SearchResult, error)
+}
@@ -22,4 +22,8 @@ type FormFieldUseCase interface {
 
 	// ListByFormID returns all fields for a specific form.
 	ListByFormID(ctx context.Context, formID uuid.UUID) ([]*entities.FormField, error)
+
+	// ListPublic returns all fields of a form as shown to respondents.
+	// Draft forms return ErrFormNotPublished.
+	ListPublic(ctx context.Context, formID uuid.UUID) ([]*entities.FormField, error)
 }
@@ -0,0 +1,31 @@
+package interfaces
+
+import (
+	"context"
+
+	"Skillture_Form/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// FormSectionUseCase defines business operations for form sections.
+type FormSectionUseCase interface {
+
+	// Create adds a new section to a form.
+	Create(ctx context.Context, section *entities.FormSection) error
+
+	// Update updates an existing section of a form.
+	Update(ctx context.Context, section *entities.FormSection) error
+
+	// Delete removes a section of a form; its fields stay in the form without a section.
+	Delete(ctx context.Context, formID, sectionID uuid.UUID) error
+
+	// ListByFormID returns all sections of a form ordered by SectionOrder.
+	ListByFormID(ctx context.Context, formID uuid.UUID) ([]*entities.FormSection, error)
+
+	// GetDefinition returns a published or closed form with its sections and nested fields.
+	GetDefinition(ctx context.Context, formID uuid.UUID) (*entities.FormDefinition, error)
+
+	// TranslationReport lists the texts of a form (drafts included) missing in the given language.
+	TranslationReport(ctx context.Context, formID uuid.UUID, lang string) (*entities.TranslationReport, error)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 
 	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/repository"
 
 	"github.com/google/uuid"
 )
@@ -11,7 +13,14 @@ import (
 // FormFilter used for listing forms
 type FormFilter struct {
 	Status *int16
-	Title  *string
+	Title  *string // searched in every language of the title
+	repository.ListOptions
+}
+
+// FormPage is a page of forms with pagination metadata
+type FormPage struct {
+	Data       []*entities.Form          `json:"data"`
+	Pagination repository.CursorPageInfo `json:"pagination"`
 }
 
 // FormUseCase defines all business operations related to forms
@@ -30,12 +39,30 @@ type FormUseCase interface {
 	// Close closes a form and prevents new responses
 	Close(ctx context.Context, formID uuid.UUID) error
 
+	// SetDuplicatePolicy replaces the duplicate policy of a form (nil allows duplicates)
+	SetDuplicatePolicy(ctx context.Context, formID uuid.UUID, policy *entities.DuplicatePolicy) (*entities.Form, error)
+
+	// SetNotificationSettings replaces the submission emails of a form (nil sends none)
+	SetNotificationSettings(ctx context.Context, formID uuid.UUID, settings *entities.NotificationSettings) (*entities.Form, error)
+
 	// Delete deletes a form even if it has responses
 	Delete(ctx context.Context, formID uuid.UUID) error
 
 	// GetByID returns a form by ID
 	GetByID(ctx context.Context, formID uuid.UUID) (*entities.Form, error)
 
-	// List returns forms based on filter
-	List(ctx context.Context, filter FormFilter) ([]*entities.Form, error)
+	// GetPublic returns a form as shown to respondents; drafts return ErrFormNotPublished
+	GetPublic(ctx context.Context, formID uuid.UUID) (*entities.Form, error)
+
+	// List returns a page of the forms the acting admin may read
+	List(ctx context.Context, filter FormFilter) (*FormPage, error)
+
+	// ListGrants returns the per-form roles of a form
+	ListGrants(ctx context.Context, formID uuid.UUID) ([]*entities.FormGrant, error)
+
+	// Grant gives an admin a role on a form
+	Grant(ctx context.Context, formID, adminID uuid.UUID, role enums.AdminRole) (*entities.FormGrant, error)
+
+	// Revoke removes an admin's role on a form
+	Revoke(ctx context.Context, formID, adminID uuid.UUID) error
 }
@@ -2,11 +2,22 @@ package interfaces
 
 import (
 	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/export"
+	"Skillture_Form/internal/repository"
+	repo "Skillture_Form/internal/repository/interfaces"
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+// ResponsePage is a page of responses with pagination metadata
+type ResponsePage struct {
+	Data       []*entities.Response `json:"data"`
+	Pagination repository.PageInfo  `json:"pagination"`
+}
+
 // ResponseUseCase defines operations for form submissions
 type ResponseUseCase interface {
 
@@ -16,9 +27,31 @@ type ResponseUseCase interface {
 	// GetByID fetches a response by its ID
 	GetByID(ctx context.Context, id uuid.UUID) (*entities.Response, error)
 
-	// ListByForm lists all responses for a given form
-	ListByForm(ctx context.Context, formID uuid.UUID) ([]*entities.Response, error)
+	// ListByForm returns a filtered, sorted page of a form's responses with their answers
+	ListByForm(ctx context.Context, filter repo.ResponseFilter) (*ResponsePage, error)
 
 	// Delete removes a response and all its answers
 	Delete(ctx context.Context, id uuid.UUID) error
+
+	// Export streams all submitted responses of a form to w, labelling field columns in lang
+	Export(ctx context.Context, formID uuid.UUID, lang string, w export.RowWriter) error
+
+	// Review changes the review status of a response on behalf of the admin in ctx
+	Review(ctx context.Context, id uuid.UUID, status enums.ResponseStatus, notes string) (*entities.Response, error)
+
+	// StatusHistory lists the status changes of a response
+	StatusHistory(ctx context.Context, id uuid.UUID) ([]*entities.ResponseStatusChange, error)
+
+	// SaveDraft creates (empty token) or replaces a pending response with partial answers
+	// and returns its resume token
+	SaveDraft(ctx context.Context, token string, response *entities.Response, answers []*entities.ResponseAnswer) (string, error)
+
+	// GetDraft loads a pending response with its answers by resume token
+	GetDraft(ctx context.Context, token string) (*entities.Response, error)
+
+	// FinalizeDraft validates a draft completely and marks it submitted
+	FinalizeDraft(ctx context.Context, token string) (*entities.Response, error)
+
+	// PurgeDrafts deletes drafts not updated within maxAge and returns how many were removed
+	PurgeDrafts(ctx context.Context, maxAge time.Duration) (int64, error)
 }
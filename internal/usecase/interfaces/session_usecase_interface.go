@@ -0,0 +1,33 @@
+package interfaces
+
+import (
+	"context"
+
+	"Skillture_Form/internal/auth"
+	"Skillture_Form/internal/domain/entities"
+
+	"github.com/google/uuid"
+)
+
+// SessionUseCase manages admin login sessions and their tokens
+type SessionUseCase interface {
+	// Start creates a session for an admin who just logged in and issues
+	// its first token pair
+	Start(ctx context.Context, admin *entities.Admin, userAgent, ipAddress string) (*auth.TokenPair, error)
+
+	// Refresh exchanges the current refresh token of a session for a new
+	// pair. A refresh token that was already exchanged revokes the session.
+	Refresh(ctx context.Context, refreshToken, userAgent, ipAddress string) (*entities.Admin, *auth.TokenPair, error)
+
+	// Validate checks that the session of an access token is still active
+	Validate(ctx context.Context, adminID, sessionID uuid.UUID) error
+
+	// List returns the active sessions of an admin
+	List(ctx context.Context, adminID uuid.UUID) ([]*entities.AdminSession, error)
+
+	// Revoke ends one session of an admin
+	Revoke(ctx context.Context, adminID, sessionID uuid.UUID) error
+
+	// RevokeAll ends every session of an admin and returns how many
+	RevokeAll(ctx context.Context, adminID uuid.UUID) (int64, error)
+}
@@ -0,0 +1,53 @@
+package interfaces
+
+import (
+	"context"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/repository"
+	repo "Skillture_Form/internal/repository/interfaces"
+
+	"github.com/google/uuid"
+)
+
+// WebhookDeliveryPage is a page of a subscription's delivery log
+type WebhookDeliveryPage struct {
+	Data       []*entities.WebhookDelivery `json:"data"`
+	Pagination repository.PageInfo         `json:"pagination"`
+}
+
+// WebhookSubscriptionUpdate changes the set fields of a subscription
+type WebhookSubscriptionUpdate struct {
+	URL    *string
+	Secret *string // rotates the signing secret
+	Events []enums.WebhookEvent
+	Active *bool
+}
+
+// WebhookUseCase manages webhook subscriptions of forms and their delivery log
+type WebhookUseCase interface {
+
+	// CreateSubscription subscribes a URL to events of a form. A signing secret is
+	// generated when none is given; it is only returned here.
+	CreateSubscription(ctx context.Context, sub *entities.WebhookSubscription) error
+
+	// ListSubscriptions returns the subscriptions of a form without their secrets
+	ListSubscriptions(ctx context.Context, formID uuid.UUID) ([]*entities.WebhookSubscription, error)
+
+	// GetSubscription returns a subscription without its secret
+	GetSubscription(ctx context.Context, id uuid.UUID) (*entities.WebhookSubscription, error)
+
+	// UpdateSubscription applies update; pending deliveries keep their payload
+	UpdateSubscription(ctx context.Context, id uuid.UUID, update WebhookSubscriptionUpdate) (*entities.WebhookSubscription, error)
+
+	// DeleteSubscription removes a subscription and its delivery log
+	DeleteSubscription(ctx context.Context, id uuid.UUID) error
+
+	// ListDeliveries returns a page of a subscription's delivery log, newest first
+	ListDeliveries(ctx context.Context, filter repo.WebhookDeliveryFilter) (*WebhookDeliveryPage, error)
+
+	// Redeliver queues a delivered or dead-lettered delivery of a subscription
+	// for a fresh round of attempts
+	Redeliver(ctx context.Context, subscriptionID, deliveryID uuid.UUID) (*entities.WebhookDelivery, error)
+}
@@ -0,0 +1,28 @@
+package response
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+)
+
+// resumeTokenBytes is the entropy of a draft resume token
+const resumeTokenBytes = 32
+
+// newResumeToken generates an opaque resume token and the hash stored for it
+func newResumeToken() (token string, hash string, err error) {
+	buf := make([]byte, resumeTokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", err
+	}
+	token = base64.RawURLEncoding.EncodeToString(buf)
+	return token, hashResumeToken(token), nil
+}
+
+// hashResumeToken returns the hex SHA-256 of a resume token.
+// Only the hash is persisted, so a leaked database does not expose drafts.
+func hashResumeToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,261 @@
+package response
+
+import (
+	"context"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	repo "Skillture_Form/internal/repository/interfaces"
+	val "Skillture_Form/internal/validation"
+
+	"github.com/google/uuid"
+)
+
+// SaveDraft creates or updates a pending response with partial answers.
+// An empty token starts a new draft; otherwise the draft identified by
+// token is replaced. It returns the resume token for the draft.
+func (u *ResponseUsecase) SaveDraft(
+	ctx context.Context,
+	token string,
+	response *entities.Response,
+	answers []*entities.ResponseAnswer,
+) (string, error) {
+
+	// -------------------
+	// 1️⃣ Load existing draft
+	// -------------------
+	var existing *entities.Response
+	if token != "" {
+		draft, err := u.responseRepo.GetDraftByTokenHash(ctx, hashResumeToken(token))
+		if err != nil {
+			return "", err
+		}
+		if response.FormID != uuid.Nil && response.FormID != draft.FormID {
+			return "", domainErr.ErrInvalidInput
+		}
+		existing = draft
+		response.ID = draft.ID
+		response.FormID = draft.FormID
+	}
+
+	if response.FormID == uuid.Nil {
+		return "", entities.ErrMissingFormID
+	}
+
+	// -------------------
+	// 2️⃣ Form must accept responses & answers must fit its fields
+	// -------------------
+	form, err := u.formRepo.GetByID(ctx, response.FormID)
+	if err != nil {
+		return "", err
+	}
+	if err := val.ValidateResponseBusiness(response, form); err != nil {
+		return "", err
+	}
+
+	fields, err := u.formFieldRepo.List(ctx, repo.FormFieldFilter{FormID: &form.ID})
+	if err != nil {
+		return "", err
+	}
+	if err := val.ValidateDraftAnswers(fields, answers); err != nil {
+		return "", err
+	}
+
+	// -------------------
+	// 3️⃣ Transaction: Draft + Answers
+	// -------------------
+	now := time.Now()
+	response.Status = enums.ResponsePending
+	response.UpdatedAt = now
+
+	err = u.responseRepo.WithTx(ctx, func(txResponseRepo repo.ResponseRepository,
+		txAnswerRepo repo.ResponseAnswerRepository,
+		_ repo.ResponseAnswerVectorRepository,
+		_ repo.WebhookRepository,
+		_ repo.EmailJobRepository) error {
+
+		if existing == nil {
+			newToken, hash, err := newResumeToken()
+			if err != nil {
+				return err
+			}
+			token = newToken
+			response.ID = uuid.New()
+			response.ResumeTokenHash = hash
+			response.SubmittedAt = now
+
+			if err := txResponseRepo.Create(ctx, response); err != nil {
+				return err
+			}
+		} else {
+			response.ResumeTokenHash = existing.ResumeTokenHash
+			response.SubmittedAt = existing.SubmittedAt
+
+			// Fails if the draft was finalized since it was loaded
+			if err := txResponseRepo.UpdateDraft(ctx, response, existing.ResumeTokenHash); err != nil {
+				return err
+			}
+			if err := txAnswerRepo.DeleteByResponseID(ctx, response.ID); err != nil {
+				return err
+			}
+		}
+
+		return createAnswers(ctx, txAnswerRepo, response.ID, answers)
+	})
+	if err != nil {
+		return "", err
+	}
+
+	response.Answers = answers
+	return token, nil
+}
+
+// GetDraft loads a pending response and its answers by resume token
+func (u *ResponseUsecase) GetDraft(ctx context.Context, token string) (*entities.Response, error) {
+	if token == "" {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	draft, err := u.responseRepo.GetDraftByTokenHash(ctx, hashResumeToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	answers, err := u.answerRepo.List(ctx, repo.ResponseAnswerFilter{ResponseID: &draft.ID})
+	if err != nil {
+		return nil, err
+	}
+	draft.Answers = answers
+
+	return draft, nil
+}
+
+// FinalizeDraft runs full validation on a draft and marks it submitted.
+// The resume token stops working once the draft is submitted. The draft is
+// locked and its answers are read inside the transaction, so a concurrent
+// SaveDraft or FinalizeDraft cannot change or submit it in between.
+func (u *ResponseUsecase) FinalizeDraft(ctx context.Context, token string) (*entities.Response, error) {
+	if token == "" {
+		return nil, domainErr.ErrInvalidInput
+	}
+	hash := hashResumeToken(token)
+
+	// -------------------
+	// 1️⃣ Load the draft's form and fields
+	// -------------------
+	pending, err := u.responseRepo.GetDraftByTokenHash(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	form, err := u.formRepo.GetByID(ctx, pending.FormID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := u.formFieldRepo.List(ctx, repo.FormFieldFilter{FormID: &form.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	// -------------------
+	// 2️⃣ Lock, fully validate as on Submit, and flip status unless the
+	// draft duplicates a submitted response
+	// -------------------
+	var draft *entities.Response
+	err = u.responseRepo.WithTx(ctx, func(txResponseRepo repo.ResponseRepository,
+		txAnswerRepo repo.ResponseAnswerRepository,
+		_ repo.ResponseAnswerVectorRepository,
+		txWebhookRepo repo.WebhookRepository,
+		txEmailRepo repo.EmailJobRepository) error {
+
+		locked, err := txResponseRepo.LockDraft(ctx, hash)
+		if err != nil {
+			return err
+		}
+		answers, err := txAnswerRepo.List(ctx, repo.ResponseAnswerFilter{ResponseID: &locked.ID})
+		if err != nil {
+			return err
+		}
+		locked.Answers = answers
+
+		if err := val.ValidateResponseDomain(locked); err != nil {
+			return err
+		}
+		if err := validateSubmit(form, fields, locked, answers); err != nil {
+			return err
+		}
+
+		now := time.Now()
+		locked.Status = enums.ResponseSubmitted
+		locked.SubmittedAt = now
+		locked.UpdatedAt = now
+		locked.ResumeTokenHash = ""
+
+		// Reject or flag duplicates per the form's policy
+		flag, err := checkDuplicates(ctx, txResponseRepo, form, locked, now)
+		if err != nil {
+			return err
+		}
+		if err := txResponseRepo.UpdateDraft(ctx, locked, hash); err != nil {
+			return err
+		}
+		if flag != nil {
+			if err := u.flagRepo.WithTxRepo(txResponseRepo).Create(ctx, flag); err != nil {
+				return err
+			}
+		}
+		if err := queueWebhook(ctx, txWebhookRepo, enums.WebhookResponseSubmitted, locked); err != nil {
+			return err
+		}
+		if err := queueNotifications(ctx, txEmailRepo, form, fields, locked, answers); err != nil {
+			return err
+		}
+
+		draft = locked
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	u.enqueueEmbedding(draft.ID)
+
+	return draft, nil
+}
+
+// PurgeDrafts deletes drafts that have not been updated within maxAge
+func (u *ResponseUsecase) PurgeDrafts(ctx context.Context, maxAge time.Duration) (int64, error) {
+	if maxAge <= 0 {
+		return 0, domainErr.ErrInvalidInput
+	}
+	return u.responseRepo.DeleteDraftsBefore(ctx, time.Now().Add(-maxAge))
+}
+
+// createAnswers persists answers for a response inside a transaction
+func createAnswers(
+	ctx context.Context,
+	answerRepo repo.ResponseAnswerRepository,
+	responseID uuid.UUID,
+	answers []*entities.ResponseAnswer,
+) error {
+	for _, ans := range answers {
+		if ans.ID == uuid.Nil {
+			ans.ID = uuid.New()
+		}
+		ans.ResponseID = responseID
+		ans.CreatedAt = time.Now()
+
+		// Validate after ResponseID is set
+		if err := val.ValidateResponseAnswerDomain(ans); err != nil {
+			return err
+		}
+
+		if err := answerRepo.Create(ctx, ans); err != nil {
+			return err
+		}
+	}
+	return nil
+}
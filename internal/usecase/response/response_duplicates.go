@@ -0,0 +1,77 @@
+package response
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	domainErr "Skillture_Form/internal/domain/errors"
+	repo "Skillture_Form/internal/repository/interfaces"
+)
+
+// checkDuplicates applies the form's duplicate policy. A repeated email
+// rejects the submission; a repeated client fingerprint only returns a flag
+// for the caller to store once the response is written, because unrelated
+// respondents behind one network and browser share a fingerprint.
+// It runs inside the submit transaction: each rule first locks its key so a
+// concurrent submission with the same email or fingerprint waits for this one
+// to commit and then sees it.
+func checkDuplicates(
+	ctx context.Context,
+	responseRepo repo.ResponseRepository,
+	form *entities.Form,
+	response *entities.Response,
+	now time.Time,
+) (*entities.ResponseFlag, error) {
+	policy := form.DuplicatePolicy
+	if policy == nil {
+		return nil, nil
+	}
+
+	// Same respondent email, any time
+	if email := strings.TrimSpace(response.GetEmail()); policy.UniqueEmail && email != "" {
+		if err := responseRepo.LockSubmissionKey(ctx, form.ID, "email:"+strings.ToLower(email)); err != nil {
+			return nil, err
+		}
+		match, err := responseRepo.FindDuplicate(ctx, repo.DuplicateFilter{
+			FormID:    form.ID,
+			ExcludeID: response.ID,
+			Email:     &email,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if match != nil {
+			return nil, fmt.Errorf("%w: a response with this email was already submitted", domainErr.ErrDuplicateResponse)
+		}
+	}
+
+	// Same client within the window
+	if window := policy.FingerprintWindow(); window > 0 && response.Fingerprint != "" {
+		if err := responseRepo.LockSubmissionKey(ctx, form.ID, "fingerprint:"+response.Fingerprint); err != nil {
+			return nil, err
+		}
+		since := now.Add(-window)
+		match, err := responseRepo.FindDuplicate(ctx, repo.DuplicateFilter{
+			FormID:      form.ID,
+			ExcludeID:   response.ID,
+			Fingerprint: &response.Fingerprint,
+			Since:       &since,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if match != nil {
+			return &entities.ResponseFlag{
+				ResponseID:        response.ID,
+				Reason:            entities.FlagReasonSameFingerprint,
+				MatchedResponseID: match,
+				CreatedAt:         now,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
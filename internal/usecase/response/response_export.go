@@ -0,0 +1,54 @@
+package response
+
+import (
+	"context"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	"Skillture_Form/internal/export"
+	repo "Skillture_Form/internal/repository/interfaces"
+
+	"github.com/google/uuid"
+)
+
+// Export writes every submitted response of a form as one row, with one
+// column per field labelled in lang. Rows are streamed from the repository
+// and the writer is closed once all rows are written.
+func (u *ResponseUsecase) Export(ctx context.Context, formID uuid.UUID, lang string, w export.RowWriter) error {
+	if formID == uuid.Nil {
+		return domainErr.ErrInvalidInput
+	}
+	if _, err := u.authz.RequireForm(ctx, formID, enums.RoleViewer); err != nil {
+		return err
+	}
+
+	form, err := u.formRepo.GetByID(ctx, formID)
+	if err != nil {
+		return err
+	}
+
+	fields, err := u.formFieldRepo.List(ctx, repo.FormFieldFilter{FormID: &form.ID})
+	if err != nil {
+		return err
+	}
+
+	keys, err := u.responseRepo.ListRespondentKeys(ctx, form.ID)
+	if err != nil {
+		return err
+	}
+
+	table := export.NewResponseTable(fields, keys, lang)
+	if err := w.WriteRow(table.Header()); err != nil {
+		return err
+	}
+
+	err = u.responseRepo.StreamByFormID(ctx, form.ID, func(resp *entities.Response) error {
+		return w.WriteRow(table.Row(resp))
+	})
+	if err != nil {
+		return err
+	}
+
+	return w.Close()
+}
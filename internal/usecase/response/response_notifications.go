@@ -0,0 +1,33 @@
+package response
+
+import (
+	"context"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/notification"
+	repo "Skillture_Form/internal/repository/interfaces"
+)
+
+// queueNotifications renders the form's submission emails and queues them in
+// the surrounding transaction. They are sent after commit by the email
+// dispatcher, so a mail server outage delays the emails instead of failing
+// the submission.
+func queueNotifications(
+	ctx context.Context,
+	emailRepo repo.EmailJobRepository,
+	form *entities.Form,
+	fields []*entities.FormField,
+	response *entities.Response,
+	answers []*entities.ResponseAnswer,
+) error {
+	jobs, err := notification.ComposeSubmission(form, fields, response, answers)
+	if err != nil {
+		return err
+	}
+	for _, job := range jobs {
+		if err := emailRepo.Create(ctx, job); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package response
+
+import (
+	"context"
+	"time"
+
+	"Skillture_Form/internal/auth"
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	repo "Skillture_Form/internal/repository/interfaces"
+
+	"github.com/google/uuid"
+)
+
+// Review moves a response through the review workflow
+// (Submitted → Reviewed → Accepted/Rejected) on behalf of the admin in ctx
+func (u *ResponseUsecase) Review(
+	ctx context.Context,
+	id uuid.UUID,
+	status enums.ResponseStatus,
+	notes string,
+) (*entities.Response, error) {
+	if id == uuid.Nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	adminID, ok := auth.AdminIDFromContext(ctx)
+	if !ok {
+		return nil, entities.ErrMissingReviewer
+	}
+
+	response, err := u.getAuthorized(ctx, id, enums.RoleReviewer)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := response.Review(status, adminID, notes, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.responseRepo.WithTx(ctx, func(txResponseRepo repo.ResponseRepository,
+		_ repo.ResponseAnswerRepository,
+		_ repo.ResponseAnswerVectorRepository,
+		txWebhookRepo repo.WebhookRepository,
+		_ repo.EmailJobRepository) error {
+
+		if err := txResponseRepo.UpdateReview(ctx, response, change); err != nil {
+			return err
+		}
+		return queueWebhook(ctx, txWebhookRepo, enums.WebhookResponseReviewed, response)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// StatusHistory lists the status changes of a response, oldest first
+func (u *ResponseUsecase) StatusHistory(ctx context.Context, id uuid.UUID) ([]*entities.ResponseStatusChange, error) {
+	if id == uuid.Nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	if _, err := u.getAuthorized(ctx, id, enums.RoleViewer); err != nil {
+		return nil, err
+	}
+
+	return u.responseRepo.ListStatusHistory(ctx, id)
+}
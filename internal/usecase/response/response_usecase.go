@@ -7,7 +7,9 @@ import (
 
 	"Skillture_Form/internal/domain/entities"
 	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/repository"
 	repo "Skillture_Form/internal/repository/interfaces"
+	uc "Skillture_Form/internal/usecase/interfaces"
 	val "Skillture_Form/internal/validation"
 
 	"github.com/google/uuid"
@@ -20,15 +22,22 @@ type ResponseUsecase struct {
 	responseRepo  repo.ResponseRepository
 	answerRepo    repo.ResponseAnswerRepository
 	vectorRepo    repo.ResponseAnswerVectorRepository
+	flagRepo      repo.ResponseFlagRepository
+	embedQueue    uc.EmbeddingQueue
+	authz         uc.Authorizer
 }
 
-// NewResponseUsecase creates a new ResponseUsecase
+// NewResponseUsecase creates a new ResponseUsecase.
+// embedQueue may be nil to skip embedding submitted answers.
 func NewResponseUsecase(
 	formRepo repo.FormRepository,
 	formFieldRepo repo.FormFieldRepository,
 	responseRepo repo.ResponseRepository,
 	answerRepo repo.ResponseAnswerRepository,
 	vectorRepo repo.ResponseAnswerVectorRepository,
+	flagRepo repo.ResponseFlagRepository,
+	embedQueue uc.EmbeddingQueue,
+	authz uc.Authorizer,
 ) *ResponseUsecase {
 	return &ResponseUsecase{
 		formRepo:      formRepo,
@@ -36,6 +45,9 @@ func NewResponseUsecase(
 		responseRepo:  responseRepo,
 		answerRepo:    answerRepo,
 		vectorRepo:    vectorRepo,
+		flagRepo:      flagRepo,
+		embedQueue:    embedQueue,
+		authz:         authz,
 	}
 }
 
@@ -71,27 +83,26 @@ func (u *ResponseUsecase) Submit(
 		return err
 	}
 
-	if err := val.ValidateResponseBusiness(response, form); err != nil {
-		return err
-	}
-
 	// -------------------
-	// 3️⃣ Fetch form fields
+	// 3️⃣ Fetch form fields & validate answers against them
 	// -------------------
 	fields, err := u.formFieldRepo.List(ctx, repo.FormFieldFilter{FormID: &form.ID})
 	if err != nil {
 		return err
 	}
-	if len(fields) == 0 {
-		return errors.New("form has no fields")
+
+	if err := validateSubmit(form, fields, response, answers); err != nil {
+		return err
 	}
 
 	// -------------------
-	// 4️⃣ Transaction: Response + Answers + Vectors
+	// 4️⃣ Transaction: Response + Answers + Vectors + Webhook & email outbox
 	// -------------------
-	return u.responseRepo.WithTx(ctx, func(txResponseRepo repo.ResponseRepository,
+	err = u.responseRepo.WithTx(ctx, func(txResponseRepo repo.ResponseRepository,
 		txAnswerRepo repo.ResponseAnswerRepository,
-		txVectorRepo repo.ResponseAnswerVectorRepository) error {
+		txVectorRepo repo.ResponseAnswerVectorRepository,
+		txWebhookRepo repo.WebhookRepository,
+		txEmailRepo repo.EmailJobRepository) error {
 
 		// Response
 		if response.ID == uuid.Nil {
@@ -99,27 +110,26 @@ func (u *ResponseUsecase) Submit(
 		}
 		response.Status = enums.ResponseSubmitted
 		response.SubmittedAt = time.Now()
+		response.UpdatedAt = response.SubmittedAt
 
-		if err := txResponseRepo.Create(ctx, response); err != nil {
+		// Reject or flag duplicates per the form's policy
+		flag, err := checkDuplicates(ctx, txResponseRepo, form, response, response.SubmittedAt)
+		if err != nil {
 			return err
 		}
 
-		// Answers
-		for _, ans := range answers {
-			if ans.ID == uuid.Nil {
-				ans.ID = uuid.New()
-			}
-			ans.ResponseID = response.ID
-			ans.CreatedAt = time.Now()
-
-			// Validate after ResponseID is set
-			if err := val.ValidateResponseAnswerDomain(ans); err != nil {
+		if err := txResponseRepo.Create(ctx, response); err != nil {
+			return err
+		}
+		if flag != nil {
+			if err := u.flagRepo.WithTxRepo(txResponseRepo).Create(ctx, flag); err != nil {
 				return err
 			}
+		}
 
-			if err := txAnswerRepo.Create(ctx, ans); err != nil {
-				return err
-			}
+		// Answers
+		if err := createAnswers(ctx, txAnswerRepo, response.ID, answers); err != nil {
+			return err
 		}
 
 		// Vectors
@@ -135,8 +145,31 @@ func (u *ResponseUsecase) Submit(
 			}
 		}
 
-		return nil
+		// Webhooks carry the answers without changing what Submit returns
+		submitted := *response
+		submitted.Answers = answers
+		if err := queueWebhook(ctx, txWebhookRepo, enums.WebhookResponseSubmitted, &submitted); err != nil {
+			return err
+		}
+		return queueNotifications(ctx, txEmailRepo, form, fields, response, answers)
 	})
+	if err != nil {
+		return err
+	}
+
+	// -------------------
+	// 5️⃣ Embed text answers once committed
+	// -------------------
+	u.enqueueEmbedding(response.ID)
+
+	return nil
+}
+
+// enqueueEmbedding schedules embedding of a committed response's answers
+func (u *ResponseUsecase) enqueueEmbedding(responseID uuid.UUID) {
+	if u.embedQueue != nil {
+		u.embedQueue.Enqueue(responseID)
+	}
 }
 
 // GetByID retrieves a single response
@@ -144,31 +177,75 @@ func (u *ResponseUsecase) GetByID(ctx context.Context, id uuid.UUID) (*entities.
 	if id == uuid.Nil {
 		return nil, errors.New("response id is required")
 	}
-	return u.responseRepo.GetByID(ctx, id)
+	return u.getAuthorized(ctx, id, enums.RoleViewer)
 }
 
-// ListByForm lists all responses of a form with their answers
-func (u *ResponseUsecase) ListByForm(ctx context.Context, formID uuid.UUID) ([]*entities.Response, error) {
-	if formID == uuid.Nil {
+// getAuthorized loads a response once the admin in ctx holds role on its form
+func (u *ResponseUsecase) getAuthorized(ctx context.Context, id uuid.UUID, role enums.AdminRole) (*entities.Response, error) {
+	response, err := u.responseRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.authz.RequireForm(ctx, response.FormID, role); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// ListByForm returns a page of a form's responses with their answers.
+// Answers for the whole page are fetched in a single query.
+func (u *ResponseUsecase) ListByForm(ctx context.Context, filter repo.ResponseFilter) (*uc.ResponsePage, error) {
+	if filter.FormID == uuid.Nil {
 		return nil, errors.New("form id is required")
 	}
-	responses, err := u.responseRepo.ListByFormID(ctx, formID)
+	if _, err := u.authz.RequireForm(ctx, filter.FormID, enums.RoleViewer); err != nil {
+		return nil, err
+	}
+	filter.Pagination.Normalize()
+
+	responses, total, err := u.responseRepo.List(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
 
-	// Enrich each response with its answers
-	for _, resp := range responses {
-		answers, err := u.answerRepo.List(ctx, repo.ResponseAnswerFilter{
-			ResponseID: &resp.ID,
-		})
+	// Enrich the page with answers and flags in batched queries
+	if len(responses) > 0 {
+		ids := make([]uuid.UUID, len(responses))
+		byID := make(map[uuid.UUID]*entities.Response, len(responses))
+		for i, resp := range responses {
+			ids[i] = resp.ID
+			byID[resp.ID] = resp
+		}
+
+		answers, err := u.answerRepo.List(ctx, repo.ResponseAnswerFilter{ResponseIDs: ids})
 		if err != nil {
 			return nil, err
 		}
-		resp.Answers = answers
+		for _, ans := range answers {
+			if resp, ok := byID[ans.ResponseID]; ok {
+				resp.Answers = append(resp.Answers, ans)
+			}
+		}
+
+		flags, err := u.flagRepo.ListByResponseIDs(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for _, flag := range flags {
+			if resp, ok := byID[flag.ResponseID]; ok {
+				resp.Flags = append(resp.Flags, flag)
+			}
+		}
 	}
 
-	return responses, nil
+	return &uc.ResponsePage{
+		Data: responses,
+		Pagination: repository.PageInfo{
+			Total:  total,
+			Limit:  filter.Pagination.Limit,
+			Offset: filter.Pagination.Offset,
+		},
+	}, nil
 }
 
 // Delete removes a response
@@ -177,7 +254,8 @@ func (u *ResponseUsecase) Delete(ctx context.Context, id uuid.UUID) error {
 		return errors.New("response id is required")
 	}
 
-	_, err := u.responseRepo.GetByID(ctx, id)
+	// Reviewers may read responses but only editors delete them
+	_, err := u.getAuthorized(ctx, id, enums.RoleEditor)
 	if err != nil {
 		return err
 	}
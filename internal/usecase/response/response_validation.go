@@ -2,11 +2,12 @@ package response
 
 import (
 	"Skillture_Form/internal/domain/entities"
-	"Skillture_Form/internal/domain/enums"
 	domainErr "Skillture_Form/internal/domain/errors"
+	val "Skillture_Form/internal/validation"
 )
 
-// validateSubmit validates high-level submit rules
+// validateSubmit validates high-level submit rules and every answer
+// against the form's field definitions
 func validateSubmit(
 	form *entities.Form,
 	fields []*entities.FormField,
@@ -14,32 +15,13 @@ func validateSubmit(
 	answers []*entities.ResponseAnswer,
 ) error {
 
-	if form == nil {
-		return domainErr.ErrNotFound
-	}
-
-	if form.Status != enums.FormStatusPublished {
-		return domainErr.ErrFormNotPublished
+	if err := val.ValidateResponseBusiness(response, form); err != nil {
+		return err
 	}
 
 	if len(fields) == 0 {
 		return domainErr.ErrInvalidInput
 	}
 
-	required := map[string]bool{}
-	for _, f := range fields {
-		if f.Required {
-			required[f.ID.String()] = true
-		}
-	}
-
-	for _, a := range answers {
-		delete(required, a.FieldID.String())
-	}
-
-	if len(required) > 0 {
-		return domainErr.ErrMissingRequiredField
-	}
-
-	return nil
+	return val.ValidateAnswers(fields, answers)
 }
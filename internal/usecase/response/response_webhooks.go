@@ -0,0 +1,21 @@
+package response
+
+import (
+	"context"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	repo "Skillture_Form/internal/repository/interfaces"
+)
+
+// queueWebhook stores a response event in the webhook outbox of the surrounding
+// transaction, so subscribers hear about exactly the changes that were committed
+func queueWebhook(
+	ctx context.Context,
+	webhookRepo repo.WebhookRepository,
+	event enums.WebhookEvent,
+	response *entities.Response,
+) error {
+	_, err := webhookRepo.Enqueue(ctx, entities.NewWebhookEvent(event, response.FormID, response))
+	return err
+}
@@ -0,0 +1,273 @@
+package session
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"Skillture_Form/internal/auth"
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	repo "Skillture_Form/internal/repository/interfaces"
+	uc "Skillture_Form/internal/usecase/interfaces"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// maxUserAgentLength caps the stored user agent of a session
+const maxUserAgentLength = 512
+
+// sessionUseCase implements the SessionUseCase interface
+type sessionUseCase struct {
+	adminRepo   repo.AdminRepository
+	sessionRepo repo.AdminSessionRepository
+	auditRepo   repo.AuditRepository
+	authz       uc.Authorizer
+	tokens      *auth.TokenManager
+}
+
+// NewSessionUseCase creates a SessionUseCase
+func NewSessionUseCase(
+	adminRepo repo.AdminRepository,
+	sessionRepo repo.AdminSessionRepository,
+	auditRepo repo.AuditRepository,
+	authz uc.Authorizer,
+	tokens *auth.TokenManager,
+) uc.SessionUseCase {
+	return &sessionUseCase{
+		adminRepo:   adminRepo,
+		sessionRepo: sessionRepo,
+		auditRepo:   auditRepo,
+		authz:       authz,
+		tokens:      tokens,
+	}
+}
+
+// Start creates a session and issues its tokens. Only the hash of the
+// refresh token is stored.
+func (u *sessionUseCase) Start(ctx context.Context, admin *entities.Admin, userAgent, ipAddress string) (*auth.TokenPair, error) {
+	session := &entities.AdminSession{
+		ID:        uuid.New(),
+		AdminID:   admin.ID,
+		UserAgent: truncate(userAgent, maxUserAgentLength),
+		IPAddress: ipAddress,
+	}
+
+	pair, err := u.tokens.IssuePair(admin, session.ID)
+	if err != nil {
+		return nil, err
+	}
+	session.RefreshTokenHash = hashToken(pair.RefreshToken)
+	session.CreatedAt = time.Now()
+	session.LastUsedAt = session.CreatedAt
+	session.ExpiresAt = pair.RefreshExpiresAt
+
+	if err := u.sessionRepo.Create(ctx, session); err != nil {
+		return nil, err
+	}
+	return pair, nil
+}
+
+// Refresh rotates the refresh token of a session. Every exchange issues a new
+// refresh token and invalidates the old one, so a validly signed token of the
+// session that is no longer current was used before: either by the client or
+// by someone who stole it. As they cannot be told apart, the whole session is
+// revoked and the admin has to log in again.
+func (u *sessionUseCase) Refresh(ctx context.Context, refreshToken, userAgent, ipAddress string) (*entities.Admin, *auth.TokenPair, error) {
+	now := time.Now()
+
+	// -------------------
+	// 1️⃣ Verify the token and load its session
+	// -------------------
+	claims, err := u.tokens.ParseRefresh(refreshToken)
+	if err != nil {
+		return nil, nil, err
+	}
+	adminID, _ := claims.AdminID()
+	sessionID, _ := claims.SessionID()
+
+	session, err := u.sessionRepo.GetByID(ctx, sessionID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil, entities.ErrSessionRevoked
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	if session.AdminID != adminID || !session.IsActive(now) {
+		return nil, nil, entities.ErrSessionRevoked
+	}
+
+	// -------------------
+	// 2️⃣ A token that is no longer current revokes the session
+	// -------------------
+	oldHash := hashToken(refreshToken)
+	if session.RefreshTokenHash != oldHash {
+		return nil, nil, u.refreshReused(ctx, session, ipAddress, now)
+	}
+
+	admin, err := u.adminRepo.GetByID(ctx, adminID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if admin == nil || !admin.CanLogin() {
+		return nil, nil, entities.ErrSessionRevoked
+	}
+
+	// -------------------
+	// 3️⃣ Rotate; of two refreshes with the same token only one wins
+	// -------------------
+	pair, err := u.tokens.IssuePair(admin, session.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	rotated, err := u.sessionRepo.Rotate(ctx, session.ID, oldHash, hashToken(pair.RefreshToken),
+		truncate(userAgent, maxUserAgentLength), ipAddress, now, pair.RefreshExpiresAt)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !rotated {
+		return nil, nil, u.refreshReused(ctx, session, ipAddress, now)
+	}
+
+	return admin, pair, nil
+}
+
+// Validate refuses access tokens of sessions that were revoked or expired
+// since the token was issued
+func (u *sessionUseCase) Validate(ctx context.Context, adminID, sessionID uuid.UUID) error {
+	session, err := u.sessionRepo.GetByID(ctx, sessionID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entities.ErrSessionRevoked
+	}
+	if err != nil {
+		return err
+	}
+	if session.AdminID != adminID || !session.IsActive(time.Now()) {
+		return entities.ErrSessionRevoked
+	}
+	return nil
+}
+
+// List returns the active sessions of an admin to itself or to an owner,
+// marking the session of the request as current
+func (u *sessionUseCase) List(ctx context.Context, adminID uuid.UUID) ([]*entities.AdminSession, error) {
+	if _, err := u.authorize(ctx, adminID); err != nil {
+		return nil, err
+	}
+
+	sessions, err := u.sessionRepo.ListActive(ctx, adminID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if current, ok := auth.SessionIDFromContext(ctx); ok {
+		for _, s := range sessions {
+			s.Current = s.ID == current
+		}
+	}
+	return sessions, nil
+}
+
+// Revoke ends one session of an admin, e.g. to log out or to sign out a
+// lost device. Admins manage their own sessions; owners manage everyone's.
+func (u *sessionUseCase) Revoke(ctx context.Context, adminID, sessionID uuid.UUID) error {
+	actor, err := u.authorize(ctx, adminID)
+	if err != nil {
+		return err
+	}
+
+	revoked, err := u.sessionRepo.Revoke(ctx, sessionID, adminID, enums.SessionRevokedByAdmin, time.Now())
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return domainErr.ErrNotFound
+	}
+
+	return u.auditRepo.Record(ctx, &entities.AuditEvent{
+		Event:   enums.AuditSessionRevoked,
+		AdminID: &adminID,
+		ActorID: &actor,
+		Details: map[string]any{"session_id": sessionID},
+	})
+}
+
+// RevokeAll ends every session of an admin, including the one of the request
+func (u *sessionUseCase) RevokeAll(ctx context.Context, adminID uuid.UUID) (int64, error) {
+	actor, err := u.authorize(ctx, adminID)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := u.sessionRepo.RevokeAll(ctx, adminID, uuid.Nil, enums.SessionRevokedByAdmin, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	if err := u.auditRepo.Record(ctx, &entities.AuditEvent{
+		Event:   enums.AuditSessionRevoked,
+		AdminID: &adminID,
+		ActorID: &actor,
+		Details: map[string]any{"sessions": count},
+	}); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// authorize lets admins act on their own sessions and owners on anyone's,
+// and returns the acting admin
+func (u *sessionUseCase) authorize(ctx context.Context, adminID uuid.UUID) (uuid.UUID, error) {
+	actorID, ok := auth.AdminIDFromContext(ctx)
+	if !ok {
+		return uuid.Nil, domainErr.ErrUnauthenticated
+	}
+	if actorID != adminID {
+		if _, err := u.authz.RequireRole(ctx, enums.RoleOwner); err != nil {
+			return uuid.Nil, err
+		}
+	}
+	return actorID, nil
+}
+
+// refreshReused revokes a session whose refresh token was presented after
+// being rotated, and returns the error for the client
+func (u *sessionUseCase) refreshReused(ctx context.Context, session *entities.AdminSession, ipAddress string, now time.Time) error {
+	revoked, err := u.sessionRepo.Revoke(ctx, session.ID, session.AdminID, enums.SessionRevokedReuse, now)
+	if err != nil {
+		return err
+	}
+
+	// Several replays may race; only the one that revoked records it
+	if revoked {
+		if err := u.auditRepo.Record(ctx, &entities.AuditEvent{
+			Event:     enums.AuditRefreshTokenReuse,
+			AdminID:   &session.AdminID,
+			ClientIP:  ipAddress,
+			Details:   map[string]any{"session_id": session.ID},
+			CreatedAt: now,
+		}); err != nil {
+			return err
+		}
+	}
+	return entities.ErrRefreshTokenReused
+}
+
+// hashToken returns the hex SHA-256 of a refresh token
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// truncate shortens s to at most n bytes without splitting a character
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return strings.ToValidUTF8(s[:n], "")
+}
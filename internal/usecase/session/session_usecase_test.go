@@ -0,0 +1,250 @@
+package session
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"Skillture_Form/internal/auth"
+	"Skillture_Form/internal/config"
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	repo "Skillture_Form/internal/repository/interfaces"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeAdminRepo struct {
+	repo.AdminRepository
+	admins map[uuid.UUID]*entities.Admin
+}
+
+func (f *fakeAdminRepo) GetByID(_ context.Context, id uuid.UUID) (*entities.Admin, error) {
+	return f.admins[id], nil
+}
+
+type fakeSessionRepo struct {
+	sessions map[uuid.UUID]*entities.AdminSession
+}
+
+func (f *fakeSessionRepo) Create(_ context.Context, s *entities.AdminSession) error {
+	f.sessions[s.ID] = s
+	return nil
+}
+
+func (f *fakeSessionRepo) GetByID(_ context.Context, id uuid.UUID) (*entities.AdminSession, error) {
+	s, ok := f.sessions[id]
+	if !ok {
+		return nil, pgx.ErrNoRows
+	}
+	copied := *s
+	return &copied, nil
+}
+
+func (f *fakeSessionRepo) ListActive(_ context.Context, adminID uuid.UUID, now time.Time) ([]*entities.AdminSession, error) {
+	var out []*entities.AdminSession
+	for _, s := range f.sessions {
+		if s.AdminID == adminID && s.IsActive(now) {
+			copied := *s
+			out = append(out, &copied)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeSessionRepo) Rotate(_ context.Context, id uuid.UUID, oldHash, newHash, _, _ string, now, expiresAt time.Time) (bool, error) {
+	s, ok := f.sessions[id]
+	if !ok || s.RefreshTokenHash != oldHash || !s.IsActive(now) {
+		return false, nil
+	}
+	s.RefreshTokenHash, s.LastUsedAt, s.ExpiresAt = newHash, now, expiresAt
+	return true, nil
+}
+
+func (f *fakeSessionRepo) Revoke(_ context.Context, id, adminID uuid.UUID, reason enums.SessionRevokeReason, now time.Time) (bool, error) {
+	s, ok := f.sessions[id]
+	if !ok || s.AdminID != adminID || s.RevokedAt != nil {
+		return false, nil
+	}
+	s.RevokedAt, s.RevokeReason = &now, reason
+	return true, nil
+}
+
+func (f *fakeSessionRepo) RevokeAll(_ context.Context, adminID, keep uuid.UUID, reason enums.SessionRevokeReason, now time.Time) (int64, error) {
+	var n int64
+	for _, s := range f.sessions {
+		if s.AdminID == adminID && s.ID != keep && s.RevokedAt == nil {
+			s.RevokedAt, s.RevokeReason = &now, reason
+			n++
+		}
+	}
+	return n, nil
+}
+
+type fakeAuditRepo struct {
+	events []*entities.AuditEvent
+}
+
+func (f *fakeAuditRepo) Record(_ context.Context, e *entities.AuditEvent) error {
+	f.events = append(f.events, e)
+	return nil
+}
+
+type fakeAuthorizer struct {
+	owners map[uuid.UUID]bool
+}
+
+func (f *fakeAuthorizer) RequireRole(ctx context.Context, _ enums.AdminRole) (*entities.Admin, error) {
+	id, _ := auth.AdminIDFromContext(ctx)
+	if !f.owners[id] {
+		return nil, domainErr.ErrForbidden
+	}
+	return &entities.Admin{ID: id, Role: enums.RoleOwner}, nil
+}
+
+func (f *fakeAuthorizer) RequireForm(context.Context, uuid.UUID, enums.AdminRole) (*entities.Admin, error) {
+	return nil, domainErr.ErrForbidden
+}
+
+func (f *fakeAuthorizer) FormScope(context.Context) (*uuid.UUID, error) {
+	return nil, domainErr.ErrForbidden
+}
+
+func newTestUseCase(admins ...*entities.Admin) (*sessionUseCase, *fakeSessionRepo, *fakeAuditRepo) {
+	byID := map[uuid.UUID]*entities.Admin{}
+	owners := map[uuid.UUID]bool{}
+	for _, a := range admins {
+		byID[a.ID] = a
+		owners[a.ID] = a.Role == enums.RoleOwner
+	}
+
+	sessions := &fakeSessionRepo{sessions: map[uuid.UUID]*entities.AdminSession{}}
+	audit := &fakeAuditRepo{}
+	tokens := auth.NewTokenManager(config.JWTConfig{
+		Secret:            strings.Repeat("s", 32),
+		Issuer:            "test-issuer",
+		AccessExpireMin:   15,
+		RefreshExpireDays: 7,
+	})
+
+	u := NewSessionUseCase(&fakeAdminRepo{admins: byID}, sessions, audit, &fakeAuthorizer{owners: owners}, tokens)
+	return u.(*sessionUseCase), sessions, audit
+}
+
+func TestSessionUseCase_RefreshRotation(t *testing.T) {
+	admin := &entities.Admin{ID: uuid.New(), Username: "admin"}
+	u, sessions, audit := newTestUseCase(admin)
+	ctx := context.Background()
+
+	first, err := u.Start(ctx, admin, "test-agent", "10.0.0.1")
+	require.NoError(t, err)
+	require.Len(t, sessions.sessions, 1)
+
+	claims, err := u.tokens.ParseAccess(first.AccessToken)
+	require.NoError(t, err)
+	sessionID, err := claims.SessionID()
+	require.NoError(t, err)
+	require.NotEqual(t, first.RefreshToken, sessions.sessions[sessionID].RefreshTokenHash, "only the hash is stored")
+	require.NoError(t, u.Validate(ctx, admin.ID, sessionID))
+
+	// ===== Rotation =====
+	_, second, err := u.Refresh(ctx, first.RefreshToken, "test-agent", "10.0.0.1")
+	require.NoError(t, err)
+	require.NotEqual(t, first.RefreshToken, second.RefreshToken)
+
+	_, third, err := u.Refresh(ctx, second.RefreshToken, "test-agent", "10.0.0.1")
+	require.NoError(t, err)
+
+	// ===== Reuse of a rotated token revokes the whole session =====
+	_, _, err = u.Refresh(ctx, first.RefreshToken, "attacker", "10.0.0.2")
+	require.ErrorIs(t, err, entities.ErrRefreshTokenReused)
+	require.Equal(t, enums.SessionRevokedReuse, sessions.sessions[sessionID].RevokeReason)
+	require.Len(t, audit.events, 1)
+	require.Equal(t, enums.AuditRefreshTokenReuse, audit.events[0].Event)
+	require.Equal(t, "10.0.0.2", audit.events[0].ClientIP)
+
+	// The latest token and the session's access tokens stop working too
+	_, _, err = u.Refresh(ctx, third.RefreshToken, "test-agent", "10.0.0.1")
+	require.ErrorIs(t, err, entities.ErrSessionRevoked)
+	require.ErrorIs(t, u.Validate(ctx, admin.ID, sessionID), entities.ErrSessionRevoked)
+}
+
+func TestSessionUseCase_RefreshRejects(t *testing.T) {
+	admin := &entities.Admin{ID: uuid.New(), Username: "admin"}
+	u, sessions, _ := newTestUseCase(admin)
+	ctx := context.Background()
+
+	pair, err := u.Start(ctx, admin, "", "")
+	require.NoError(t, err)
+
+	// ===== Access token used as refresh token =====
+	_, _, err = u.Refresh(ctx, pair.AccessToken, "", "")
+	require.ErrorIs(t, err, auth.ErrWrongTokenType)
+
+	// ===== Session of a deleted admin =====
+	delete(u.adminRepo.(*fakeAdminRepo).admins, admin.ID)
+	_, _, err = u.Refresh(ctx, pair.RefreshToken, "", "")
+	require.ErrorIs(t, err, entities.ErrSessionRevoked)
+
+	// ===== Unknown session =====
+	sessions.sessions = map[uuid.UUID]*entities.AdminSession{}
+	_, _, err = u.Refresh(ctx, pair.RefreshToken, "", "")
+	require.ErrorIs(t, err, entities.ErrSessionRevoked)
+}
+
+func TestSessionUseCase_ListAndRevoke(t *testing.T) {
+	owner := &entities.Admin{ID: uuid.New(), Username: "owner", Role: enums.RoleOwner}
+	viewer := &entities.Admin{ID: uuid.New(), Username: "viewer", Role: enums.RoleViewer}
+	u, _, _ := newTestUseCase(owner, viewer)
+	ctx := context.Background()
+
+	var viewerSessions []uuid.UUID
+	for range 2 {
+		pair, err := u.Start(ctx, viewer, "", "")
+		require.NoError(t, err)
+		claims, err := u.tokens.ParseAccess(pair.AccessToken)
+		require.NoError(t, err)
+		id, _ := claims.SessionID()
+		viewerSessions = append(viewerSessions, id)
+	}
+	_, err := u.Start(ctx, owner, "", "")
+	require.NoError(t, err)
+
+	asViewer := auth.WithSessionID(auth.WithAdminID(ctx, viewer.ID), viewerSessions[0])
+	asOwner := auth.WithAdminID(ctx, owner.ID)
+
+	// ===== Own sessions, with the current one marked =====
+	list, err := u.List(asViewer, viewer.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	for _, s := range list {
+		require.Equal(t, s.ID == viewerSessions[0], s.Current)
+	}
+
+	// ===== Other admins' sessions need an owner =====
+	_, err = u.List(asViewer, owner.ID)
+	require.ErrorIs(t, err, domainErr.ErrForbidden)
+	err = u.Revoke(asViewer, owner.ID, uuid.New())
+	require.ErrorIs(t, err, domainErr.ErrForbidden)
+
+	// ===== Revoke one, then all =====
+	require.NoError(t, u.Revoke(asViewer, viewer.ID, viewerSessions[1]))
+	require.ErrorIs(t, u.Revoke(asViewer, viewer.ID, viewerSessions[1]), domainErr.ErrNotFound)
+	require.ErrorIs(t, u.Validate(ctx, viewer.ID, viewerSessions[1]), entities.ErrSessionRevoked)
+
+	count, err := u.RevokeAll(asOwner, viewer.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+	list, err = u.List(asOwner, viewer.ID)
+	require.NoError(t, err)
+	require.Empty(t, list)
+
+	// The owner's own session is untouched
+	list, err = u.List(asOwner, owner.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+}
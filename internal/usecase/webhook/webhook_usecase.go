@@ -0,0 +1,234 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	"Skillture_Form/internal/repository"
+	repo "Skillture_Form/internal/repository/interfaces"
+	uc "Skillture_Form/internal/usecase/interfaces"
+	val "Skillture_Form/internal/validation"
+
+	"github.com/google/uuid"
+)
+
+// webhookUseCase implements the WebhookUseCase interface
+type webhookUseCase struct {
+	formRepo    repo.FormRepository
+	webhookRepo repo.WebhookRepository
+	authz       uc.Authorizer
+}
+
+// NewWebhookUseCase creates a WebhookUseCase
+func NewWebhookUseCase(formRepo repo.FormRepository, webhookRepo repo.WebhookRepository, authz uc.Authorizer) uc.WebhookUseCase {
+	return &webhookUseCase{
+		formRepo:    formRepo,
+		webhookRepo: webhookRepo,
+		authz:       authz,
+	}
+}
+
+// CreateSubscription validates and stores a new active subscription
+func (u *webhookUseCase) CreateSubscription(ctx context.Context, sub *entities.WebhookSubscription) error {
+
+	// -------------------
+	// 1️⃣ Check access and that the form exists
+	// -------------------
+	if _, err := u.authz.RequireForm(ctx, sub.FormID, enums.RoleEditor); err != nil {
+		return err
+	}
+	if _, err := u.formRepo.GetByID(ctx, sub.FormID); err != nil {
+		return err
+	}
+
+	// -------------------
+	// 2️⃣ Validate, generating a secret if needed
+	// -------------------
+	if sub.Secret == "" {
+		secret, err := newSecret()
+		if err != nil {
+			return err
+		}
+		sub.Secret = secret
+	}
+	if err := val.ValidateWebhookSubscription(sub); err != nil {
+		return err
+	}
+
+	// -------------------
+	// 3️⃣ Persist
+	// -------------------
+	sub.ID = uuid.New()
+	sub.Active = true
+	sub.CreatedAt = time.Now()
+	sub.UpdatedAt = sub.CreatedAt
+
+	return u.webhookRepo.CreateSubscription(ctx, sub)
+}
+
+// ListSubscriptions returns a form's subscriptions
+func (u *webhookUseCase) ListSubscriptions(ctx context.Context, formID uuid.UUID) ([]*entities.WebhookSubscription, error) {
+	if _, err := u.authz.RequireForm(ctx, formID, enums.RoleEditor); err != nil {
+		return nil, err
+	}
+	if _, err := u.formRepo.GetByID(ctx, formID); err != nil {
+		return nil, err
+	}
+
+	subs, err := u.webhookRepo.ListSubscriptions(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+	for _, sub := range subs {
+		sub.Secret = ""
+	}
+	return subs, nil
+}
+
+// GetSubscription returns a subscription by ID
+func (u *webhookUseCase) GetSubscription(ctx context.Context, id uuid.UUID) (*entities.WebhookSubscription, error) {
+	if id == uuid.Nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	sub, err := u.getSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	sub.Secret = ""
+	return sub, nil
+}
+
+// getSubscription loads a subscription once the admin in ctx may edit its form
+func (u *webhookUseCase) getSubscription(ctx context.Context, id uuid.UUID) (*entities.WebhookSubscription, error) {
+	sub, err := u.webhookRepo.GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := u.authz.RequireForm(ctx, sub.FormID, enums.RoleEditor); err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// UpdateSubscription changes the set fields and validates the result
+func (u *webhookUseCase) UpdateSubscription(
+	ctx context.Context,
+	id uuid.UUID,
+	update uc.WebhookSubscriptionUpdate,
+) (*entities.WebhookSubscription, error) {
+	if id == uuid.Nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+
+	sub, err := u.getSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if update.URL != nil {
+		sub.URL = *update.URL
+	}
+	if update.Secret != nil {
+		sub.Secret = *update.Secret
+	}
+	if update.Events != nil {
+		sub.Events = update.Events
+	}
+	if update.Active != nil {
+		sub.Active = *update.Active
+	}
+	if err := val.ValidateWebhookSubscription(sub); err != nil {
+		return nil, err
+	}
+	sub.UpdatedAt = time.Now()
+
+	if err := u.webhookRepo.UpdateSubscription(ctx, sub); err != nil {
+		return nil, err
+	}
+
+	sub.Secret = ""
+	return sub, nil
+}
+
+// DeleteSubscription removes a subscription
+func (u *webhookUseCase) DeleteSubscription(ctx context.Context, id uuid.UUID) error {
+	if id == uuid.Nil {
+		return domainErr.ErrInvalidInput
+	}
+	if _, err := u.getSubscription(ctx, id); err != nil {
+		return err
+	}
+	return u.webhookRepo.DeleteSubscription(ctx, id)
+}
+
+// ListDeliveries returns a page of the delivery log of an existing subscription
+func (u *webhookUseCase) ListDeliveries(ctx context.Context, filter repo.WebhookDeliveryFilter) (*uc.WebhookDeliveryPage, error) {
+	if filter.SubscriptionID == uuid.Nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+	filter.Pagination.Normalize()
+
+	if _, err := u.getSubscription(ctx, filter.SubscriptionID); err != nil {
+		return nil, err
+	}
+
+	deliveries, total, err := u.webhookRepo.ListDeliveries(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &uc.WebhookDeliveryPage{
+		Data: deliveries,
+		Pagination: repository.PageInfo{
+			Total:  total,
+			Limit:  filter.Pagination.Limit,
+			Offset: filter.Pagination.Offset,
+		},
+	}, nil
+}
+
+// Redeliver resets a finished delivery so the dispatcher sends it again with the original payload
+func (u *webhookUseCase) Redeliver(ctx context.Context, subscriptionID, deliveryID uuid.UUID) (*entities.WebhookDelivery, error) {
+	if subscriptionID == uuid.Nil || deliveryID == uuid.Nil {
+		return nil, domainErr.ErrInvalidInput
+	}
+	if _, err := u.getSubscription(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+
+	delivery, err := u.webhookRepo.GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if delivery.SubscriptionID != subscriptionID {
+		return nil, domainErr.ErrNotFound
+	}
+	if delivery.Status == enums.WebhookDeliveryPending {
+		return nil, entities.ErrDeliveryPending
+	}
+
+	delivery.Status = enums.WebhookDeliveryPending
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = time.Now()
+	delivery.DeliveredAt = nil
+
+	if err := u.webhookRepo.UpdateDelivery(ctx, delivery); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// newSecret returns a random 256-bit signing secret
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
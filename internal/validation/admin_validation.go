@@ -1 +1,95 @@
 package validation
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"Skillture_Form/internal/domain/entities"
+)
+
+// MaxPasswordLength is the number of bytes bcrypt hashes
+const MaxPasswordLength = 72
+
+// commonPasswords are refused regardless of the configured banned list
+var commonPasswords = []string{
+	"password", "password1", "password123", "passw0rd", "p@ssw0rd",
+	"123456", "12345678", "123456789", "1234567890", "qwerty", "qwerty123",
+	"qwertyuiop", "abc123", "111111", "000000", "iloveyou", "letmein",
+	"welcome", "welcome1", "admin", "admin123", "administrator", "changeme",
+	"monkey", "dragon", "football", "sunshine", "trustno1",
+}
+
+// PasswordPolicy is the strength an admin password must meet
+type PasswordPolicy struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	Banned        []string // Compared case-insensitively, with the common passwords
+}
+
+// ValidatePassword checks a new password of the admin called username
+// against the policy. The error lists every rule the password breaks.
+func ValidatePassword(policy PasswordPolicy, username, password string) error {
+	var problems []string
+
+	if n := len([]rune(password)); n < policy.MinLength {
+		problems = append(problems, fmt.Sprintf("be at least %d characters", policy.MinLength))
+	}
+	if len(password) > MaxPasswordLength {
+		problems = append(problems, fmt.Sprintf("be at most %d bytes", MaxPasswordLength))
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			symbol = true
+		}
+	}
+	if policy.RequireUpper && !upper {
+		problems = append(problems, "contain an uppercase letter")
+	}
+	if policy.RequireLower && !lower {
+		problems = append(problems, "contain a lowercase letter")
+	}
+	if policy.RequireDigit && !digit {
+		problems = append(problems, "contain a digit")
+	}
+	if policy.RequireSymbol && !symbol {
+		problems = append(problems, "contain a symbol")
+	}
+
+	if isBannedPassword(policy.Banned, username, password) {
+		problems = append(problems, "not be a common password or the username")
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: password must %s", entities.ErrWeakPassword, strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+// isBannedPassword reports whether password is a common, banned or the
+// username, ignoring case
+func isBannedPassword(banned []string, username, password string) bool {
+	if username != "" && strings.EqualFold(password, username) {
+		return true
+	}
+	for _, list := range [][]string{commonPasswords, banned} {
+		for _, b := range list {
+			if strings.EqualFold(password, b) {
+				return true
+			}
+		}
+	}
+	return false
+}
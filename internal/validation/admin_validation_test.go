@@ -0,0 +1,61 @@
+package validation_test
+
+import (
+	"strings"
+	"testing"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/validation"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidatePassword(t *testing.T) {
+	policy := validation.PasswordPolicy{
+		MinLength:    10,
+		RequireUpper: true,
+		RequireLower: true,
+		RequireDigit: true,
+		Banned:       []string{"Skillture2024!"},
+	}
+
+	tests := []struct {
+		name     string
+		policy   validation.PasswordPolicy
+		password string
+		wantErr  string
+	}{
+		{"valid", policy, "Correct7Horse", ""},
+		{"non-ASCII letters count", policy, "Ünïcödé7pass", ""},
+		{"too short", policy, "Ab1cdefg", "at least 10 characters"},
+		{"too long for bcrypt", policy, "Aa1" + strings.Repeat("x", 70), "at most 72 bytes"},
+		{"missing upper", policy, "correct7horse", "uppercase"},
+		{"missing lower", policy, "CORRECT7HORSE", "lowercase"},
+		{"missing digit", policy, "CorrectHorse", "digit"},
+		{"missing symbol", validation.PasswordPolicy{MinLength: 8, RequireSymbol: true}, "CorrectHorse", "symbol"},
+		{"symbol present", validation.PasswordPolicy{MinLength: 8, RequireSymbol: true}, "Correct-Horse", ""},
+		{"common password", validation.PasswordPolicy{MinLength: 8}, "Password123", "common password"},
+		{"configured banned", policy, "skillture2024!", "common password"},
+		{"username", validation.PasswordPolicy{MinLength: 8}, "Site-Admin", "common password"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validation.ValidatePassword(tt.policy, "site-admin", tt.password)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, entities.ErrWeakPassword)
+			require.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestValidatePassword_ListsEveryProblem(t *testing.T) {
+	policy := validation.PasswordPolicy{MinLength: 10, RequireUpper: true, RequireDigit: true}
+
+	err := validation.ValidatePassword(policy, "", "short")
+	require.ErrorIs(t, err, entities.ErrWeakPassword)
+	require.Contains(t, err.Error(), "at least 10 characters, contain an uppercase letter, contain a digit")
+}
@@ -0,0 +1,360 @@
+package validation
+
+import (
+	"fmt"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+
+	"github.com/google/uuid"
+)
+
+// Answer error codes returned to clients
+const (
+	CodeUnknownField      = "unknown_field"
+	CodeFieldTypeMismatch = "field_type_mismatch"
+	CodeDuplicateAnswer   = "duplicate_answer"
+	CodeRequired          = "required"
+	CodeInvalidEmail      = "invalid_email"
+	CodeInvalidNumber     = "invalid_number"
+	CodeInvalidDate       = "invalid_date"
+	CodeInvalidOption     = "invalid_option"
+	CodeInvalidValue      = "invalid_value"
+)
+
+// Accepted date layouts for FieldTypeDate answers
+var answerDateLayouts = []string{"2006-01-02", time.RFC3339}
+
+// FieldError describes a single invalid answer
+type FieldError struct {
+	FieldID uuid.UUID `json:"field_id"`
+	Code    string    `json:"code"`
+	Message string    `json:"message"`
+}
+
+// AnswerErrors is the list of per-field errors for a submission.
+// It matches domainErr.ErrInvalidAnswers with errors.Is.
+type AnswerErrors []FieldError
+
+func (e AnswerErrors) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, fe := range e {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", fe.FieldID, fe.Message))
+	}
+	return domainErr.ErrInvalidAnswers.Error() + ": " + strings.Join(msgs, "; ")
+}
+
+func (e AnswerErrors) Unwrap() error {
+	return domainErr.ErrInvalidAnswers
+}
+
+// answerChecker validates the value of a non-empty answer for one field type
+type answerChecker func(field *entities.FormField, answer *entities.ResponseAnswer) *FieldError
+
+// answerCheckers maps each field type to its value checker
+var answerCheckers = map[enums.FieldType]answerChecker{
+	enums.FieldTypeText:     checkTextAnswer,
+	enums.FieldTypeTextarea: checkTextAnswer,
+	enums.FieldTypeEmail:    checkEmailAnswer,
+	enums.FieldTypeNumber:   checkNumberAnswer,
+	enums.FieldTypeDate:     checkDateAnswer,
+	enums.FieldTypeSelect:   checkSingleChoiceAnswer,
+	enums.FieldTypeRadio:    checkSingleChoiceAnswer,
+	enums.FieldTypeCheckbox: checkMultiChoiceAnswer,
+}
+
+// ValidateAnswers checks submitted answers against the form's field definitions.
+// It rejects answers to unknown fields (including fields of other forms),
+// mismatched field types, duplicates, missing required fields, answers to
+// fields hidden by show-if conditions and values that do not match their
+// field type or rules. All problems are reported together.
+func ValidateAnswers(fields []*entities.FormField, answers []*entities.ResponseAnswer) error {
+	return validateAnswers(fields, answers, true)
+}
+
+// ValidateDraftAnswers checks the answers of a partially filled draft.
+// Values are validated like ValidateAnswers, but required fields may still be
+// missing and show-if visibility is not enforced until the draft is finalized.
+func ValidateDraftAnswers(fields []*entities.FormField, answers []*entities.ResponseAnswer) error {
+	return validateAnswers(fields, answers, false)
+}
+
+func validateAnswers(fields []*entities.FormField, answers []*entities.ResponseAnswer, complete bool) error {
+	var errs AnswerErrors
+	add := func(fieldID uuid.UUID, code, msg string) {
+		errs = append(errs, FieldError{FieldID: fieldID, Code: code, Message: msg})
+	}
+
+	byID := make(map[uuid.UUID]*entities.FormField, len(fields))
+	for _, f := range fields {
+		byID[f.ID] = f
+	}
+
+	visible := ResolveVisibility(fields, answers)
+
+	seen := make(map[uuid.UUID]bool, len(answers))
+	answered := make(map[uuid.UUID]bool, len(answers))
+	for _, ans := range answers {
+		field, ok := byID[ans.FieldID]
+		if !ok {
+			add(ans.FieldID, CodeUnknownField, "field does not belong to this form")
+			continue
+		}
+
+		if seen[ans.FieldID] {
+			add(ans.FieldID, CodeDuplicateAnswer, "field answered more than once")
+			continue
+		}
+		seen[ans.FieldID] = true
+
+		if ans.FieldType != field.Type {
+			add(ans.FieldID, CodeFieldTypeMismatch,
+				fmt.Sprintf("expected field type %s, got %s", field.Type, ans.FieldType))
+			continue
+		}
+
+		// Checked before emptiness: AnswerSelections drops non-string items,
+		// so {"selected": [1]} would otherwise pass as unanswered
+		if fe := checkSelectionKeys(field, ans); fe != nil {
+			errs = append(errs, *fe)
+			continue
+		}
+
+		// Empty answers count as unanswered
+		if IsEmptyAnswer(ans) {
+			continue
+		}
+
+		// Hidden fields don't accept answers
+		if complete && !visible[field.ID] {
+			add(ans.FieldID, CodeHiddenField, "field is hidden by its show-if conditions")
+			continue
+		}
+		answered[ans.FieldID] = true
+
+		if check, ok := answerCheckers[field.Type]; ok {
+			if fe := check(field, ans); fe != nil {
+				errs = append(errs, *fe)
+				continue
+			}
+		}
+
+		if fe := checkAnswerRules(field, ans); fe != nil {
+			errs = append(errs, *fe)
+		}
+	}
+
+	if complete {
+		for _, f := range fields {
+			if f.IsRequired() && visible[f.ID] && !answered[f.ID] {
+				add(f.ID, CodeRequired, "field is required")
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// IsEmptyAnswer reports whether an answer carries no value
+func IsEmptyAnswer(ans *entities.ResponseAnswer) bool {
+	switch ans.FieldType {
+	case enums.FieldTypeSelect, enums.FieldTypeRadio, enums.FieldTypeCheckbox:
+		return len(AnswerSelections(ans)) == 0
+	default:
+		v, ok := AnswerScalar(ans)
+		if !ok || v == nil {
+			return true
+		}
+		if s, isStr := v.(string); isStr {
+			return strings.TrimSpace(s) == ""
+		}
+		return false
+	}
+}
+
+// AnswerScalar returns the raw value of a single-value answer.
+// Answers use {"text": ...}; the older {"en": ...} shape is accepted too.
+func AnswerScalar(ans *entities.ResponseAnswer) (any, bool) {
+	if ans.Value == nil {
+		return nil, false
+	}
+	if v, ok := ans.Value["text"]; ok {
+		return v, true
+	}
+	if v, ok := ans.Value["en"]; ok {
+		return v, true
+	}
+	return nil, false
+}
+
+// AnswerText returns the answer value as a trimmed string
+func AnswerText(ans *entities.ResponseAnswer) string {
+	v, ok := AnswerScalar(ans)
+	if !ok || v == nil {
+		return ""
+	}
+	switch t := v.(type) {
+	case string:
+		return strings.TrimSpace(t)
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	default:
+		return strings.TrimSpace(fmt.Sprint(t))
+	}
+}
+
+// AnswerSelections returns the selected option keys of a choice answer.
+// {"selected": "key"} and {"selected": ["a", "b"]} are both supported.
+func AnswerSelections(ans *entities.ResponseAnswer) []string {
+	if ans.Value == nil {
+		return nil
+	}
+	switch v := ans.Value["selected"].(type) {
+	case string:
+		if v == "" {
+			return nil
+		}
+		return []string{v}
+	case []string:
+		return v
+	case []any:
+		out := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok && s != "" {
+				out = append(out, s)
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+// AnswerNumber parses a number answer
+func AnswerNumber(ans *entities.ResponseAnswer) (float64, bool) {
+	v, ok := AnswerScalar(ans)
+	if !ok {
+		return 0, false
+	}
+	switch t := v.(type) {
+	case float64:
+		return t, true
+	case string:
+		n, err := strconv.ParseFloat(strings.TrimSpace(t), 64)
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// AnswerDate parses a date answer
+func AnswerDate(ans *entities.ResponseAnswer) (time.Time, bool) {
+	return ParseAnswerDate(AnswerText(ans))
+}
+
+// ParseAnswerDate parses a date in one of the accepted layouts
+func ParseAnswerDate(s string) (time.Time, bool) {
+	for _, layout := range answerDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// -------------------
+// Per-type checkers
+// -------------------
+
+func checkTextAnswer(field *entities.FormField, ans *entities.ResponseAnswer) *FieldError {
+	if _, ok := ans.Value["text"].(string); !ok {
+		if _, ok := ans.Value["en"].(string); !ok {
+			return invalidAnswer(field, CodeInvalidValue, "text answer must be a string")
+		}
+	}
+	return nil
+}
+
+func checkEmailAnswer(field *entities.FormField, ans *entities.ResponseAnswer) *FieldError {
+	text := AnswerText(ans)
+	addr, err := mail.ParseAddress(text)
+	if err != nil || addr.Address != text {
+		return invalidAnswer(field, CodeInvalidEmail, "invalid email address")
+	}
+	return nil
+}
+
+func checkNumberAnswer(field *entities.FormField, ans *entities.ResponseAnswer) *FieldError {
+	if _, ok := AnswerNumber(ans); !ok {
+		return invalidAnswer(field, CodeInvalidNumber, "answer must be a number")
+	}
+	return nil
+}
+
+func checkDateAnswer(field *entities.FormField, ans *entities.ResponseAnswer) *FieldError {
+	if _, ok := AnswerDate(ans); !ok {
+		return invalidAnswer(field, CodeInvalidDate, "answer must be a date (YYYY-MM-DD)")
+	}
+	return nil
+}
+
+// checkSelectionKeys rejects choice answers whose "selected" value is not an
+// option key or a list of option keys
+func checkSelectionKeys(field *entities.FormField, ans *entities.ResponseAnswer) *FieldError {
+	switch field.Type {
+	case enums.FieldTypeSelect, enums.FieldTypeRadio, enums.FieldTypeCheckbox:
+	default:
+		return nil
+	}
+
+	switch v := ans.Value["selected"].(type) {
+	case nil, string, []string:
+		return nil
+	case []any:
+		for i, item := range v {
+			if _, ok := item.(string); !ok {
+				return invalidAnswer(field, CodeInvalidValue, fmt.Sprintf("selected item %d must be an option key string", i))
+			}
+		}
+		return nil
+	default:
+		return invalidAnswer(field, CodeInvalidValue, "selected must be an option key or a list of option keys")
+	}
+}
+
+func checkSingleChoiceAnswer(field *entities.FormField, ans *entities.ResponseAnswer) *FieldError {
+	selected := AnswerSelections(ans)
+	if len(selected) != 1 {
+		return invalidAnswer(field, CodeInvalidOption, "exactly one option must be selected")
+	}
+	if !field.HasOption(selected[0]) {
+		return invalidAnswer(field, CodeInvalidOption, fmt.Sprintf("unknown option %q", selected[0]))
+	}
+	return nil
+}
+
+func checkMultiChoiceAnswer(field *entities.FormField, ans *entities.ResponseAnswer) *FieldError {
+	seen := make(map[string]bool)
+	for _, key := range AnswerSelections(ans) {
+		if !field.HasOption(key) {
+			return invalidAnswer(field, CodeInvalidOption, fmt.Sprintf("unknown option %q", key))
+		}
+		if seen[key] {
+			return invalidAnswer(field, CodeInvalidOption, fmt.Sprintf("option %q selected more than once", key))
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+func invalidAnswer(field *entities.FormField, code, msg string) *FieldError {
+	return &FieldError{FieldID: field.ID, Code: code, Message: msg}
+}
@@ -0,0 +1,168 @@
+package validation_test
+
+import (
+	"errors"
+	"testing"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	domainErr "Skillture_Form/internal/domain/errors"
+	"Skillture_Form/internal/validation"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newField(t enums.FieldType, required bool, options map[string]any) *entities.FormField {
+	return &entities.FormField{
+		ID:       uuid.New(),
+		FormID:   uuid.New(),
+		Type:     t,
+		Required: required,
+		Options:  options,
+	}
+}
+
+func answerFor(f *entities.FormField, value map[string]any) *entities.ResponseAnswer {
+	return &entities.ResponseAnswer{FieldID: f.ID, FieldType: f.Type, Value: value}
+}
+
+func codesOf(t *testing.T, err error) map[uuid.UUID]string {
+	t.Helper()
+	var errs validation.AnswerErrors
+	require.True(t, errors.As(err, &errs), "expected AnswerErrors, got %v", err)
+	require.True(t, errors.Is(err, domainErr.ErrInvalidAnswers))
+	codes := make(map[uuid.UUID]string, len(errs))
+	for _, fe := range errs {
+		codes[fe.FieldID] = fe.Code
+	}
+	return codes
+}
+
+func TestValidateAnswers_ValidSubmission(t *testing.T) {
+	name := newField(enums.FieldTypeText, true, nil)
+	email := newField(enums.FieldTypeEmail, true, nil)
+	age := newField(enums.FieldTypeNumber, false, nil)
+	dob := newField(enums.FieldTypeDate, false, nil)
+	color := newField(enums.FieldTypeRadio, true, map[string]any{"red": "Red", "blue": "Blue"})
+	langs := newField(enums.FieldTypeCheckbox, false, map[string]any{"go": "Go", "js": "JS"})
+
+	fields := []*entities.FormField{name, email, age, dob, color, langs}
+	answers := []*entities.ResponseAnswer{
+		answerFor(name, map[string]any{"text": "Jane"}),
+		answerFor(email, map[string]any{"text": "jane@example.com"}),
+		answerFor(age, map[string]any{"text": "21"}),
+		answerFor(dob, map[string]any{"text": "2001-04-30"}),
+		answerFor(color, map[string]any{"selected": "red"}),
+		answerFor(langs, map[string]any{"selected": []any{"go", "js"}}),
+	}
+
+	require.NoError(t, validation.ValidateAnswers(fields, answers))
+}
+
+func TestValidateAnswers_ReportsEveryProblem(t *testing.T) {
+	name := newField(enums.FieldTypeText, true, nil)
+	email := newField(enums.FieldTypeEmail, false, nil)
+	age := newField(enums.FieldTypeNumber, false, nil)
+	dob := newField(enums.FieldTypeDate, false, nil)
+	color := newField(enums.FieldTypeSelect, false, map[string]any{"red": "Red"})
+	langs := newField(enums.FieldTypeCheckbox, false, map[string]any{"go": "Go"})
+	other := newField(enums.FieldTypeText, false, nil) // belongs to another form
+	typed := newField(enums.FieldTypeText, false, nil)
+
+	fields := []*entities.FormField{name, email, age, dob, color, langs, typed}
+	answers := []*entities.ResponseAnswer{
+		answerFor(email, map[string]any{"text": "not-an-email"}),
+		answerFor(age, map[string]any{"text": "twenty"}),
+		answerFor(dob, map[string]any{"text": "30/04/2001"}),
+		answerFor(color, map[string]any{"selected": "green"}),
+		answerFor(langs, map[string]any{"selected": []any{"go", "rust"}}),
+		answerFor(other, map[string]any{"text": "hello"}),
+		{FieldID: typed.ID, FieldType: enums.FieldTypeNumber, Value: map[string]any{"text": "1"}},
+	}
+
+	codes := codesOf(t, validation.ValidateAnswers(fields, answers))
+	require.Equal(t, map[uuid.UUID]string{
+		name.ID:  validation.CodeRequired,
+		email.ID: validation.CodeInvalidEmail,
+		age.ID:   validation.CodeInvalidNumber,
+		dob.ID:   validation.CodeInvalidDate,
+		color.ID: validation.CodeInvalidOption,
+		langs.ID: validation.CodeInvalidOption,
+		other.ID: validation.CodeUnknownField,
+		typed.ID: validation.CodeFieldTypeMismatch,
+	}, codes)
+}
+
+func TestValidateAnswers_EmptyAnswerDoesNotSatisfyRequired(t *testing.T) {
+	name := newField(enums.FieldTypeText, true, nil)
+	color := newField(enums.FieldTypeRadio, true, map[string]any{"red": "Red"})
+
+	fields := []*entities.FormField{name, color}
+	answers := []*entities.ResponseAnswer{
+		answerFor(name, map[string]any{"text": "   "}),
+		answerFor(color, map[string]any{}),
+	}
+
+	codes := codesOf(t, validation.ValidateAnswers(fields, answers))
+	require.Equal(t, validation.CodeRequired, codes[name.ID])
+	require.Equal(t, validation.CodeRequired, codes[color.ID])
+}
+
+func TestValidateAnswers_NonStringSelections(t *testing.T) {
+	color := newField(enums.FieldTypeRadio, false, map[string]any{"a": "A"})
+	langs := newField(enums.FieldTypeCheckbox, false, map[string]any{"a": "A"})
+	fields := []*entities.FormField{color, langs}
+
+	tests := []struct {
+		name  string
+		field *entities.FormField
+		value any
+	}{
+		{"mixed list", langs, []any{1, "a"}},
+		{"only non-strings", langs, []any{true}},
+		{"nested list", langs, []any{[]any{"a"}}},
+		{"number", color, 1.0},
+		{"object", color, map[string]any{"key": "a"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			answers := []*entities.ResponseAnswer{answerFor(tt.field, map[string]any{"selected": tt.value})}
+
+			codes := codesOf(t, validation.ValidateAnswers(fields, answers))
+			require.Equal(t, map[uuid.UUID]string{tt.field.ID: validation.CodeInvalidValue}, codes)
+
+			codes = codesOf(t, validation.ValidateDraftAnswers(fields, answers))
+			require.Equal(t, map[uuid.UUID]string{tt.field.ID: validation.CodeInvalidValue}, codes)
+		})
+	}
+}
+
+func TestValidateAnswers_DuplicateAnswer(t *testing.T) {
+	name := newField(enums.FieldTypeText, false, nil)
+
+	err := validation.ValidateAnswers(
+		[]*entities.FormField{name},
+		[]*entities.ResponseAnswer{
+			answerFor(name, map[string]any{"text": "a"}),
+			answerFor(name, map[string]any{"text": "b"}),
+		},
+	)
+
+	require.Equal(t, validation.CodeDuplicateAnswer, codesOf(t, err)[name.ID])
+}
+
+func TestValidateDraftAnswers_AllowsMissingRequired(t *testing.T) {
+	name := newField(enums.FieldTypeText, true, nil)
+	email := newField(enums.FieldTypeEmail, true, nil)
+	fields := []*entities.FormField{name, email}
+
+	require.NoError(t, validation.ValidateDraftAnswers(fields, []*entities.ResponseAnswer{
+		answerFor(name, map[string]any{"text": "Jane"}),
+	}))
+
+	codes := codesOf(t, validation.ValidateDraftAnswers(fields, []*entities.ResponseAnswer{
+		answerFor(email, map[string]any{"text": "not-an-email"}),
+	}))
+	require.Equal(t, map[uuid.UUID]string{email.ID: validation.CodeInvalidEmail}, codes)
+}
@@ -0,0 +1,290 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+
+	"github.com/google/uuid"
+)
+
+// Errors
+var (
+	ErrInvalidShowIf      = errors.New("invalid show-if conditions")
+	ErrConditionCycle     = errors.New("show-if conditions form a cycle")
+	ErrFieldHasDependents = errors.New("field is referenced by show-if conditions of other fields")
+)
+
+// Condition error codes returned to clients
+const (
+	CodeHiddenField = "hidden_field"
+)
+
+// validateShowIfDomain checks the structure of a field's show-if conditions
+func validateShowIfDomain(ff *entities.FormField) error {
+	s := ff.ShowIf
+	if s == nil {
+		return nil
+	}
+	if s.Match != "" && s.Match != entities.ShowIfMatchAll && s.Match != entities.ShowIfMatchAny {
+		return showIfError(`match must be "all" or "any"`)
+	}
+	if len(s.Conditions) == 0 {
+		return showIfError("at least one condition is required")
+	}
+	for _, c := range s.Conditions {
+		if c.FieldID == uuid.Nil {
+			return showIfError("condition field_id is required")
+		}
+		if ff.ID != uuid.Nil && c.FieldID == ff.ID {
+			return showIfError("a field cannot depend on itself")
+		}
+		if !c.Operator.IsValid() {
+			return showIfError(fmt.Sprintf("unknown operator %q", c.Operator))
+		}
+		if c.Value == nil {
+			return showIfError("condition value is required")
+		}
+	}
+	return nil
+}
+
+// ValidateFieldConditions checks show-if references of field against the other
+// fields of the same form (siblings must not contain field itself):
+//   - referenced fields exist and precede field in FieldOrder
+//   - operators fit the referenced field types
+//   - fields depending on field still come after it
+//   - the resulting dependency graph has no cycles
+func ValidateFieldConditions(field *entities.FormField, siblings []*entities.FormField) error {
+	byID := make(map[uuid.UUID]*entities.FormField, len(siblings))
+	for _, s := range siblings {
+		byID[s.ID] = s
+	}
+
+	if field.IsConditional() {
+		for _, c := range field.ShowIf.Conditions {
+			ref, ok := byID[c.FieldID]
+			if !ok {
+				return showIfError(fmt.Sprintf("field %s does not belong to this form", c.FieldID))
+			}
+			if ref.FieldOrder >= field.FieldOrder {
+				return showIfError(fmt.Sprintf("field %s must come before this field", c.FieldID))
+			}
+			if !c.Operator.AppliesTo(ref.Type) {
+				return showIfError(fmt.Sprintf("operator %q cannot be used on %s fields", c.Operator, ref.Type))
+			}
+		}
+	}
+
+	for _, s := range siblings {
+		if s.DependsOn(field.ID) && s.FieldOrder <= field.FieldOrder {
+			return showIfError(fmt.Sprintf("field %s depends on this field and must come after it", s.ID))
+		}
+	}
+
+	all := append([]*entities.FormField{field}, siblings...)
+	if hasConditionCycle(all) {
+		return ErrConditionCycle
+	}
+
+	return nil
+}
+
+// ResolveVisibility returns which fields are visible given the answers.
+// Fields are evaluated in FieldOrder; a condition on a hidden or unanswered
+// field never matches.
+func ResolveVisibility(fields []*entities.FormField, answers []*entities.ResponseAnswer) map[uuid.UUID]bool {
+	ordered := make([]*entities.FormField, len(fields))
+	copy(ordered, fields)
+	sort.SliceStable(ordered, func(i, j int) bool { return ordered[i].FieldOrder < ordered[j].FieldOrder })
+
+	types := make(map[uuid.UUID]enums.FieldType, len(fields))
+	for _, f := range fields {
+		types[f.ID] = f.Type
+	}
+
+	byField := make(map[uuid.UUID]*entities.ResponseAnswer, len(answers))
+	for _, a := range answers {
+		if t, ok := types[a.FieldID]; ok && a.FieldType == t && !IsEmptyAnswer(a) {
+			if _, dup := byField[a.FieldID]; !dup {
+				byField[a.FieldID] = a
+			}
+		}
+	}
+
+	visible := make(map[uuid.UUID]bool, len(fields))
+	for _, f := range ordered {
+		if !f.IsConditional() {
+			visible[f.ID] = true
+			continue
+		}
+
+		matchAny := f.ShowIf.MatchAny()
+		result := !matchAny
+		for _, c := range f.ShowIf.Conditions {
+			ans := byField[c.FieldID]
+			ok := visible[c.FieldID] && ans != nil && evaluateCondition(c, ans)
+			if matchAny && ok {
+				result = true
+				break
+			}
+			if !matchAny && !ok {
+				result = false
+				break
+			}
+		}
+		visible[f.ID] = result
+	}
+
+	return visible
+}
+
+// evaluateCondition compares a non-empty answer with the condition value
+func evaluateCondition(c entities.FieldCondition, ans *entities.ResponseAnswer) bool {
+	switch ans.FieldType {
+	case enums.FieldTypeSelect, enums.FieldTypeRadio, enums.FieldTypeCheckbox:
+		selected := AnswerSelections(ans)
+		want := conditionString(c.Value)
+		includes := false
+		for _, s := range selected {
+			if s == want {
+				includes = true
+				break
+			}
+		}
+		switch c.Operator {
+		case enums.ConditionEquals:
+			return len(selected) == 1 && includes
+		case enums.ConditionNotEquals:
+			return !(len(selected) == 1 && includes)
+		case enums.ConditionContains:
+			return includes
+		}
+		return false
+
+	case enums.FieldTypeNumber:
+		got, ok1 := AnswerNumber(ans)
+		want, ok2 := conditionNumber(c.Value)
+		if !ok1 || !ok2 {
+			return false
+		}
+		switch c.Operator {
+		case enums.ConditionEquals:
+			return got == want
+		case enums.ConditionNotEquals:
+			return got != want
+		case enums.ConditionGreaterThan:
+			return got > want
+		case enums.ConditionLessThan:
+			return got < want
+		}
+		return false
+
+	case enums.FieldTypeDate:
+		got, ok1 := AnswerDate(ans)
+		want, ok2 := ParseAnswerDate(conditionString(c.Value))
+		if !ok1 || !ok2 {
+			return false
+		}
+		switch c.Operator {
+		case enums.ConditionEquals:
+			return got.Equal(want)
+		case enums.ConditionNotEquals:
+			return !got.Equal(want)
+		case enums.ConditionGreaterThan:
+			return got.After(want)
+		case enums.ConditionLessThan:
+			return got.Before(want)
+		}
+		return false
+
+	default:
+		got := strings.ToLower(AnswerText(ans))
+		want := strings.ToLower(strings.TrimSpace(conditionString(c.Value)))
+		switch c.Operator {
+		case enums.ConditionEquals:
+			return got == want
+		case enums.ConditionNotEquals:
+			return got != want
+		case enums.ConditionContains:
+			return strings.Contains(got, want)
+		}
+		return false
+	}
+}
+
+// hasConditionCycle runs a depth-first search over show-if references
+func hasConditionCycle(fields []*entities.FormField) bool {
+	byID := make(map[uuid.UUID]*entities.FormField, len(fields))
+	for _, f := range fields {
+		byID[f.ID] = f
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[uuid.UUID]int, len(fields))
+
+	var visit func(id uuid.UUID) bool
+	visit = func(id uuid.UUID) bool {
+		switch state[id] {
+		case visiting:
+			return true
+		case done:
+			return false
+		}
+		state[id] = visiting
+		if f, ok := byID[id]; ok && f.ShowIf != nil {
+			for _, c := range f.ShowIf.Conditions {
+				if visit(c.FieldID) {
+					return true
+				}
+			}
+		}
+		state[id] = done
+		return false
+	}
+
+	for _, f := range fields {
+		if visit(f.ID) {
+			return true
+		}
+	}
+	return false
+}
+
+func conditionString(v any) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	default:
+		return fmt.Sprint(t)
+	}
+}
+
+func conditionNumber(v any) (float64, bool) {
+	switch t := v.(type) {
+	case float64:
+		return t, true
+	case int:
+		return float64(t), true
+	case string:
+		n, err := strconv.ParseFloat(strings.TrimSpace(t), 64)
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func showIfError(msg string) error {
+	return fmt.Errorf("%w: %s", ErrInvalidShowIf, msg)
+}
@@ -0,0 +1,111 @@
+package validation_test
+
+import (
+	"errors"
+	"testing"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/validation"
+
+	"github.com/stretchr/testify/require"
+)
+
+func showIf(match string, conds ...entities.FieldCondition) *entities.ShowIf {
+	return &entities.ShowIf{Match: match, Conditions: conds}
+}
+
+func TestValidateFieldConditions(t *testing.T) {
+	hasJob := newField(enums.FieldTypeRadio, true, map[string]any{"yes": "Yes", "no": "No"})
+	hasJob.FieldOrder = 1
+	age := newField(enums.FieldTypeNumber, false, nil)
+	age.FieldOrder = 2
+
+	employer := newField(enums.FieldTypeText, true, nil)
+	employer.FormID = hasJob.FormID
+	employer.FieldOrder = 3
+
+	// ===== Valid reference to an earlier field =====
+	employer.ShowIf = showIf("", entities.FieldCondition{FieldID: hasJob.ID, Operator: enums.ConditionEquals, Value: "yes"})
+	require.NoError(t, validation.ValidateFieldConditions(employer, []*entities.FormField{hasJob, age}))
+
+	// ===== Unknown field =====
+	other := newField(enums.FieldTypeText, false, nil)
+	employer.ShowIf = showIf("", entities.FieldCondition{FieldID: other.ID, Operator: enums.ConditionEquals, Value: "x"})
+	err := validation.ValidateFieldConditions(employer, []*entities.FormField{hasJob, age})
+	require.True(t, errors.Is(err, validation.ErrInvalidShowIf))
+
+	// ===== Referenced field comes later =====
+	employer.FieldOrder = 1
+	hasJob.FieldOrder = 2
+	employer.ShowIf = showIf("", entities.FieldCondition{FieldID: hasJob.ID, Operator: enums.ConditionEquals, Value: "yes"})
+	err = validation.ValidateFieldConditions(employer, []*entities.FormField{hasJob, age})
+	require.True(t, errors.Is(err, validation.ErrInvalidShowIf))
+	hasJob.FieldOrder, employer.FieldOrder = 1, 3
+
+	// ===== Operator doesn't fit the referenced type =====
+	employer.ShowIf = showIf("", entities.FieldCondition{FieldID: hasJob.ID, Operator: enums.ConditionGreaterThan, Value: 3.0})
+	err = validation.ValidateFieldConditions(employer, []*entities.FormField{hasJob, age})
+	require.True(t, errors.Is(err, validation.ErrInvalidShowIf))
+
+	// ===== Moving a referenced field after its dependent =====
+	employer.ShowIf = showIf("", entities.FieldCondition{FieldID: hasJob.ID, Operator: enums.ConditionEquals, Value: "yes"})
+	moved := *hasJob
+	moved.FieldOrder = 4
+	err = validation.ValidateFieldConditions(&moved, []*entities.FormField{employer, age})
+	require.True(t, errors.Is(err, validation.ErrInvalidShowIf))
+
+	// ===== Mutual dependency =====
+	a := newField(enums.FieldTypeText, false, nil)
+	b := newField(enums.FieldTypeText, false, nil)
+	a.FieldOrder, b.FieldOrder = 2, 1
+	a.ShowIf = showIf("", entities.FieldCondition{FieldID: b.ID, Operator: enums.ConditionEquals, Value: "x"})
+	b.ShowIf = showIf("", entities.FieldCondition{FieldID: a.ID, Operator: enums.ConditionEquals, Value: "x"})
+	err = validation.ValidateFieldConditions(a, []*entities.FormField{b})
+	require.Error(t, err)
+}
+
+func TestValidateAnswers_ShowIf(t *testing.T) {
+	hasJob := newField(enums.FieldTypeRadio, true, map[string]any{"yes": "Yes", "no": "No"})
+	hasJob.FieldOrder = 1
+	years := newField(enums.FieldTypeNumber, false, nil)
+	years.FieldOrder = 2
+	employer := newField(enums.FieldTypeText, true, nil)
+	employer.FieldOrder = 3
+	employer.ShowIf = showIf("", entities.FieldCondition{FieldID: hasJob.ID, Operator: enums.ConditionEquals, Value: "yes"})
+	senior := newField(enums.FieldTypeText, true, nil)
+	senior.FieldOrder = 4
+	senior.ShowIf = showIf(entities.ShowIfMatchAll,
+		entities.FieldCondition{FieldID: employer.ID, Operator: enums.ConditionContains, Value: "corp"},
+		entities.FieldCondition{FieldID: years.ID, Operator: enums.ConditionGreaterThan, Value: 5.0},
+	)
+
+	fields := []*entities.FormField{hasJob, years, employer, senior}
+
+	// ===== Hidden fields are not required =====
+	require.NoError(t, validation.ValidateAnswers(fields, []*entities.ResponseAnswer{
+		answerFor(hasJob, map[string]any{"selected": "no"}),
+	}))
+
+	// ===== Visible fields become required =====
+	codes := codesOf(t, validation.ValidateAnswers(fields, []*entities.ResponseAnswer{
+		answerFor(hasJob, map[string]any{"selected": "yes"}),
+		answerFor(years, map[string]any{"text": "10"}),
+		answerFor(employer, map[string]any{"text": "Big Corp"}),
+	}))
+	require.Len(t, codes, 1)
+	require.Equal(t, validation.CodeRequired, codes[senior.ID])
+
+	// ===== Hidden fields don't accept answers =====
+	codes = codesOf(t, validation.ValidateAnswers(fields, []*entities.ResponseAnswer{
+		answerFor(hasJob, map[string]any{"selected": "no"}),
+		answerFor(employer, map[string]any{"text": "Big Corp"}),
+	}))
+	require.Equal(t, validation.CodeHiddenField, codes[employer.ID])
+
+	// ===== Conditions on hidden fields never match =====
+	require.NoError(t, validation.ValidateAnswers(fields, []*entities.ResponseAnswer{
+		answerFor(hasJob, map[string]any{"selected": "no"}),
+		answerFor(years, map[string]any{"text": "10"}),
+	}))
+}
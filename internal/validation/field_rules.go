@@ -0,0 +1,169 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+)
+
+// Errors
+var (
+	ErrInvalidValidationRules = errors.New("invalid validation rules")
+)
+
+// Rule error codes returned to clients
+const (
+	CodeOutOfRange        = "out_of_range"
+	CodeTooShort          = "too_short"
+	CodeTooLong           = "too_long"
+	CodePatternMismatch   = "pattern_mismatch"
+	CodeDateOutOfRange    = "date_out_of_range"
+	CodeTooFewSelections  = "too_few_selections"
+	CodeTooManySelections = "too_many_selections"
+)
+
+// validateFieldRules checks that the rules fit the field type and are consistent
+func validateFieldRules(ff *entities.FormField) error {
+	r := ff.Rules
+	if r.IsEmpty() {
+		return nil
+	}
+
+	isText := ff.Type == enums.FieldTypeText || ff.Type == enums.FieldTypeTextarea
+
+	if (r.Min != nil || r.Max != nil) && ff.Type != enums.FieldTypeNumber {
+		return rulesError("min/max only apply to number fields")
+	}
+	if (r.MinLength != nil || r.MaxLength != nil || r.Pattern != "") && !isText {
+		return rulesError("min_length/max_length/pattern only apply to text and textarea fields")
+	}
+	if (r.Earliest != "" || r.Latest != "") && ff.Type != enums.FieldTypeDate {
+		return rulesError("earliest/latest only apply to date fields")
+	}
+	if (r.MinSelections != nil || r.MaxSelections != nil) && ff.Type != enums.FieldTypeCheckbox {
+		return rulesError("min_selections/max_selections only apply to checkbox fields")
+	}
+
+	if r.Min != nil && r.Max != nil && *r.Min > *r.Max {
+		return rulesError("min must not be greater than max")
+	}
+
+	if err := validateIntRange(r.MinLength, r.MaxLength, "min_length", "max_length"); err != nil {
+		return err
+	}
+	if r.Pattern != "" {
+		if _, err := compileRulePattern(r.Pattern); err != nil {
+			return rulesError(fmt.Sprintf("pattern does not compile: %v", err))
+		}
+	}
+
+	var earliest, latest string
+	if r.Earliest != "" {
+		t, ok := ParseAnswerDate(r.Earliest)
+		if !ok {
+			return rulesError("earliest must be a date (YYYY-MM-DD)")
+		}
+		earliest = t.Format("2006-01-02")
+	}
+	if r.Latest != "" {
+		t, ok := ParseAnswerDate(r.Latest)
+		if !ok {
+			return rulesError("latest must be a date (YYYY-MM-DD)")
+		}
+		latest = t.Format("2006-01-02")
+	}
+	if earliest != "" && latest != "" && earliest > latest {
+		return rulesError("earliest must not be after latest")
+	}
+
+	if err := validateIntRange(r.MinSelections, r.MaxSelections, "min_selections", "max_selections"); err != nil {
+		return err
+	}
+	if r.MaxSelections != nil && len(ff.Options) > 0 && *r.MaxSelections > len(ff.Options) {
+		return rulesError("max_selections exceeds the number of options")
+	}
+
+	return nil
+}
+
+// checkAnswerRules enforces a field's rules on a non-empty, well-typed answer
+func checkAnswerRules(field *entities.FormField, ans *entities.ResponseAnswer) *FieldError {
+	r := field.Rules
+	if r.IsEmpty() {
+		return nil
+	}
+
+	switch field.Type {
+	case enums.FieldTypeNumber:
+		n, _ := AnswerNumber(ans)
+		if r.Min != nil && n < *r.Min {
+			return invalidAnswer(field, CodeOutOfRange, fmt.Sprintf("must be at least %v", *r.Min))
+		}
+		if r.Max != nil && n > *r.Max {
+			return invalidAnswer(field, CodeOutOfRange, fmt.Sprintf("must be at most %v", *r.Max))
+		}
+
+	case enums.FieldTypeText, enums.FieldTypeTextarea:
+		text := AnswerText(ans)
+		length := utf8.RuneCountInString(text)
+		if r.MinLength != nil && length < *r.MinLength {
+			return invalidAnswer(field, CodeTooShort, fmt.Sprintf("must be at least %d characters", *r.MinLength))
+		}
+		if r.MaxLength != nil && length > *r.MaxLength {
+			return invalidAnswer(field, CodeTooLong, fmt.Sprintf("must be at most %d characters", *r.MaxLength))
+		}
+		if r.Pattern != "" {
+			re, err := compileRulePattern(r.Pattern)
+			if err != nil || !re.MatchString(text) {
+				return invalidAnswer(field, CodePatternMismatch, "does not match the required format")
+			}
+		}
+
+	case enums.FieldTypeDate:
+		d, _ := AnswerDate(ans)
+		day := d.Format("2006-01-02")
+		if earliest, ok := ParseAnswerDate(r.Earliest); ok && day < earliest.Format("2006-01-02") {
+			return invalidAnswer(field, CodeDateOutOfRange, fmt.Sprintf("must be on or after %s", r.Earliest))
+		}
+		if latest, ok := ParseAnswerDate(r.Latest); ok && day > latest.Format("2006-01-02") {
+			return invalidAnswer(field, CodeDateOutOfRange, fmt.Sprintf("must be on or before %s", r.Latest))
+		}
+
+	case enums.FieldTypeCheckbox:
+		count := len(AnswerSelections(ans))
+		if r.MinSelections != nil && count < *r.MinSelections {
+			return invalidAnswer(field, CodeTooFewSelections, fmt.Sprintf("select at least %d options", *r.MinSelections))
+		}
+		if r.MaxSelections != nil && count > *r.MaxSelections {
+			return invalidAnswer(field, CodeTooManySelections, fmt.Sprintf("select at most %d options", *r.MaxSelections))
+		}
+	}
+
+	return nil
+}
+
+// compileRulePattern anchors the pattern so it must match the whole answer
+func compileRulePattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile(`^(?:` + pattern + `)$`)
+}
+
+func validateIntRange(min, max *int, minName, maxName string) error {
+	if min != nil && *min < 0 {
+		return rulesError(minName + " must not be negative")
+	}
+	if max != nil && *max < 0 {
+		return rulesError(maxName + " must not be negative")
+	}
+	if min != nil && max != nil && *min > *max {
+		return rulesError(fmt.Sprintf("%s must not be greater than %s", minName, maxName))
+	}
+	return nil
+}
+
+func rulesError(msg string) error {
+	return fmt.Errorf("%w: %s", ErrInvalidValidationRules, msg)
+}
@@ -0,0 +1,93 @@
+package validation_test
+
+import (
+	"errors"
+	"testing"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/validation"
+
+	"github.com/stretchr/testify/require"
+)
+
+func ptr[T any](v T) *T { return &v }
+
+func TestValidateFormFieldDomain_Rules(t *testing.T) {
+	tests := []struct {
+		name  string
+		field *entities.FormField
+		ok    bool
+	}{
+		{"number range", &entities.FormField{Type: enums.FieldTypeNumber, Rules: &entities.FieldRules{Min: ptr(16.0), Max: ptr(35.0)}}, true},
+		{"inverted range", &entities.FormField{Type: enums.FieldTypeNumber, Rules: &entities.FieldRules{Min: ptr(35.0), Max: ptr(16.0)}}, false},
+		{"min on text", &entities.FormField{Type: enums.FieldTypeText, Rules: &entities.FieldRules{Min: ptr(1.0)}}, false},
+		{"text pattern", &entities.FormField{Type: enums.FieldTypeText, Rules: &entities.FieldRules{Pattern: `\+?[0-9]{10,14}`}}, true},
+		{"bad pattern", &entities.FormField{Type: enums.FieldTypeText, Rules: &entities.FieldRules{Pattern: `(`}}, false},
+		{"negative length", &entities.FormField{Type: enums.FieldTypeTextarea, Rules: &entities.FieldRules{MinLength: ptr(-1)}}, false},
+		{"date window", &entities.FormField{Type: enums.FieldTypeDate, Rules: &entities.FieldRules{Earliest: "2024-01-01", Latest: "2024-12-31"}}, true},
+		{"bad date", &entities.FormField{Type: enums.FieldTypeDate, Rules: &entities.FieldRules{Earliest: "01/01/2024"}}, false},
+		{"too many selections", &entities.FormField{
+			Type:    enums.FieldTypeCheckbox,
+			Options: map[string]any{"a": "A"},
+			Rules:   &entities.FieldRules{MaxSelections: ptr(2)},
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.field.FieldOrder = 1
+			if tt.field.Options == nil && tt.field.RequiresOptions() {
+				tt.field.Options = map[string]any{"a": "A", "b": "B"}
+			}
+			err := validation.ValidateFormFieldDomain(tt.field)
+			if tt.ok {
+				require.NoError(t, err)
+			} else {
+				require.True(t, errors.Is(err, validation.ErrInvalidValidationRules), "got %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateAnswers_EnforcesRules(t *testing.T) {
+	age := newField(enums.FieldTypeNumber, true, nil)
+	age.Rules = &entities.FieldRules{Min: ptr(16.0), Max: ptr(35.0)}
+
+	phone := newField(enums.FieldTypeText, true, nil)
+	phone.Rules = &entities.FieldRules{Pattern: `\+?[0-9]{10,14}`}
+
+	bio := newField(enums.FieldTypeTextarea, false, nil)
+	bio.Rules = &entities.FieldRules{MaxLength: ptr(5)}
+
+	start := newField(enums.FieldTypeDate, false, nil)
+	start.Rules = &entities.FieldRules{Earliest: "2024-01-01"}
+
+	langs := newField(enums.FieldTypeCheckbox, false, map[string]any{"go": "Go", "js": "JS"})
+	langs.Rules = &entities.FieldRules{MinSelections: ptr(2)}
+
+	fields := []*entities.FormField{age, phone, bio, start, langs}
+
+	// ===== Valid =====
+	require.NoError(t, validation.ValidateAnswers(fields, []*entities.ResponseAnswer{
+		answerFor(age, map[string]any{"text": "20"}),
+		answerFor(phone, map[string]any{"text": "+9647700000000"}),
+		answerFor(bio, map[string]any{"text": "hi"}),
+		answerFor(start, map[string]any{"text": "2024-06-01"}),
+		answerFor(langs, map[string]any{"selected": []any{"go", "js"}}),
+	}))
+
+	// ===== Invalid =====
+	codes := codesOf(t, validation.ValidateAnswers(fields, []*entities.ResponseAnswer{
+		answerFor(age, map[string]any{"text": 40.0}),
+		answerFor(phone, map[string]any{"text": "call me 0770"}),
+		answerFor(bio, map[string]any{"text": "too long"}),
+		answerFor(start, map[string]any{"text": "2023-12-31"}),
+		answerFor(langs, map[string]any{"selected": []any{"go"}}),
+	}))
+	require.Equal(t, validation.CodeOutOfRange, codes[age.ID])
+	require.Equal(t, validation.CodePatternMismatch, codes[phone.ID])
+	require.Equal(t, validation.CodeTooLong, codes[bio.ID])
+	require.Equal(t, validation.CodeDateOutOfRange, codes[start.ID])
+	require.Equal(t, validation.CodeTooFewSelections, codes[langs.ID])
+}
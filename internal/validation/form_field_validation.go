@@ -29,6 +29,16 @@ func ValidateFormFieldDomain(ff *entities.FormField) error {
 		return ErrInvalidFieldOrder
 	}
 
+	// Ensure validation rules fit the field type
+	if err := validateFieldRules(ff); err != nil {
+		return err
+	}
+
+	// Ensure show-if conditions are well formed
+	if err := validateShowIfDomain(ff); err != nil {
+		return err
+	}
+
 	// All validations passed
 	return nil
 }
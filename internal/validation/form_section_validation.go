@@ -0,0 +1,26 @@
+package validation
+
+import (
+	"errors"
+
+	"Skillture_Form/internal/domain/entities"
+)
+
+// Errors
+var (
+	ErrInvalidSectionOrder = errors.New("section order must be greater than zero")
+	ErrSectionNotInForm    = errors.New("section does not belong to the field's form")
+)
+
+// ValidateFormSectionDomain validates FormSection entity
+func ValidateFormSectionDomain(s *entities.FormSection) error {
+	if err := s.IsValid(); err != nil {
+		return err
+	}
+
+	if s.SectionOrder <= 0 {
+		return ErrInvalidSectionOrder
+	}
+
+	return nil
+}
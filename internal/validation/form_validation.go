@@ -2,6 +2,7 @@ package validation
 
 import (
 	"errors"
+	"fmt"
 
 	"Skillture_Form/internal/domain/entities"
 )
@@ -15,11 +16,11 @@ var (
 
 // ValidateFormDomain validates the Form entity
 func ValidateFormDomain(f *entities.Form) error {
-	if f.Title == "" {
+	if !f.HasTitle() {
 		return ErrFormTitleRequired
 	}
 
-	if f.Description == "" {
+	if f.GetDescription("en") == "" {
 		return ErrFormDescriptionNeeded
 	}
 
@@ -29,3 +30,22 @@ func ValidateFormDomain(f *entities.Form) error {
 
 	return nil
 }
+
+// Duplicate policy limits
+const (
+	MaxFingerprintWindowMin = 525600 // One year
+)
+
+// ValidateDuplicatePolicy checks the ranges of a form's duplicate policy
+func ValidateDuplicatePolicy(p *entities.DuplicatePolicy) error {
+	if p == nil {
+		return nil
+	}
+	if p.FingerprintWindowMin < 0 || p.FingerprintWindowMin > MaxFingerprintWindowMin {
+		return fmt.Errorf("%w: fingerprint_window_min must be between 0 and %d", entities.ErrInvalidDuplicatePolicy, MaxFingerprintWindowMin)
+	}
+	if p.NearDuplicateThreshold < 0 || p.NearDuplicateThreshold > 1 {
+		return fmt.Errorf("%w: near_duplicate_threshold must be between 0 and 1", entities.ErrInvalidDuplicatePolicy)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+package validation_test
+
+import (
+	"errors"
+	"testing"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/validation"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateDuplicatePolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *entities.DuplicatePolicy
+		ok     bool
+	}{
+		{"no policy", nil, true},
+		{"all rules", &entities.DuplicatePolicy{UniqueEmail: true, FingerprintWindowMin: 60, NearDuplicateThreshold: 0.95}, true},
+		{"negative window", &entities.DuplicatePolicy{FingerprintWindowMin: -1}, false},
+		{"window over a year", &entities.DuplicatePolicy{FingerprintWindowMin: validation.MaxFingerprintWindowMin + 1}, false},
+		{"threshold above 1", &entities.DuplicatePolicy{NearDuplicateThreshold: 1.5}, false},
+		{"negative threshold", &entities.DuplicatePolicy{NearDuplicateThreshold: -0.1}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validation.ValidateDuplicatePolicy(tt.policy)
+			if tt.ok {
+				require.NoError(t, err)
+			} else {
+				require.True(t, errors.Is(err, entities.ErrInvalidDuplicatePolicy), "got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package validation
+
+import (
+	"fmt"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"Skillture_Form/internal/domain/entities"
+)
+
+// Notification settings limits
+const (
+	MaxAdminEmails         = 20
+	MaxEmailSubjectLength  = 255
+	MaxEmailTemplateLength = 10000
+	MaxEmailTemplateLangs  = 20
+	maxLanguageTagLength   = 16
+	maxEmailAddressLength  = 254
+)
+
+// languageTag matches tags like "en", "ar" or "pt-BR"
+var languageTag = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// ValidateNotificationSettings checks the addresses, language and template
+// sizes of a form's notification settings. Template syntax is checked by the
+// notification package, which renders them.
+func ValidateNotificationSettings(s *entities.NotificationSettings) error {
+	if s == nil {
+		return nil
+	}
+
+	if len(s.AdminEmails) > MaxAdminEmails {
+		return fmt.Errorf("%w: at most %d admin emails", entities.ErrInvalidNotificationSettings, MaxAdminEmails)
+	}
+	seen := make(map[string]bool, len(s.AdminEmails))
+	for _, email := range s.AdminEmails {
+		addr, err := mail.ParseAddress(email)
+		if err != nil || addr.Address != email || len(email) > maxEmailAddressLength {
+			return fmt.Errorf("%w: invalid admin email %q", entities.ErrInvalidNotificationSettings, email)
+		}
+		key := strings.ToLower(email)
+		if seen[key] {
+			return fmt.Errorf("%w: duplicate admin email %q", entities.ErrInvalidNotificationSettings, email)
+		}
+		seen[key] = true
+	}
+
+	if s.AdminLanguage != "" && !isLanguageTag(s.AdminLanguage) {
+		return fmt.Errorf("%w: invalid admin_language %q", entities.ErrInvalidNotificationSettings, s.AdminLanguage)
+	}
+
+	if err := validateEmailTemplates(entities.EmailKindConfirmation, s.Confirmation); err != nil {
+		return err
+	}
+	return validateEmailTemplates(entities.EmailKindAdminAlert, s.AdminAlert)
+}
+
+// validateEmailTemplates checks the languages and sizes of one kind's templates
+func validateEmailTemplates(kind string, templates map[string]entities.EmailTemplate) error {
+	if len(templates) > MaxEmailTemplateLangs {
+		return fmt.Errorf("%w: at most %d %s languages", entities.ErrInvalidNotificationSettings, MaxEmailTemplateLangs, kind)
+	}
+	for lang, tmpl := range templates {
+		if !isLanguageTag(lang) {
+			return fmt.Errorf("%w: invalid %s language %q", entities.ErrInvalidNotificationSettings, kind, lang)
+		}
+		if strings.TrimSpace(tmpl.Subject) == "" || strings.TrimSpace(tmpl.Body) == "" {
+			return fmt.Errorf("%w: %s template (%s) needs a subject and a body", entities.ErrInvalidNotificationSettings, kind, lang)
+		}
+		if len(tmpl.Subject) > MaxEmailSubjectLength || len(tmpl.Body) > MaxEmailTemplateLength {
+			return fmt.Errorf("%w: %s template (%s) is too long", entities.ErrInvalidNotificationSettings, kind, lang)
+		}
+	}
+	return nil
+}
+
+// isLanguageTag reports whether tag looks like a BCP 47 language tag
+func isLanguageTag(tag string) bool {
+	return len(tag) <= maxLanguageTagLength && languageTag.MatchString(tag)
+}
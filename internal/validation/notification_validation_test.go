@@ -0,0 +1,75 @@
+package validation_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/validation"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateNotificationSettings(t *testing.T) {
+	valid := func() *entities.NotificationSettings {
+		return &entities.NotificationSettings{
+			ConfirmRespondent: true,
+			AdminEmails:       []string{"admin@example.com", "team@example.org"},
+			AdminLanguage:     "ar",
+			Confirmation: map[string]entities.EmailTemplate{
+				"en":    {Subject: "Thanks", Body: "Thanks for {{.FormTitle}}"},
+				"pt-BR": {Subject: "Obrigado", Body: "Obrigado"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		modify func(s *entities.NotificationSettings)
+		ok     bool
+	}{
+		{"valid", func(s *entities.NotificationSettings) {}, true},
+		{"no admin emails", func(s *entities.NotificationSettings) { s.AdminEmails = nil }, true},
+		{"invalid email", func(s *entities.NotificationSettings) { s.AdminEmails = []string{"admin"} }, false},
+		{"display name", func(s *entities.NotificationSettings) { s.AdminEmails = []string{"Admin <admin@example.com>"} }, false},
+		{"header injection", func(s *entities.NotificationSettings) {
+			s.AdminEmails = []string{"admin@example.com\r\nBcc: x@example.com"}
+		}, false},
+		{"duplicate email", func(s *entities.NotificationSettings) {
+			s.AdminEmails = []string{"admin@example.com", "ADMIN@example.com"}
+		}, false},
+		{"too many emails", func(s *entities.NotificationSettings) {
+			s.AdminEmails = nil
+			for i := 0; i <= validation.MaxAdminEmails; i++ {
+				s.AdminEmails = append(s.AdminEmails, fmt.Sprintf("a%d@example.com", i))
+			}
+		}, false},
+		{"invalid admin language", func(s *entities.NotificationSettings) { s.AdminLanguage = "english!" }, false},
+		{"invalid template language", func(s *entities.NotificationSettings) {
+			s.AdminAlert = map[string]entities.EmailTemplate{"": {Subject: "x", Body: "y"}}
+		}, false},
+		{"empty subject", func(s *entities.NotificationSettings) {
+			s.Confirmation["en"] = entities.EmailTemplate{Subject: " ", Body: "y"}
+		}, false},
+		{"body too long", func(s *entities.NotificationSettings) {
+			s.Confirmation["en"] = entities.EmailTemplate{Subject: "x", Body: strings.Repeat("a", validation.MaxEmailTemplateLength+1)}
+		}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := valid()
+			tt.modify(s)
+			err := validation.ValidateNotificationSettings(s)
+			if tt.ok {
+				require.NoError(t, err)
+			} else {
+				require.True(t, errors.Is(err, entities.ErrInvalidNotificationSettings), "got %v", err)
+			}
+		})
+	}
+
+	require.NoError(t, validation.ValidateNotificationSettings(nil))
+}
@@ -0,0 +1,49 @@
+package validation
+
+import (
+	"fmt"
+	"net/url"
+
+	"Skillture_Form/internal/domain/entities"
+)
+
+// Webhook subscription limits
+const (
+	MinWebhookSecretLength = 16
+	MaxWebhookSecretLength = 128
+	MaxWebhookURLLength    = 2048
+)
+
+// ValidateWebhookSubscription checks the URL, secret and events of a subscription
+func ValidateWebhookSubscription(s *entities.WebhookSubscription) error {
+	if len(s.URL) > MaxWebhookURLLength {
+		return fmt.Errorf("%w: url must be at most %d characters", entities.ErrInvalidWebhook, MaxWebhookURLLength)
+	}
+	u, err := url.Parse(s.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http or https URL", entities.ErrInvalidWebhook)
+	}
+	if u.User != nil {
+		return fmt.Errorf("%w: url must not contain credentials", entities.ErrInvalidWebhook)
+	}
+
+	if len(s.Secret) < MinWebhookSecretLength || len(s.Secret) > MaxWebhookSecretLength {
+		return fmt.Errorf("%w: secret must be %d to %d characters", entities.ErrInvalidWebhook, MinWebhookSecretLength, MaxWebhookSecretLength)
+	}
+
+	if len(s.Events) == 0 {
+		return fmt.Errorf("%w: at least one event is required", entities.ErrInvalidWebhook)
+	}
+	seen := make(map[string]bool, len(s.Events))
+	for _, e := range s.Events {
+		if !e.IsValid() {
+			return fmt.Errorf("%w: unknown event %q", entities.ErrInvalidWebhook, e)
+		}
+		if seen[string(e)] {
+			return fmt.Errorf("%w: duplicate event %q", entities.ErrInvalidWebhook, e)
+		}
+		seen[string(e)] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+// Package webhook signs and sends webhook deliveries to subscriber endpoints.
+//
+// Every request is a POST of the event JSON with these headers:
+//
+//	X-Webhook-Event:     response.submitted
+//	X-Webhook-Delivery:  delivery ID, stable across retries
+//	X-Webhook-Timestamp: Unix seconds of the attempt
+//	X-Webhook-Signature: sha256=hex(HMAC-SHA256(secret, timestamp + "." + body))
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+)
+
+// Request headers set on every delivery
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+const (
+	signaturePrefix = "sha256="
+	userAgent       = "Skillture-Form-Webhooks/1.0"
+)
+
+// ErrForbiddenAddress is returned when a delivery URL resolves to a loopback,
+// private, link-local or otherwise internal address.
+var ErrForbiddenAddress = errors.New("webhook: destination address is not allowed")
+
+// Ranges not covered by the netip.Addr predicates
+var internalPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // "This network"
+	netip.MustParsePrefix("100.64.0.0/10"), // Carrier-grade NAT
+}
+
+// Sign returns the X-Webhook-Signature value of body sent at timestamp
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks a signature in constant time, as a subscriber would
+func Verify(secret, signature string, timestamp int64, body []byte) bool {
+	return hmac.Equal([]byte(signature), []byte(Sign(secret, timestamp, body)))
+}
+
+// Client POSTs signed deliveries. Redirects are not followed, so a subscriber
+// cannot bounce a signed payload to another host.
+type Client struct {
+	http *http.Client
+}
+
+// NewClient creates a client whose requests time out after timeout. Unless
+// allowPrivate is set, connections to internal addresses are refused with
+// ErrForbiddenAddress. The check runs on the resolved address at dial time,
+// so a hostname that re-resolves to an internal address is still blocked.
+func NewClient(timeout time.Duration, allowPrivate bool) *Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !allowPrivate {
+		// A proxy would dial on our behalf and bypass the check
+		transport.Proxy = nil
+		dialer := &net.Dialer{Timeout: timeout, Control: rejectInternal}
+		transport.DialContext = dialer.DialContext
+	}
+
+	return &Client{http: &http.Client{
+		Transport: transport,
+		Timeout:   timeout,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}}
+}
+
+// rejectInternal is a net.Dialer Control hook that refuses internal addresses
+func rejectInternal(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if isInternal(addr.Unmap()) {
+		return fmt.Errorf("%w: %s", ErrForbiddenAddress, addr)
+	}
+	return nil
+}
+
+func isInternal(addr netip.Addr) bool {
+	if addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return true
+	}
+	for _, prefix := range internalPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Send posts a delivery's payload to its URL. It returns the HTTP status code
+// (0 when no response was received) and an error unless the status is 2xx.
+func (c *Client) Send(ctx context.Context, delivery *entities.WebhookDelivery) (int, error) {
+	timestamp := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, delivery.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set(HeaderEvent, string(delivery.Event))
+	req.Header.Set(HeaderDelivery, delivery.ID.String())
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(delivery.Secret, timestamp, delivery.Payload))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// The response body is never read into the delivery log: it is whatever
+	// the remote host chose to return and may echo internal details.
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook: endpoint returned %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
@@ -0,0 +1,119 @@
+package webhook_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"Skillture_Form/internal/domain/entities"
+	"Skillture_Form/internal/domain/enums"
+	"Skillture_Form/internal/webhook"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"type":"form.closed"}`)
+
+	sig := webhook.Sign("secret", 1700000000, body)
+	require.Regexp(t, `^sha256=[0-9a-f]{64}$`, sig)
+	require.True(t, webhook.Verify("secret", sig, 1700000000, body))
+
+	require.False(t, webhook.Verify("other", sig, 1700000000, body))
+	require.False(t, webhook.Verify("secret", sig, 1700000001, body), "timestamp is signed")
+	require.False(t, webhook.Verify("secret", sig, 1700000000, []byte(`{"type":"form.published"}`)))
+}
+
+func newDelivery(url string) *entities.WebhookDelivery {
+	return &entities.WebhookDelivery{
+		ID:      uuid.New(),
+		Event:   enums.WebhookResponseSubmitted,
+		Payload: []byte(`{"type":"response.submitted","data":{}}`),
+		URL:     url,
+		Secret:  "s3cret",
+	}
+}
+
+func TestClient_SendSignsPayload(t *testing.T) {
+	delivery := newDelivery("")
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.Equal(t, "response.submitted", r.Header.Get(webhook.HeaderEvent))
+		require.Equal(t, delivery.ID.String(), r.Header.Get(webhook.HeaderDelivery))
+
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.JSONEq(t, string(delivery.Payload), string(body))
+
+		ts, err := strconv.ParseInt(r.Header.Get(webhook.HeaderTimestamp), 10, 64)
+		require.NoError(t, err)
+		require.True(t, webhook.Verify("s3cret", r.Header.Get(webhook.HeaderSignature), ts, body))
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+	delivery.URL = srv.URL
+
+	code, err := webhook.NewClient(time.Second, true).Send(context.Background(), delivery)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusNoContent, code)
+}
+
+func TestClient_SendFailures(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/error":
+			http.Error(w, "database down", http.StatusInternalServerError)
+		case "/redirect":
+			http.Redirect(w, r, "/elsewhere", http.StatusFound)
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+		}
+	}))
+	defer srv.Close()
+	client := webhook.NewClient(50*time.Millisecond, true)
+
+	code, err := client.Send(context.Background(), newDelivery(srv.URL+"/error"))
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), "database down", "response bodies are not logged")
+	require.Equal(t, http.StatusInternalServerError, code)
+
+	code, err = client.Send(context.Background(), newDelivery(srv.URL+"/redirect"))
+	require.Error(t, err, "redirects are not followed")
+	require.Equal(t, http.StatusFound, code)
+
+	code, err = client.Send(context.Background(), newDelivery(srv.URL+"/slow"))
+	require.Error(t, err)
+	require.Zero(t, code)
+}
+
+func TestClient_SendRefusesInternalAddresses(t *testing.T) {
+	var hits int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+	}))
+	defer srv.Close()
+	client := webhook.NewClient(time.Second, false)
+
+	for _, url := range []string{
+		srv.URL,
+		strings.Replace(srv.URL, "127.0.0.1", "localhost", 1),
+		"http://10.0.0.1/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://0.0.0.0/hook",
+		"http://[::1]/hook",
+	} {
+		code, err := client.Send(context.Background(), newDelivery(url))
+		require.ErrorIs(t, err, webhook.ErrForbiddenAddress, url)
+		require.Zero(t, code)
+	}
+	require.Zero(t, hits)
+}
@@ -21,8 +21,10 @@ export const AuthProvider = ({ children }) => {
     }, []);
 
     const login = (userData) => {
-        // For now, since backend doesn't return user details, we create a dummy session
-        // In future, use the actual user object from API response
+        if (userData.tokens) {
+            localStorage.setItem('token', userData.tokens.access_token);
+            localStorage.setItem('refreshToken', userData.tokens.refresh_token);
+        }
         const sessionUser = {
             username: userData.username,
             timestamp: new Date().toISOString()
@@ -34,6 +36,8 @@ export const AuthProvider = ({ children }) => {
     const logout = () => {
         setUser(null);
         localStorage.removeItem('user');
+        localStorage.removeItem('token');
+        localStorage.removeItem('refreshToken');
     };
 
     return (
@@ -2,6 +2,7 @@ import React, { useEffect, useState } from 'react';
 import { Link } from 'react-router-dom';
 import { Plus, Eye, Trash2, MessageSquare, Edit, Share2, ToggleLeft, ToggleRight } from 'lucide-react';
 import api from '../../services/api';
+import { localized } from '../../services/i18n';
 import Button from '../../components/ui/Button';
 import Card from '../../components/ui/Card';
 import styles from './Dashboard.module.css';
@@ -16,8 +17,8 @@ const Dashboard = () => {
 
     const fetchForms = async () => {
         try {
-            const res = await api.get('/forms/');
-            setForms(res.data || []);
+            const res = await api.get('/forms/', { params: { limit: 100 } });
+            setForms(res.data?.data || []);
         } catch (error) {
             console.error('Failed to fetch forms', error);
         } finally {
@@ -100,11 +101,11 @@ const Dashboard = () => {
                         <Card key={form.id} className={styles.formCard}>
                             <div className={styles.cardHeader}>
                                 <Link to={`/admin/forms/${form.id}/edit`} className={styles.formTitleLink}>
-                                    <h3 className={styles.formTitle}>{form.title}</h3>
+                                    <h3 className={styles.formTitle}>{localized(form.title)}</h3>
                                 </Link>
                                 {getStatusToggle(form)}
                             </div>
-                            <p className={styles.formDesc}>{form.description || 'No description'}</p>
+                            <p className={styles.formDesc}>{localized(form.description) || 'No description'}</p>
                             <div className={styles.cardFooter}>
                                 <div className={styles.actions}>
                                     <Link to={`/forms/${form.id}`} target="_blank" title="Preview Form">
@@ -1,6 +1,7 @@
 import React, { useState, useEffect } from 'react';
 import { useParams, useNavigate } from 'react-router-dom';
 import api from '../../services/api';
+import { localized } from '../../services/i18n';
 import Button from '../../components/ui/Button';
 import Input from '../../components/ui/Input';
 import Card from '../../components/ui/Card';
@@ -134,7 +135,7 @@ const FormBuilder = () => {
                         <ArrowLeft size={18} />
                     </Button>
                     <div>
-                        <h1 className={styles.title}>{form.title}</h1>
+                        <h1 className={styles.title}>{localized(form.title)}</h1>
                         <span className={styles.subtitle}>Form Builder</span>
                     </div>
                 </div>
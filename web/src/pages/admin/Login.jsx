@@ -22,7 +22,7 @@ const Login = () => {
         try {
             const res = await api.post('/admins/login', formData);
             // Login successful, update context
-            login({ username: formData.username });
+            login({ username: formData.username, tokens: res.data.tokens });
             navigate('/admin/dashboard');
         } catch (err) {
             setError('Invalid username or password');
@@ -1,6 +1,7 @@
 import React, { useEffect, useState } from 'react';
 import { useParams } from 'react-router-dom';
 import api from '../../services/api';
+import { localized } from '../../services/i18n';
 import Card from '../../components/ui/Card';
 import Button from '../../components/ui/Button';
 import { Download } from 'lucide-react';
@@ -11,6 +12,7 @@ const ResponseViewer = () => {
     const [responses, setResponses] = useState([]);
     const [form, setForm] = useState(null);
     const [fields, setFields] = useState([]);
+    const [total, setTotal] = useState(0);
     const [loading, setLoading] = useState(true);
 
     useEffect(() => {
@@ -22,11 +24,12 @@ const ResponseViewer = () => {
             const [formRes, fieldsRes, responsesRes] = await Promise.all([
                 api.get(`/forms/${id}`),
                 api.get(`/forms/${id}/fields`),
-                api.get(`/forms/${id}/responses`)
+                api.get(`/forms/${id}/responses`, { params: { limit: 100 } })
             ]);
             setForm(formRes.data);
             setFields((fieldsRes.data || []).sort((a, b) => a.field_order - b.field_order));
-            setResponses(responsesRes.data || []);
+            setResponses(responsesRes.data?.data || []);
+            setTotal(responsesRes.data?.pagination?.total || 0);
         } catch (err) {
             console.error('Failed to load responses', err);
         } finally {
@@ -46,51 +49,26 @@ const ResponseViewer = () => {
         return JSON.stringify(answer.value);
     };
 
-    const escapeCsvCell = (value) => {
-        const str = String(value ?? '');
-        // Wrap in quotes if contains comma, quote, or newline
-        if (str.includes(',') || str.includes('"') || str.includes('\n')) {
-            return `"${str.replace(/"/g, '""')}"`;
-        }
-        return str;
-    };
-
-    const handleExportCSV = () => {
-        // Build header row
-        const headers = [
-            'Respondent Name',
-            'Respondent Email',
-            'Submitted At',
-            ...fields.map(f => f.label?.en || 'Question')
-        ];
-
-        // Build data rows
-        const rows = responses.map(response => [
-            response.respondent?.name || 'Anonymous',
-            response.respondent?.email || '',
-            new Date(response.submitted_at || response.created_at).toLocaleString(),
-            ...fields.map(field => getAnswerValue(response, field.id))
-        ]);
-
-        // Convert to CSV string
-        const csvContent = [
-            headers.map(escapeCsvCell).join(','),
-            ...rows.map(row => row.map(escapeCsvCell).join(','))
-        ].join('\n');
-
-        // Add BOM for proper UTF-8 handling in Excel (important for Arabic text)
-        const bom = '\uFEFF';
-        const blob = new Blob([bom + csvContent], { type: 'text/csv;charset=utf-8;' });
-        const url = URL.createObjectURL(blob);
+    // The server streams every response, not just the loaded page
+    const handleExportCSV = async () => {
+        try {
+            const res = await api.get(`/forms/${id}/responses/export`, {
+                params: { format: 'csv' },
+                responseType: 'blob'
+            });
+            const url = URL.createObjectURL(res.data);
 
-        const link = document.createElement('a');
-        link.href = url;
-        const formTitle = (form?.title || 'responses').replace(/[^a-zA-Z0-9]/g, '_');
-        link.download = `${formTitle}_responses.csv`;
-        document.body.appendChild(link);
-        link.click();
-        document.body.removeChild(link);
-        URL.revokeObjectURL(url);
+            const link = document.createElement('a');
+            link.href = url;
+            const formTitle = (localized(form?.title) || 'responses').replace(/[^a-zA-Z0-9]/g, '_');
+            link.download = `${formTitle}_responses.csv`;
+            document.body.appendChild(link);
+            link.click();
+            document.body.removeChild(link);
+            URL.revokeObjectURL(url);
+        } catch (err) {
+            console.error('Failed to export responses', err);
+        }
     };
 
     if (loading) return <div className={styles.loading}>Loading responses...</div>;
@@ -99,8 +77,8 @@ const ResponseViewer = () => {
         <div className={styles.container}>
             <div className={styles.header}>
                 <div className={styles.headerLeft}>
-                    <h1>Responses for: {form?.title}</h1>
-                    <span className={styles.count}>{responses.length} responses</span>
+                    <h1>Responses for: {localized(form?.title)}</h1>
+                    <span className={styles.count}>{total} responses</span>
                 </div>
                 {responses.length > 0 && (
                     <Button onClick={handleExportCSV} className={styles.exportBtn}>
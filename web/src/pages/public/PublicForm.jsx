@@ -1,6 +1,7 @@
 import React, { useEffect, useState, useCallback } from 'react';
 import { useParams } from 'react-router-dom';
 import api from '../../services/api';
+import { localized } from '../../services/i18n';
 import Button from '../../components/ui/Button';
 import Input from '../../components/ui/Input';
 import Card from '../../components/ui/Card';
@@ -21,8 +22,8 @@ const PublicForm = () => {
     const fetchFormDetails = useCallback(async () => {
         try {
             const [formRes, fieldsRes] = await Promise.all([
-                api.get(`/forms/${id}`),
-                api.get(`/forms/${id}/fields`)
+                api.get(`/public/forms/${id}`),
+                api.get(`/public/forms/${id}/fields`)
             ]);
             setForm(formRes.data);
             // Sort fields by field_order
@@ -241,7 +242,7 @@ const PublicForm = () => {
                     </div>
                     <h2 className={styles.waitingTitle}>Form Not Available Yet</h2>
                     <p className={styles.waitingMessage}>
-                        <strong>{form.title}</strong> is currently not accepting responses.
+                        <strong>{localized(form.title)}</strong> is currently not accepting responses.
                     </p>
                     <p className={styles.waitingSubtext}>
                         Please wait — this page will automatically refresh when the form becomes available.
@@ -282,8 +283,8 @@ const PublicForm = () => {
         <div className={styles.container}>
             <Card className={styles.formCard}>
                 <header className={styles.formHeader}>
-                    <h1 className={styles.title}>{form.title}</h1>
-                    <p className={styles.desc}>{form.description}</p>
+                    <h1 className={styles.title}>{localized(form.title)}</h1>
+                    <p className={styles.desc}>{localized(form.description)}</p>
                 </header>
 
                 <form onSubmit={handleSubmit} className={styles.form}>
@@ -7,12 +7,12 @@ const api = axios.create({
     },
 });
 
-// Request interceptor for auth (placeholder for now)
+// Request interceptor for auth
 api.interceptors.request.use((config) => {
-    // const token = localStorage.getItem('token');
-    // if (token) {
-    //   config.headers.Authorization = `Bearer ${token}`;
-    // }
+    const token = localStorage.getItem('token');
+    if (token) {
+        config.headers.Authorization = `Bearer ${token}`;
+    }
     return config;
 });
 
@@ -0,0 +1,7 @@
+// Picks a translation from a multilingual value like {"en": "Survey", "ar": "استبيان"}.
+// Falls back to English, then to any available language; plain strings are returned as is.
+export const localized = (value, lang = 'en') => {
+    if (!value) return '';
+    if (typeof value === 'string') return value;
+    return value[lang] || value.en || Object.values(value).find(Boolean) || '';
+};